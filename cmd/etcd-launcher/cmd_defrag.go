@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -79,24 +80,31 @@ func DefragFunc(log *zap.SugaredLogger, opt *defragOptions) cobraFuncE {
 			return fmt.Errorf("failed to set expected cluster size: %w", err)
 		}
 
-		client, err := e.GetEtcdClient(ctx, log)
-		if err != nil {
-			return fmt.Errorf("failed to get etcd cluster client: %w", err)
-		}
+		err := defragment(ctx, log, e)
+		e.RecordJobOutcome(ctx, log, etcd.JobDefrag, err)
 
-		for _, endpoint := range client.Endpoints() {
-			_, err := client.Defragment(ctx, endpoint)
-			if err != nil {
-				return fmt.Errorf("failed to defragment %s: %w", endpoint, err)
-			}
+		return err
+	})
+}
 
-			log.Infow("defragmented etcd member", "endpoint", endpoint)
+func defragment(ctx context.Context, log *zap.SugaredLogger, e *etcd.Cluster) error {
+	client, err := e.GetEtcdClient(ctx, log)
+	if err != nil {
+		return fmt.Errorf("failed to get etcd cluster client: %w", err)
+	}
 
-			time.Sleep(5 * time.Second)
+	for _, endpoint := range client.Endpoints() {
+		_, err := client.Defragment(ctx, endpoint)
+		if err != nil {
+			return fmt.Errorf("failed to defragment %s: %w", endpoint, err)
 		}
 
-		log.Info("finished defragmentation on all members")
+		log.Infow("defragmented etcd member", "endpoint", endpoint)
 
-		return nil
-	})
+		time.Sleep(5 * time.Second)
+	}
+
+	log.Info("finished defragmentation on all members")
+
+	return nil
 }
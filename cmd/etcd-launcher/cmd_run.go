@@ -21,14 +21,18 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"net/http"
 	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
 	"k8c.io/kubermatic/v2/cmd/etcd-launcher/pkg/etcd"
+	"k8c.io/kubermatic/v2/pkg/metrics"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
 
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -45,6 +49,8 @@ type runOptions struct {
 	enableCorruptionCheck bool
 
 	quotaBackendBytes int64
+
+	metricsAddress string
 }
 
 func RunCommand(logger *zap.SugaredLogger) *cobra.Command {
@@ -90,6 +96,7 @@ func RunCommand(logger *zap.SugaredLogger) *cobra.Command {
 	cmd.PersistentFlags().StringVar(&opt.token, "token", "", "etcd database token")
 	cmd.PersistentFlags().BoolVar(&opt.enableCorruptionCheck, "enable-corruption-check", false, "enable experimental corruption check")
 	cmd.PersistentFlags().Int64Var(&opt.quotaBackendBytes, "quota-backend-gb", 0, "maximum backend size of etcd in gb (0 means use etcd default)")
+	cmd.PersistentFlags().StringVar(&opt.metricsAddress, "metrics-address", fmt.Sprintf(":%d", resources.EtcdLauncherMetricsPort), "address to serve Prometheus metrics on")
 
 	return cmd
 }
@@ -118,6 +125,22 @@ func RunFunc(log *zap.SugaredLogger, opt *runOptions) cobraFuncE {
 
 		reconciling.Configure(log)
 
+		etcd.RegisterMetrics(prometheus.DefaultRegisterer)
+
+		// the readiness of the etcd container is determined by the launcher, so that
+		// learners are only reported ready once they have been promoted
+		http.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+			ready, err := e.IsReady(r.Context(), log)
+			if err != nil || !ready {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		})
+
+		go metrics.ServeForever(opt.metricsAddress, "/metrics")
+
 		// init the current cluster object; we only care about the namespace name
 		// (which is practically immutable) and so it's sufficient to fetch the
 		// cluster now, once.
@@ -176,24 +199,43 @@ func RunFunc(log *zap.SugaredLogger, opt *runOptions) cobraFuncE {
 			log.Panicw("failed to start etcd cmd", zap.Error(err))
 		}
 
+		quorumWaitStart := time.Now()
+
 		if err = wait.PollUntilContextTimeout(ctx, 1*time.Second, 60*time.Second, false, func(ctx context.Context) (bool, error) {
 			return e.IsClusterHealthy(ctx, log)
 		}); err != nil {
 			log.Panicw("manager thread failed to connect to cluster", zap.Error(err))
 		}
 
-		// reconcile dead members continuously. Initially we did this once as a step at the end of start up. We did that because scale up/down operations required a full restart of the ring with each node add/remove. However, this is no longer the case, so we need to separate the reconcile from the start up process and do it continuously.
+		etcd.ObserveQuorumWait(time.Since(quorumWaitStart))
+
+		// learners are promoted once they have caught up with the leader. This runs independently of the reconciliation
+		// below, so dead members are still removed and metrics are still updated while this member is a learner.
 		go func() {
-			wait.Forever(func() {
-				// refresh the cluster size so the etcd-launcher is aware of scaling operations
-				if err := e.SetClusterSize(ctx); err != nil {
-					log.Warnw("failed to refresh cluster size", zap.Error(err))
-				} else if _, err := e.DeleteUnwantedDeadMembers(ctx, log); err != nil {
-					log.Warnw("failed to remove dead members", zap.Error(err))
+			_ = wait.PollUntilContextCancel(ctx, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+				promoted, err := e.PromoteLearner(ctx, log)
+				if err != nil {
+					log.Warnw("failed to promote learner", zap.Error(err))
 				}
-			}, 30*time.Second)
+
+				return promoted, nil
+			})
 		}()
 
+		// reconcile dead members continuously. Initially we did this once as a step at the end of start up. We did that because scale up/down operations required a full restart of the ring with each node add/remove. However, this is no longer the case, so we need to separate the reconcile from the start up process and do it continuously.
+		go wait.Forever(func() {
+			// refresh the cluster size so the etcd-launcher is aware of scaling operations
+			if err := e.SetClusterSize(ctx); err != nil {
+				log.Warnw("failed to refresh cluster size", zap.Error(err))
+			} else if _, err := e.DeleteUnwantedDeadMembers(ctx, log); err != nil {
+				log.Warnw("failed to remove dead members", zap.Error(err))
+			}
+
+			if err := e.UpdateJobOutcomeMetrics(ctx); err != nil {
+				log.Warnw("failed to update job outcome metrics", zap.Error(err))
+			}
+		}, 30*time.Second)
+
 		if err = etcdCmd.Wait(); err != nil {
 			log.Panic(err)
 		}
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"strings"
@@ -25,12 +26,14 @@ import (
 	"go.uber.org/zap"
 
 	"k8c.io/kubermatic/v2/cmd/etcd-launcher/pkg/etcd"
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
 )
 
 type snapshotCmdOptions struct {
 	options
 
 	snapshotOptions etcd.SnapshotOptions
+	encryptionKey   []byte
 }
 
 func SnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
@@ -39,6 +42,7 @@ func SnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:          "snapshot",
 		Short:        "Create etcd database snapshot and save it to file",
+		Long:         fmt.Sprintf("If the %s environment variable is set, the snapshot is encrypted with the given key.", etcdbackup.EncryptionKeyEnvVarKey),
 		RunE:         SnapshotFunc(log, &opt),
 		SilenceUsage: true,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -48,6 +52,12 @@ func SnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
 				return fmt.Errorf("invalid --compression algorithm, must be one of %v", etcd.ValidCompressions)
 			}
 
+			key, err := encryptionKeyFromEnv()
+			if err != nil {
+				return err
+			}
+			opt.encryptionKey = key
+
 			return nil
 		},
 	}
@@ -89,39 +99,55 @@ func SnapshotFunc(log *zap.SugaredLogger, opt *snapshotCmdOptions) cobraFuncE {
 			return fmt.Errorf("failed to set expected cluster size: %w", err)
 		}
 
-		configs, err := e.GetEtcdEndpointConfigs(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get etcd cluster clients: %w", err)
+		err := takeSnapshot(ctx, log, e, opt)
+		e.RecordJobOutcome(ctx, log, etcd.JobSnapshot, err)
+
+		return err
+	})
+}
+
+func takeSnapshot(ctx context.Context, log *zap.SugaredLogger, e *etcd.Cluster, opt *snapshotCmdOptions) error {
+	configs, err := e.GetEtcdEndpointConfigs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get etcd cluster clients: %w", err)
+	}
+
+	// snapshots can only be taken from a single endpoint, but it's possible
+	// that one of the endpoints is down right now (e.g. because an update
+	// or autoscaling-caused rescheduling event is happening). So we loop
+	// over all endpoints and try to take a snapshot.
+	for _, config := range configs {
+		clog := log.With("endpoints", strings.Join(config.Endpoints, ","))
+
+		if len(config.Endpoints) != 1 {
+			clog.Warn("unexpected number of endpoints, skipping this configuration")
+			continue
 		}
 
-		// snapshots can only be taken from a single endpoint, but it's possible
-		// that one of the endpoints is down right now (e.g. because an update
-		// or autoscaling-caused rescheduling event is happening). So we loop
-		// over all endpoints and try to take a snapshot.
-		for _, config := range configs {
-			clog := log.With("endpoints", strings.Join(config.Endpoints, ","))
+		err := etcd.CreateSnapshot(ctx, clog, config, &opt.snapshotOptions)
 
-			if len(config.Endpoints) != 1 {
-				clog.Warn("unexpected number of endpoints, skipping this configuration")
-				continue
-			}
+		// if the snapshot was successful, we have what we want and do not
+		// need to loop over the remaining endpoints. We can exit the program
+		// successfully then.
+		if err == nil {
+			clog.Infow("saved snapshot from endpoint", "file", opt.snapshotOptions.File)
 
-			err := etcd.CreateSnapshot(ctx, clog, config, &opt.snapshotOptions)
+			if opt.encryptionKey != nil {
+				if err := etcd.EncryptSnapshot(opt.snapshotOptions.File, opt.encryptionKey); err != nil {
+					return fmt.Errorf("failed to encrypt snapshot: %w", err)
+				}
 
-			// if the snapshot was successful, we have what we want and do not
-			// need to loop over the remaining endpoints. We can exit the program
-			// successfully then.
-			if err == nil {
-				clog.Infow("saved snapshot from endpoint", "file", opt.snapshotOptions.File)
-				return nil
+				clog.Info("encrypted snapshot")
 			}
 
-			// log an error if we were not able to take a snapshot, before the loop
-			// moves on to the next one.
-			clog.Errorw("failed to save snapshot from endpoint, trying next endpoint", zap.Error(err))
+			return nil
 		}
 
-		// we failed to take any snapshot, so we need to exit the program with an error.
-		return fmt.Errorf("exhausted all endpoints, no snapshot was successful")
-	})
+		// log an error if we were not able to take a snapshot, before the loop
+		// moves on to the next one.
+		clog.Errorw("failed to save snapshot from endpoint, trying next endpoint", zap.Error(err))
+	}
+
+	// we failed to take any snapshot, so we need to exit the program with an error.
+	return fmt.Errorf("exhausted all endpoints, no snapshot was successful")
 }
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/v2/cmd/etcd-launcher/pkg/etcd"
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
+	"k8c.io/kubermatic/v2/pkg/util/s3"
+)
+
+type verifySnapshotOptions struct {
+	options
+
+	object        string
+	workDir       string
+	caBundle      string
+	resultFile    string
+	encryptionKey []byte
+}
+
+func VerifySnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
+	opt := verifySnapshotOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "verify-snapshot",
+		Short: "Download an etcd snapshot from S3 and verify it by restoring it into a temporary data directory",
+		Long: fmt.Sprintf("The S3 endpoint, bucket and credentials are read from the %s, %s, %s and %s environment variables, "+
+			"plain HTTP is used if %s is \"true\". "+
+			"Encrypted snapshots are decrypted using the key from the %s environment variable.",
+			etcdbackup.BackupEndpointEnvVarKey, etcdbackup.BucketNameEnvVarKey, etcdbackup.AccessKeyIDEnvVarKey, etcdbackup.SecretAccessKeyEnvVarKey,
+			etcdbackup.BackupInsecureEnvVarKey,
+			etcdbackup.EncryptionKeyEnvVarKey),
+		RunE:         VerifySnapshotFunc(log, &opt),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.CopyInto(&opt.options)
+
+			if opt.object == "" {
+				return errors.New("no --object given")
+			}
+
+			key, err := encryptionKeyFromEnv()
+			if err != nil {
+				return err
+			}
+			opt.encryptionKey = key
+
+			return nil
+		},
+	}
+
+	cmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		if err := c.Usage(); err != nil {
+			return err
+		}
+
+		// ensure we exit with code 1 later on
+		return err
+	})
+
+	cmd.PersistentFlags().StringVar(&opt.object, "object", "", "name of the snapshot object in the S3 bucket")
+	cmd.PersistentFlags().StringVar(&opt.workDir, "work-dir", "/backup", "directory to download and restore the snapshot in")
+	cmd.PersistentFlags().StringVar(&opt.caBundle, "ca-bundle", "/etc/ca-bundle/ca-bundle.pem", "path to the CA bundle used to connect to S3")
+	cmd.PersistentFlags().StringVar(&opt.resultFile, "result-file", "/dev/termination-log", "file to write the verification result to (as JSON)")
+
+	return cmd
+}
+
+func VerifySnapshotFunc(log *zap.SugaredLogger, opt *verifySnapshotOptions) cobraFuncE {
+	return handleErrors(log, func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		log := log.With("cluster", opt.cluster, "object", opt.object)
+
+		caBundle, err := os.ReadFile(opt.caBundle)
+		if err != nil {
+			return fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		endpoint := os.Getenv(etcdbackup.BackupEndpointEnvVarKey)
+		if os.Getenv(etcdbackup.BackupInsecureEnvVarKey) == "true" && !strings.Contains(endpoint, "://") {
+			endpoint = "http://" + endpoint
+		}
+
+		s3Client, err := s3.NewClient(
+			endpoint,
+			os.Getenv(etcdbackup.AccessKeyIDEnvVarKey),
+			os.Getenv(etcdbackup.SecretAccessKeyEnvVarKey),
+			string(caBundle),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create S3 client: %w", err)
+		}
+
+		bucketName := os.Getenv(etcdbackup.BucketNameEnvVarKey)
+		downloadedSnapshotFile := filepath.Join(opt.workDir, filepath.Base(opt.object))
+
+		log.Info("Downloading snapshot")
+
+		if err := s3Client.FGetObject(ctx, bucketName, opt.object, downloadedSnapshotFile, minio.GetObjectOptions{}); err != nil {
+			return fmt.Errorf("failed to download snapshot (%s/%s): %w", bucketName, opt.object, err)
+		}
+
+		if err := etcd.DecryptSnapshotIfNeeded(downloadedSnapshotFile, opt.encryptionKey); err != nil {
+			return fmt.Errorf("failed to decrypt snapshot: %w", err)
+		}
+
+		log.Info("Verifying snapshot")
+
+		result, err := etcd.VerifySnapshot(log, downloadedSnapshotFile, opt.workDir)
+		if err != nil {
+			return fmt.Errorf("failed to verify snapshot: %w", err)
+		}
+
+		encoded, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to encode verification result: %w", err)
+		}
+
+		if err := os.WriteFile(opt.resultFile, encoded, 0644); err != nil {
+			return fmt.Errorf("failed to write verification result: %w", err)
+		}
+
+		log.Infow("Snapshot verified successfully", "revision", result.Revision, "keys", result.KeyCount, "hash", result.Hash)
+
+		return nil
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -24,6 +25,8 @@ import (
 
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
+	"k8c.io/kubermatic/v2/pkg/util/backupencryption"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
@@ -104,6 +107,7 @@ func addCommands(cmd *cobra.Command, logger *zap.SugaredLogger) {
 		IsRunningCommand(logger),
 		DefragCommand(logger),
 		SnapshotCommand(logger),
+		VerifySnapshotCommand(logger),
 	)
 }
 
@@ -117,3 +121,19 @@ func handleErrors(logger *zap.SugaredLogger, action cobraFuncE) cobraFuncE {
 		return err
 	}
 }
+
+// encryptionKeyFromEnv returns the snapshot encryption key, if one is configured
+// for the backup destination, or nil otherwise.
+func encryptionKeyFromEnv() ([]byte, error) {
+	encoded := os.Getenv(etcdbackup.EncryptionKeyEnvVarKey)
+	if encoded == "" {
+		return nil, nil
+	}
+
+	key, err := backupencryption.ParseKey([]byte(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", etcdbackup.EncryptionKeyEnvVarKey, err)
+	}
+
+	return key, nil
+}
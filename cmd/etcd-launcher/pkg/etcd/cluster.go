@@ -26,6 +26,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -58,6 +59,7 @@ const (
 	timeoutAddMember      = time.Second * 15
 	timeoutRemoveMember   = time.Second * 30
 	timeoutUpdatePeerURLs = time.Second * 10
+	timeoutPromoteMember  = time.Second * 15
 )
 
 type Cluster struct {
@@ -82,6 +84,9 @@ type Cluster struct {
 	initialMembers []string
 	usePeerTLSOnly bool
 	clusterSize    int
+
+	// isVoter is set once this member has been found or promoted to be a voting member.
+	isVoter atomic.Bool
 }
 
 func (e *Cluster) Init(ctx context.Context) (*kubermaticv1.Cluster, error) {
@@ -140,6 +145,8 @@ func (e *Cluster) SetInitialState(ctx context.Context, log *zap.SugaredLogger, c
 		}
 	}
 
+	setPeerTLSModeMetric(e.usePeerTLSOnly)
+
 	return nil
 }
 
@@ -233,17 +240,83 @@ func (e *Cluster) JoinCluster(ctx context.Context, log *zap.SugaredLogger) error
 	ctx, cancelFunc := context.WithTimeout(ctx, timeoutAddMember)
 	defer cancelFunc()
 
-	if _, err := client.MemberAdd(ctx, peerURLs); err != nil {
+	// new members join as learners, which do not count towards the quorum until they
+	// have caught up with the leader and have been promoted by PromoteLearner.
+	if _, err := client.MemberAddAsLearner(ctx, peerURLs); err != nil {
 		closeClient(client, log)
-		return fmt.Errorf("add itself as a member: %w", err)
+		return fmt.Errorf("add itself as a learner member: %w", err)
 	}
 
+	memberJoins.Inc()
+
 	defer closeClient(client, log)
 
-	log.Info("joined etcd cluster successfully.")
+	log.Info("joined etcd cluster successfully as learner.")
 	return nil
 }
 
+// PromoteLearner promotes this member to a voting member if it is a learner. It returns
+// false if the learner has not caught up with the leader yet and needs to be retried.
+func (e *Cluster) PromoteLearner(ctx context.Context, log *zap.SugaredLogger) (bool, error) {
+	member, err := e.GetMemberByName(ctx, log, e.PodName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check cluster membership: %w", err)
+	}
+
+	if member == nil {
+		return false, errors.New("pod is not a cluster member")
+	}
+
+	if !member.IsLearner {
+		e.isVoter.Store(true)
+		return true, nil
+	}
+
+	client, err := e.GetEtcdClient(ctx, log)
+	if err != nil {
+		return false, fmt.Errorf("can't find cluster client: %w", err)
+	}
+	defer closeClient(client, log)
+
+	return e.promoteLearner(ctx, log, client, member.ID)
+}
+
+func (e *Cluster) promoteLearner(ctx context.Context, log *zap.SugaredLogger, cluster client.Cluster, memberID uint64) (bool, error) {
+	ctx, cancelFunc := context.WithTimeout(ctx, timeoutPromoteMember)
+	defer cancelFunc()
+
+	if _, err := cluster.MemberPromote(ctx, memberID); err != nil {
+		if errors.Is(err, rpctypes.ErrMemberLearnerNotReady) {
+			log.Info("learner has not caught up with the leader yet")
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to promote learner: %w", err)
+	}
+
+	memberPromotions.Inc()
+	e.isVoter.Store(true)
+
+	log.Info("promoted learner to voting member")
+
+	return true, nil
+}
+
+// IsReady returns true if this member is a voting member and healthy.
+func (e *Cluster) IsReady(ctx context.Context, log *zap.SugaredLogger) (bool, error) {
+	return e.isReady(func() (bool, error) {
+		return e.isHealthyWithEndpoints(ctx, log, []string{e.endpoint()})
+	})
+}
+
+func (e *Cluster) isReady(isHealthy func() (bool, error)) (bool, error) {
+	if !e.isVoter.Load() {
+		return false, nil
+	}
+
+	return isHealthy()
+}
+
 func (e *Cluster) RemoveStaleMember(ctx context.Context, log *zap.SugaredLogger, memberID uint64) error {
 	client, err := e.GetEtcdClient(ctx, log)
 	if err != nil {
@@ -260,6 +333,8 @@ func (e *Cluster) RemoveStaleMember(ctx context.Context, log *zap.SugaredLogger,
 
 	closeClient(client, log)
 
+	memberRemovals.WithLabelValues("stale").Inc()
+
 	return nil
 }
 
@@ -299,12 +374,17 @@ func (e *Cluster) UpdatePeerURLs(ctx context.Context, log *zap.SugaredLogger) er
 
 				log.Infof("updating member %d to include plaintext and tls peer ports", member.ID)
 
-				_, err = client.MemberUpdate(
+				if _, err := client.MemberUpdate(
 					ctx,
 					member.ID,
 					[]string{plainPeerURL.String(), tlsPeerURL.String()},
-				)
-				return err
+				); err != nil {
+					return err
+				}
+
+				peerURLUpdates.Inc()
+
+				return nil
 			}
 
 			// if we're supposed to run with TLS peer endpoints only, two peer URLs are
@@ -317,13 +397,17 @@ func (e *Cluster) UpdatePeerURLs(ctx context.Context, log *zap.SugaredLogger) er
 
 				log.Infof("updating member %d to set tls peer port only", member.ID)
 
-				_, err = client.MemberUpdate(
+				if _, err := client.MemberUpdate(
 					ctx,
 					member.ID,
 					[]string{tlsPeerURL.String()},
-				)
+				); err != nil {
+					return err
+				}
 
-				return err
+				peerURLUpdates.Inc()
+
+				return nil
 			}
 		}
 	}
@@ -580,6 +664,11 @@ func (e *Cluster) removeDeadMembers(ctx context.Context, log *zap.SugaredLogger,
 	}
 	defer closeClient(client, log)
 
+	members, err := e.listMembers(ctx, log)
+	if err != nil {
+		return err
+	}
+
 	for _, member := range unwantedMembers {
 		log.Infow("checking cluster member for removal", "member-name", member.Name)
 
@@ -612,18 +701,67 @@ func (e *Cluster) removeDeadMembers(ctx context.Context, log *zap.SugaredLogger,
 
 			return nil, nil
 		}); err != nil {
+			if !member.IsLearner {
+				quorum, err := e.hasQuorumWithout(ctx, log, members, member.ID)
+				if err != nil {
+					return fmt.Errorf("failed to check quorum: %w", err)
+				}
+
+				if !quorum {
+					log.Warnw("not removing member, the cluster would lose quorum", "member-name", member.Name)
+					return nil
+				}
+			}
+
 			log.Infow("member is not responding, removing from cluster", "member-name", member.Name)
 
 			ctx, cancelFunc := context.WithTimeout(ctx, timeoutRemoveMember)
 			defer cancelFunc()
 
-			_, err = client.MemberRemove(ctx, member.ID)
-			return err
+			if _, err := client.MemberRemove(ctx, member.ID); err != nil {
+				return err
+			}
+
+			memberRemovals.WithLabelValues("dead").Inc()
+
+			// members are removed one at a time, any further unwanted
+			// members are handled in the next reconciliation
+			return nil
 		}
 	}
 	return nil
 }
 
+// hasQuorumWithout returns true if enough of the remaining voting members are healthy to
+// maintain quorum once the given member has been removed.
+func (e *Cluster) hasQuorumWithout(ctx context.Context, log *zap.SugaredLogger, members []*etcdserverpb.Member, removedID uint64) (bool, error) {
+	voters := 0
+	healthy := 0
+
+	for _, member := range members {
+		if member.IsLearner || member.ID == removedID {
+			continue
+		}
+
+		voters++
+
+		if len(member.ClientURLs) == 0 {
+			continue
+		}
+
+		ok, err := e.isHealthyWithEndpoints(ctx, log, member.ClientURLs[len(member.ClientURLs)-1:])
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			healthy++
+		}
+	}
+
+	return healthy >= voters/2+1, nil
+}
+
 func (e *Cluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
 	restoreList := &kubermaticv1.EtcdRestoreList{}
 	if err := seedClient.List(ctx, restoreList, &ctrlruntimeclient.ListOptions{Namespace: e.namespace}); err != nil {
@@ -645,6 +783,17 @@ func (e *Cluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, log *zap
 		return nil
 	}
 
+	if err := e.restoreDatadir(ctx, log, seedClient, cluster, activeRestore); err != nil {
+		restores.WithLabelValues("failure").Inc()
+		return err
+	}
+
+	restores.WithLabelValues("success").Inc()
+
+	return nil
+}
+
+func (e *Cluster) restoreDatadir(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, activeRestore *kubermaticv1.EtcdRestore) error {
 	log.Infow("restoring datadir from backup", "backup-name", activeRestore.Spec.BackupName)
 
 	s3Client, bucketName, err := resources.GetEtcdRestoreS3Client(ctx, activeRestore, false, seedClient, cluster, nil)
@@ -659,6 +808,15 @@ func (e *Cluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, log *zap
 		return fmt.Errorf("failed to download backup (%s/%s): %w", bucketName, objectName, err)
 	}
 
+	encryptionKey, err := resources.GetEtcdRestoreEncryptionKey(ctx, activeRestore, seedClient, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get encryption key: %w", err)
+	}
+
+	if err := DecryptSnapshotIfNeeded(downloadedSnapshotFile, encryptionKey); err != nil {
+		return fmt.Errorf("failed to decrypt snapshot file %s: %w", objectName, err)
+	}
+
 	rawBackupFile, err := DecompressSnapshot(downloadedSnapshotFile)
 	if err != nil {
 		return fmt.Errorf("failed to decompress snapshot file %s: %w", objectName, err)
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	client "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+type fakeCluster struct {
+	client.Cluster
+
+	promoteErrs []error
+	promoted    []uint64
+}
+
+func (f *fakeCluster) MemberPromote(_ context.Context, id uint64) (*client.MemberPromoteResponse, error) {
+	var err error
+	if len(f.promoteErrs) > 0 {
+		err, f.promoteErrs = f.promoteErrs[0], f.promoteErrs[1:]
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	f.promoted = append(f.promoted, id)
+
+	return &client.MemberPromoteResponse{}, nil
+}
+
+func TestPromoteLearner(t *testing.T) {
+	testCases := []struct {
+		name string
+		// promoteErrs are returned by consecutive MemberPromote calls, nil meaning success
+		promoteErrs []error
+		attempts    int
+		expected    []bool
+		expectErr   bool
+		healthy     bool
+		ready       bool
+	}{
+		{
+			name:     "learner is promoted",
+			attempts: 1,
+			expected: []bool{true},
+			healthy:  true,
+			ready:    true,
+		},
+		{
+			name:        "learner not yet in sync is retried",
+			promoteErrs: []error{rpctypes.ErrMemberLearnerNotReady},
+			attempts:    1,
+			expected:    []bool{false},
+			healthy:     true,
+			ready:       false,
+		},
+		{
+			name:        "learner is promoted once it has caught up",
+			promoteErrs: []error{rpctypes.ErrMemberLearnerNotReady, rpctypes.ErrMemberLearnerNotReady, nil},
+			attempts:    3,
+			expected:    []bool{false, false, true},
+			healthy:     true,
+			ready:       true,
+		},
+		{
+			name:        "wrapped learner not ready error is retried",
+			promoteErrs: []error{fmt.Errorf("promote: %w", rpctypes.ErrMemberLearnerNotReady)},
+			attempts:    1,
+			expected:    []bool{false},
+			healthy:     true,
+			ready:       false,
+		},
+		{
+			name:        "promoted but unhealthy member is not ready",
+			promoteErrs: []error{nil},
+			attempts:    1,
+			expected:    []bool{true},
+			healthy:     false,
+			ready:       false,
+		},
+		{
+			name:        "other errors are returned",
+			promoteErrs: []error{rpctypes.ErrMemberNotFound},
+			attempts:    1,
+			expected:    []bool{false},
+			expectErr:   true,
+			healthy:     true,
+			ready:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &Cluster{}
+			cluster := &fakeCluster{promoteErrs: tc.promoteErrs}
+			promotionsBefore := testutil.ToFloat64(memberPromotions)
+
+			for i := range tc.attempts {
+				promoted, err := e.promoteLearner(context.Background(), zap.NewNop().Sugar(), cluster, 42)
+				if tc.expectErr != (err != nil) {
+					t.Fatalf("Attempt %d: expected error=%v, got %v", i, tc.expectErr, err)
+				}
+
+				if promoted != tc.expected[i] {
+					t.Fatalf("Attempt %d: expected promoted=%v, got %v", i, tc.expected[i], promoted)
+				}
+			}
+
+			expectedPromotions := 0
+			if tc.expected[len(tc.expected)-1] {
+				expectedPromotions = 1
+			}
+
+			if len(cluster.promoted) != expectedPromotions {
+				t.Errorf("Expected %d promotions, got %v", expectedPromotions, cluster.promoted)
+			}
+			if len(cluster.promoted) > 0 && cluster.promoted[0] != 42 {
+				t.Errorf("Expected member 42 to be promoted, got %d", cluster.promoted[0])
+			}
+
+			if promotions := testutil.ToFloat64(memberPromotions) - promotionsBefore; promotions != float64(expectedPromotions) {
+				t.Errorf("Expected promotion counter to increase by %d, got %v", expectedPromotions, promotions)
+			}
+
+			ready, err := e.isReady(func() (bool, error) {
+				return tc.healthy, nil
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if ready != tc.ready {
+				t.Errorf("Expected ready=%v, got %v", tc.ready, ready)
+			}
+		})
+	}
+}
+
+func TestIsReady(t *testing.T) {
+	testCases := []struct {
+		name        string
+		isVoter     bool
+		healthy     bool
+		healthErr   error
+		expected    bool
+		expectErr   bool
+		checkHealth bool
+	}{
+		{
+			name:        "learner is not ready",
+			isVoter:     false,
+			healthy:     true,
+			expected:    false,
+			checkHealth: false,
+		},
+		{
+			name:        "healthy voter is ready",
+			isVoter:     true,
+			healthy:     true,
+			expected:    true,
+			checkHealth: true,
+		},
+		{
+			name:        "unhealthy voter is not ready",
+			isVoter:     true,
+			healthy:     false,
+			expected:    false,
+			checkHealth: true,
+		},
+		{
+			name:        "health check error is returned",
+			isVoter:     true,
+			healthErr:   errors.New("connection refused"),
+			expected:    false,
+			expectErr:   true,
+			checkHealth: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &Cluster{}
+			e.isVoter.Store(tc.isVoter)
+
+			checked := false
+			ready, err := e.isReady(func() (bool, error) {
+				checked = true
+				return tc.healthy, tc.healthErr
+			})
+
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("Expected error=%v, got %v", tc.expectErr, err)
+			}
+
+			if ready != tc.expected {
+				t.Errorf("Expected ready=%v, got %v", tc.expected, ready)
+			}
+
+			if checked != tc.checkHealth {
+				t.Errorf("Expected health check=%v, got %v", tc.checkHealth, checked)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	metricsNamespace = "etcd_launcher"
+
+	// JobDefrag is the operation label of the defrag job in job outcome metrics.
+	JobDefrag = "defrag"
+	// JobSnapshot is the operation label of the snapshot job in job outcome metrics.
+	JobSnapshot = "snapshot"
+
+	// jobOutcomeConfigMapName is the name of the ConfigMap in the cluster namespace in which
+	// the defrag and snapshot commands store their outcome. Both run as short-lived Jobs that
+	// cannot be scraped, so the etcd-launcher running next to each etcd member exports their
+	// outcome instead. The ConfigMap is created by KKP, the etcd-launcher is only allowed to
+	// update it.
+	jobOutcomeConfigMapName = resources.EtcdLauncherJobOutcomesConfigMapName
+
+	timeoutJobOutcome = time.Second * 5
+)
+
+var (
+	memberJoins = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "member_joins_total",
+		Help:      "Number of times this member has joined the etcd cluster",
+	})
+
+	memberPromotions = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "member_promotions_total",
+		Help:      "Number of times this member has been promoted from learner to voting member",
+	})
+
+	memberRemovals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "member_removals_total",
+		Help:      "Number of members removed from the etcd cluster by this launcher",
+	}, []string{"reason"})
+
+	peerURLUpdates = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "peer_url_updates_total",
+		Help:      "Number of times the peer URLs of this member have been updated",
+	})
+
+	quorumWaitSeconds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "quorum_wait_seconds",
+		Help:      "Time spent waiting for the etcd cluster to become healthy after this member was started",
+	})
+
+	restores = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "restores_total",
+		Help:      "Number of attempts to restore the data directory of this member from a backup",
+	}, []string{"result"})
+
+	peerTLSMode = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "peer_tls_mode",
+		Help:      "Peer TLS mode of this member, 1 for the active mode",
+	}, []string{"mode"})
+
+	jobLastRunTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "job_last_run_timestamp_seconds",
+		Help:      "Unix timestamp of the last run of the defrag or snapshot job",
+	}, []string{"operation"})
+
+	jobLastRunSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "job_last_run_success",
+		Help:      "Whether the last run of the defrag or snapshot job was successful (1) or not (0)",
+	}, []string{"operation"})
+
+	jobLastSuccessTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "job_last_success_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful run of the defrag or snapshot job",
+	}, []string{"operation"})
+)
+
+// RegisterMetrics registers the etcd-launcher metrics with the given registerer.
+func RegisterMetrics(registerer prometheus.Registerer) {
+	registerer.MustRegister(
+		memberJoins,
+		memberPromotions,
+		memberRemovals,
+		peerURLUpdates,
+		quorumWaitSeconds,
+		restores,
+		peerTLSMode,
+		jobLastRunTimestamp,
+		jobLastRunSuccess,
+		jobLastSuccessTimestamp,
+	)
+}
+
+// ObserveQuorumWait records the time spent waiting for the cluster to become healthy.
+func ObserveQuorumWait(d time.Duration) {
+	quorumWaitSeconds.Set(d.Seconds())
+}
+
+func setPeerTLSModeMetric(strict bool) {
+	strictValue, mixedValue := 0.0, 1.0
+	if strict {
+		strictValue, mixedValue = 1.0, 0.0
+	}
+
+	peerTLSMode.WithLabelValues("strict").Set(strictValue)
+	peerTLSMode.WithLabelValues("mixed").Set(mixedValue)
+}
+
+type jobOutcome struct {
+	Timestamp time.Time `json:"timestamp"`
+	Success   bool      `json:"success"`
+}
+
+func jobOutcomeKey(job, kind string) string {
+	return fmt.Sprintf("%s.%s", job, kind)
+}
+
+// RecordJobOutcome stores the outcome of a defrag or snapshot run in the job outcome
+// ConfigMap, so that it can be exported by the etcd-launchers. Failing to do so is logged,
+// but not returned, as it must not affect the outcome of the job itself.
+func (e *Cluster) RecordJobOutcome(ctx context.Context, log *zap.SugaredLogger, job string, jobErr error) {
+	if err := e.recordJobOutcome(ctx, job, jobOutcome{
+		Timestamp: time.Now().UTC(),
+		Success:   jobErr == nil,
+	}); err != nil {
+		log.Warnw("failed to record job outcome", zap.Error(err))
+	}
+}
+
+func (e *Cluster) recordJobOutcome(ctx context.Context, job string, outcome jobOutcome) error {
+	encoded, err := json.Marshal(outcome)
+	if err != nil {
+		return fmt.Errorf("failed to encode job outcome: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeoutJobOutcome)
+	defer cancel()
+
+	// the defrag and snapshot jobs can finish at the same time
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		key := types.NamespacedName{Name: jobOutcomeConfigMapName, Namespace: e.namespace}
+		if err := e.clusterClient.Get(ctx, key, cm); err != nil {
+			return fmt.Errorf("failed to get job outcomes: %w", err)
+		}
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+
+		cm.Data[jobOutcomeKey(job, "last-run")] = string(encoded)
+		if outcome.Success {
+			cm.Data[jobOutcomeKey(job, "last-success")] = string(encoded)
+		}
+
+		return e.clusterClient.Update(ctx, cm)
+	})
+}
+
+// UpdateJobOutcomeMetrics reads the outcomes recorded by the defrag and snapshot
+// jobs and updates the corresponding metrics.
+func (e *Cluster) UpdateJobOutcomeMetrics(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, timeoutJobOutcome)
+	defer cancel()
+
+	cm := &corev1.ConfigMap{}
+	key := types.NamespacedName{Name: jobOutcomeConfigMapName, Namespace: e.namespace}
+	if err := e.clusterClient.Get(ctx, key, cm); err != nil {
+		// none of the jobs has run yet
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get job outcomes: %w", err)
+	}
+
+	for _, job := range []string{JobDefrag, JobSnapshot} {
+		for _, kind := range []string{"last-run", "last-success"} {
+			value, ok := cm.Data[jobOutcomeKey(job, kind)]
+
+			// the job has not run (successfully) yet
+			if !ok {
+				continue
+			}
+
+			outcome := jobOutcome{}
+			if err := json.Unmarshal([]byte(value), &outcome); err != nil {
+				return fmt.Errorf("failed to decode %s outcome of %s job: %w", kind, job, err)
+			}
+
+			timestamp := float64(outcome.Timestamp.Unix())
+
+			if kind == "last-success" {
+				jobLastSuccessTimestamp.WithLabelValues(job).Set(timestamp)
+				continue
+			}
+
+			success := 0.0
+			if outcome.Success {
+				success = 1.0
+			}
+
+			jobLastRunTimestamp.WithLabelValues(job).Set(timestamp)
+			jobLastRunSuccess.WithLabelValues(job).Set(success)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testNamespace = "cluster-abcd"
+
+func TestRecordJobOutcome(t *testing.T) {
+	testCases := []struct {
+		name         string
+		existing     map[string]string
+		job          string
+		jobErr       error
+		expectedKeys []string
+		keptKeys     map[string]string
+	}{
+		{
+			name:         "first successful run records a success",
+			job:          JobDefrag,
+			expectedKeys: []string{"defrag.last-run", "defrag.last-success"},
+		},
+		{
+			name:         "first failed run does not record a success",
+			job:          JobSnapshot,
+			jobErr:       errors.New("upload failed"),
+			expectedKeys: []string{"snapshot.last-run"},
+		},
+		{
+			name: "failed run keeps the last success and the other job's outcomes",
+			existing: map[string]string{
+				"defrag.last-run":       `{"timestamp":"2026-01-01T00:00:00Z","success":true}`,
+				"defrag.last-success":   `{"timestamp":"2026-01-01T00:00:00Z","success":true}`,
+				"snapshot.last-success": `{"timestamp":"2026-01-02T00:00:00Z","success":true}`,
+			},
+			job:          JobDefrag,
+			jobErr:       errors.New("defrag failed"),
+			expectedKeys: []string{"defrag.last-run"},
+			keptKeys: map[string]string{
+				"defrag.last-success":   `{"timestamp":"2026-01-01T00:00:00Z","success":true}`,
+				"snapshot.last-success": `{"timestamp":"2026-01-02T00:00:00Z","success":true}`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &Cluster{
+				clusterClient: fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      jobOutcomeConfigMapName,
+						Namespace: testNamespace,
+					},
+					Data: tc.existing,
+				}).Build(),
+				namespace: testNamespace,
+			}
+
+			before := time.Now().UTC().Truncate(time.Second)
+			e.RecordJobOutcome(context.Background(), zap.NewNop().Sugar(), tc.job, tc.jobErr)
+
+			cm := &corev1.ConfigMap{}
+			key := types.NamespacedName{Name: jobOutcomeConfigMapName, Namespace: testNamespace}
+			if err := e.clusterClient.Get(context.Background(), key, cm); err != nil {
+				t.Fatalf("Failed to get job outcome ConfigMap: %v", err)
+			}
+
+			if len(cm.Data) != len(tc.expectedKeys)+len(tc.keptKeys) {
+				t.Fatalf("Expected %d keys, got %v", len(tc.expectedKeys)+len(tc.keptKeys), cm.Data)
+			}
+
+			for _, key := range tc.expectedKeys {
+				outcome := jobOutcome{}
+				if err := json.Unmarshal([]byte(cm.Data[key]), &outcome); err != nil {
+					t.Fatalf("Failed to decode %q: %v", key, err)
+				}
+
+				if outcome.Success != (tc.jobErr == nil) {
+					t.Errorf("Expected %q to have success=%v, got %v", key, tc.jobErr == nil, outcome.Success)
+				}
+
+				if outcome.Timestamp.Before(before) {
+					t.Errorf("Expected %q to be recorded after %v, got %v", key, before, outcome.Timestamp)
+				}
+			}
+
+			for key, value := range tc.keptKeys {
+				if cm.Data[key] != value {
+					t.Errorf("Expected %q to be kept as %q, got %q", key, value, cm.Data[key])
+				}
+			}
+		})
+	}
+}
+
+func TestRecordJobOutcomeWithoutConfigMap(t *testing.T) {
+	e := &Cluster{
+		clusterClient: fake.NewClientBuilder().Build(),
+		namespace:     testNamespace,
+	}
+
+	err := e.recordJobOutcome(context.Background(), JobDefrag, jobOutcome{Timestamp: time.Now(), Success: true})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("Expected a NotFound error, as the ConfigMap must not be created by the job, got %v", err)
+	}
+}
+
+func TestUpdateJobOutcomeMetrics(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expectErr   bool
+		lastRun     map[string]float64
+		lastSuccess map[string]float64
+		success     map[string]float64
+	}{
+		{
+			name: "no job has run yet",
+		},
+		{
+			name: "failed run after a successful one",
+			data: map[string]string{
+				"defrag.last-run":       `{"timestamp":"2026-01-02T00:00:00Z","success":false}`,
+				"defrag.last-success":   `{"timestamp":"2026-01-01T00:00:00Z","success":true}`,
+				"snapshot.last-run":     `{"timestamp":"2026-01-03T00:00:00Z","success":true}`,
+				"snapshot.last-success": `{"timestamp":"2026-01-03T00:00:00Z","success":true}`,
+			},
+			lastRun:     map[string]float64{JobDefrag: 1767312000, JobSnapshot: 1767398400},
+			lastSuccess: map[string]float64{JobDefrag: 1767225600, JobSnapshot: 1767398400},
+			success:     map[string]float64{JobDefrag: 0, JobSnapshot: 1},
+		},
+		{
+			name: "job has never succeeded",
+			data: map[string]string{
+				"snapshot.last-run": `{"timestamp":"2026-01-03T00:00:00Z","success":false}`,
+			},
+			lastRun: map[string]float64{JobSnapshot: 1767398400},
+			success: map[string]float64{JobSnapshot: 0},
+		},
+		{
+			name: "invalid outcome",
+			data: map[string]string{
+				"defrag.last-run": `not-json`,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jobLastRunTimestamp.Reset()
+			jobLastRunSuccess.Reset()
+			jobLastSuccessTimestamp.Reset()
+
+			builder := fake.NewClientBuilder()
+			if tc.data != nil {
+				builder.WithObjects(&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      jobOutcomeConfigMapName,
+						Namespace: testNamespace,
+					},
+					Data: tc.data,
+				})
+			}
+
+			e := &Cluster{
+				clusterClient: builder.Build(),
+				namespace:     testNamespace,
+			}
+
+			err := e.UpdateJobOutcomeMetrics(context.Background())
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if count := testutil.CollectAndCount(jobLastRunTimestamp); count != len(tc.lastRun) {
+				t.Errorf("Expected %d last run timestamps, got %d", len(tc.lastRun), count)
+			}
+			if count := testutil.CollectAndCount(jobLastSuccessTimestamp); count != len(tc.lastSuccess) {
+				t.Errorf("Expected %d last success timestamps, got %d", len(tc.lastSuccess), count)
+			}
+
+			for job, expected := range tc.lastRun {
+				if value := testutil.ToFloat64(jobLastRunTimestamp.WithLabelValues(job)); value != expected {
+					t.Errorf("Expected last run timestamp of %s to be %v, got %v", job, expected, value)
+				}
+			}
+			for job, expected := range tc.lastSuccess {
+				if value := testutil.ToFloat64(jobLastSuccessTimestamp.WithLabelValues(job)); value != expected {
+					t.Errorf("Expected last success timestamp of %s to be %v, got %v", job, expected, value)
+				}
+			}
+			for job, expected := range tc.success {
+				if value := testutil.ToFloat64(jobLastRunSuccess.WithLabelValues(job)); value != expected {
+					t.Errorf("Expected last run success of %s to be %v, got %v", job, expected, value)
+				}
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package etcd
 import (
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -28,6 +29,9 @@ import (
 	client "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/util/backupencryption"
 )
 
 type SnapshotOptions struct {
@@ -132,3 +136,151 @@ func DecompressSnapshot(filename string) (string, error) {
 		return "", fmt.Errorf("unsupported backup file extension %q", ext)
 	}
 }
+
+// EncryptSnapshot encrypts the given snapshot file in place.
+func EncryptSnapshot(filename string, key []byte) error {
+	return transformSnapshot(filename, func(dst io.Writer, src io.Reader) error {
+		return backupencryption.Encrypt(dst, src, key)
+	})
+}
+
+// DecryptSnapshotIfNeeded decrypts the given snapshot file in place if it is encrypted.
+// Plain snapshots are left untouched, so that backups created before encryption was
+// enabled can still be restored. An error is returned if the snapshot is encrypted,
+// but no key is given.
+func DecryptSnapshotIfNeeded(filename string, key []byte) error {
+	encrypted, err := isSnapshotEncrypted(filename)
+	if err != nil {
+		return err
+	}
+
+	if !encrypted {
+		return nil
+	}
+
+	if key == nil {
+		return errors.New("snapshot is encrypted, but no encryption key is configured")
+	}
+
+	return transformSnapshot(filename, func(dst io.Writer, src io.Reader) error {
+		return backupencryption.Decrypt(dst, src, key)
+	})
+}
+
+func isSnapshotEncrypted(filename string) (bool, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	header := make([]byte, backupencryption.PrefixSize())
+	if _, err := io.ReadFull(f, header); err != nil {
+		// files shorter than the header cannot be encrypted
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return backupencryption.IsEncrypted(header), nil
+}
+
+// transformSnapshot rewrites the given file using transform and replaces the
+// original file only if the transformation was successful.
+func transformSnapshot(filename string, transform func(dst io.Writer, src io.Reader) error) error {
+	src, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmpFile := filename + ".tmp"
+	defer os.Remove(tmpFile)
+
+	dst, err := os.Create(tmpFile)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	if err := transform(dst, src); err != nil {
+		return err
+	}
+
+	if err := dst.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile, filename)
+}
+
+// VerifySnapshot checks the integrity of the given (possibly compressed) snapshot and
+// restores it into a throwaway data directory inside workDir. The restored database is
+// then compared against the snapshot to ensure that no data was lost.
+func VerifySnapshot(log *zap.SugaredLogger, filename string, workDir string) (*kubermaticv1.BackupVerification, error) {
+	rawFilename, err := DecompressSnapshot(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+
+	sp := snapshot.NewV3(log.Desugar())
+
+	// Status checks the integrity of the bbolt database before calculating its hash
+	snapshotStatus, err := sp.Status(rawFilename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot status: %w", err)
+	}
+
+	if snapshotStatus.TotalKey == 0 {
+		return nil, errors.New("snapshot does not contain any keys")
+	}
+
+	dataDir, err := os.MkdirTemp(workDir, "restore-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary data directory: %w", err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	// Restore refuses to write into existing directories
+	dataDir = filepath.Join(dataDir, "etcd")
+
+	const memberName = "verify"
+	peerURL := "http://localhost:2380"
+
+	if err := sp.Restore(snapshot.RestoreConfig{
+		SnapshotPath:        rawFilename,
+		Name:                memberName,
+		OutputDataDir:       dataDir,
+		OutputWALDir:        filepath.Join(dataDir, "member", "wal"),
+		PeerURLs:            []string{peerURL},
+		InitialCluster:      fmt.Sprintf("%s=%s", memberName, peerURL),
+		InitialClusterToken: memberName,
+		SkipHashCheck:       false,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to restore snapshot: %w", err)
+	}
+
+	// the restore adds cluster membership information to the database, so its hash
+	// cannot be compared, but all keys and the revision must have been preserved
+	restoredStatus, err := sp.Status(filepath.Join(dataDir, "member", "snap", "db"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status of restored database: %w", err)
+	}
+
+	if restoredStatus.Revision != snapshotStatus.Revision {
+		return nil, fmt.Errorf("restored database has revision %d, but snapshot has revision %d", restoredStatus.Revision, snapshotStatus.Revision)
+	}
+
+	if restoredStatus.TotalKey != snapshotStatus.TotalKey {
+		return nil, fmt.Errorf("restored database contains %d keys, but snapshot contains %d keys", restoredStatus.TotalKey, snapshotStatus.TotalKey)
+	}
+
+	return &kubermaticv1.BackupVerification{
+		Revision: snapshotStatus.Revision,
+		KeyCount: int64(snapshotStatus.TotalKey),
+		Hash:     fmt.Sprintf("%08x", snapshotStatus.Hash),
+		Size:     snapshotStatus.TotalSize,
+	}, nil
+}
@@ -51,8 +51,9 @@ func main() {
 	kubeconfigFlag := flag.String("kubeconfig", "", "Path to a kubeconfig.")
 	master := flag.String("master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig")
 	networkFlag := flag.String("node-access-network", "", "The network in CIDR notation to translate to.")
-	chainNameFlag := flag.String("chain-name", "node-access-dnat", "Name of the chain in nat table.")
+	chainNameFlag := flag.String("chain-name", "node-access-dnat", "Name of the chain in nat table (iptables) or of the table (nftables).")
 	vpnInterfaceFlag := flag.String("vpn-interface", "tun0", "Name of the vpn interface.")
+	backendFlag := flag.String("backend", string(kubeletdnatcontroller.BackendAuto), "Backend used to install the NAT rules, one of auto, iptables or nftables. auto uses legacy iptables if the host's nat rules are installed with it and nftables otherwise.")
 	flag.Parse()
 
 	rawLog := kubermaticlog.New(logOpts.Debug, logOpts.Format)
@@ -112,7 +113,7 @@ func main() {
 		log.Fatalw("Failed to create manager", zap.Error(err))
 	}
 
-	if err := kubeletdnatcontroller.Add(mgr, *chainNameFlag, nodeAccessNetwork, log, *vpnInterfaceFlag); err != nil {
+	if err := kubeletdnatcontroller.Add(mgr, *chainNameFlag, nodeAccessNetwork, log, *vpnInterfaceFlag, kubeletdnatcontroller.Backend(*backendFlag)); err != nil {
 		log.Fatalw("Failed to add the kubelet dnat controller", zap.Error(err))
 	}
 
@@ -37,6 +37,7 @@ import (
 	clustermutation "k8c.io/kubermatic/v2/pkg/webhook/cluster/mutation"
 	clustervalidation "k8c.io/kubermatic/v2/pkg/webhook/cluster/validation"
 	clustertemplatevalidation "k8c.io/kubermatic/v2/pkg/webhook/clustertemplate/validation"
+	clustertemplateinstancevalidation "k8c.io/kubermatic/v2/pkg/webhook/clustertemplateinstance/validation"
 	externalclustermutation "k8c.io/kubermatic/v2/pkg/webhook/externalcluster/mutation"
 	groupprojectbinding "k8c.io/kubermatic/v2/pkg/webhook/groupprojectbinding/validation"
 	ipampoolvalidation "k8c.io/kubermatic/v2/pkg/webhook/ipampool/validation"
@@ -45,6 +46,7 @@ import (
 	policieswebhook "k8c.io/kubermatic/v2/pkg/webhook/policies"
 	policytemplatevalidation "k8c.io/kubermatic/v2/pkg/webhook/policytemplate/validation"
 	resourcequotavalidation "k8c.io/kubermatic/v2/pkg/webhook/resourcequota/validation"
+	rulegroupvalidation "k8c.io/kubermatic/v2/pkg/webhook/rulegroup/validation"
 	seedwebhook "k8c.io/kubermatic/v2/pkg/webhook/seed"
 	uservalidation "k8c.io/kubermatic/v2/pkg/webhook/user/validation"
 	usersshkeymutation "k8c.io/kubermatic/v2/pkg/webhook/usersshkey/mutation"
@@ -177,6 +179,14 @@ func main() {
 		log.Fatalw("Failed to setup cluster validation webhook", zap.Error(err))
 	}
 
+	// /////////////////////////////////////////
+	// setup ClusterTemplateInstance webhooks
+
+	clusterTemplateInstanceValidator := clustertemplateinstancevalidation.NewValidator(mgr.GetClient())
+	if err := builder.WebhookManagedBy(mgr).For(&kubermaticv1.ClusterTemplateInstance{}).WithValidator(clusterTemplateInstanceValidator).Complete(); err != nil {
+		log.Fatalw("Failed to setup cluster template instance validation webhook", zap.Error(err))
+	}
+
 	// /////////////////////////////////////////
 	// setup Addon webhook
 
@@ -238,6 +248,14 @@ func main() {
 		log.Fatalw("Failed to setup GroupProjectBinding validation webhook", zap.Error(err))
 	}
 
+	// /////////////////////////////////////////
+	// setup RuleGroup webhook
+
+	ruleGroupValidator := rulegroupvalidation.NewValidator()
+	if err := builder.WebhookManagedBy(mgr).For(&kubermaticv1.RuleGroup{}).WithValidator(ruleGroupValidator).Complete(); err != nil {
+		log.Fatalw("Failed to setup RuleGroup validation webhook", zap.Error(err))
+	}
+
 	// /////////////////////////////////////////
 	// setup PolicyTemplate webhook
 
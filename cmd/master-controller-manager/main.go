@@ -202,6 +202,9 @@ func main() {
 	log.Debug("Starting projects collector")
 	collectors.MustRegisterProjectCollector(prometheus.DefaultRegisterer, ctrlCtx.mgr.GetAPIReader())
 
+	log.Debug("Starting resource quotas collector")
+	collectors.MustRegisterResourceQuotaCollector(prometheus.DefaultRegisterer, ctrlCtx.mgr.GetAPIReader())
+
 	log.Debug("Starting seeds collector")
 	collectors.MustRegisterSeedCollector(prometheus.DefaultRegisterer, ctrlCtx.mgr.GetAPIReader())
 
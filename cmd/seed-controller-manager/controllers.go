@@ -26,6 +26,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/addoninstaller"
 	applicationsecretclustercontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/application-secret-cluster-controller"
 	autoupdatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/auto-update-controller"
+	carotationcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/ca-rotation-controller"
 	cloudcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cloud"
 	clustercredentialscontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-credentials-controller"
 	clusterphasecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-phase-controller"
@@ -35,6 +36,7 @@ import (
 	seedconstraintsynchronizer "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/constraint-controller"
 	constrainttemplatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/constraint-template-controller"
 	defaultapplicationcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/default-application-controller"
+	deletionreportcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/deletion-report-controller"
 	encryptionatrestcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/encryption-at-rest-controller"
 	etcdbackupcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/etcdbackup"
 	etcdrestorecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/etcdrestore"
@@ -49,6 +51,7 @@ import (
 	presetcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/preset-controller"
 	projectcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/project"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/pvwatcher"
+	scheduleddeletioncontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/scheduled-deletion-controller"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/seedresourcesuptodatecondition"
 	updatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/update-controller"
 	"k8c.io/kubermatic/v2/pkg/features"
@@ -80,6 +83,9 @@ var AllControllers = map[string]controllerCreator{
 	clusterphasecontroller.ControllerName:                   createClusterPhaseController,
 	presetcontroller.ControllerName:                         createPresetController,
 	encryptionatrestcontroller.ControllerName:               createEncryptionAtRestController,
+	carotationcontroller.ControllerName:                     createCARotationController,
+	scheduleddeletioncontroller.ControllerName:              createScheduledDeletionController,
+	deletionreportcontroller.ControllerName:                 createDeletionReportController,
 	ipam.ControllerName:                                     createIPAMController,
 	clusterstuckcontroller.ControllerName:                   createClusterStuckController,
 	operatingsystemprofilesynchronizer.ControllerName:       createOperatingSystemProfileController,
@@ -176,6 +182,7 @@ func createProjectController(ctrlCtx *controllerContext) error {
 		ctrlCtx.mgr,
 		ctrlCtx.log,
 		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.seedGetter,
 	)
 }
 
@@ -422,6 +429,37 @@ func createEncryptionAtRestController(ctrlCtx *controllerContext) error {
 	)
 }
 
+func createCARotationController(ctrlCtx *controllerContext) error {
+	return carotationcontroller.Add(
+		ctrlCtx.mgr,
+		ctrlCtx.log,
+		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.runOptions.workerName,
+		ctrlCtx.clientProvider,
+		ctrlCtx.versions,
+	)
+}
+
+func createScheduledDeletionController(ctrlCtx *controllerContext) error {
+	return scheduleddeletioncontroller.Add(
+		ctrlCtx.mgr,
+		ctrlCtx.log,
+		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.runOptions.workerName,
+		ctrlCtx.seedGetter,
+	)
+}
+
+func createDeletionReportController(ctrlCtx *controllerContext) error {
+	return deletionreportcontroller.Add(
+		ctrlCtx.mgr,
+		ctrlCtx.log,
+		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.runOptions.workerName,
+		ctrlCtx.clientProvider,
+	)
+}
+
 func createIPAMController(ctrlCtx *controllerContext) error {
 	return ipam.Add(
 		ctrlCtx.mgr,
@@ -41,6 +41,7 @@ import (
 	metricserver "k8c.io/kubermatic/v2/pkg/metrics/server"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
 	"k8c.io/kubermatic/v2/pkg/util/cli"
 	"k8c.io/kubermatic/v2/pkg/util/flagopts"
@@ -91,6 +92,9 @@ func main() {
 	ctrlruntimelog.SetLogger(zapr.NewLogger(rawLog.WithOptions(zap.AddCallerSkip(1))))
 	reconciling.Configure(log)
 
+	// all certificate reconcilers in this process share the same renewal threshold
+	resources.SetCertificateRenewalThreshold(options.certRenewalThreshold)
+
 	// make sure the logging flags actually affect the global (deprecated) logger instance
 	kubermaticlog.Logger = log
 
@@ -250,6 +254,13 @@ Please install the VerticalPodAutoscaler according to the documentation: https:/
 		log.Debug("Starting addons collector")
 		collectors.MustRegisterAddonCollector(prometheus.DefaultRegisterer, ctrlCtx.mgr.GetAPIReader())
 	}
+	if !slices.Contains(disabledCollectors, string(kubermaticv1.CertificateCollector)) {
+		// Listing all Secrets on every scrape is too expensive for the API server, so unlike the
+		// other collectors this one uses the cache-backed client. The controllers already watch
+		// Secrets, so this does not add another informer.
+		log.Debug("Starting certificates collector")
+		collectors.MustRegisterCertificateCollector(prometheus.DefaultRegisterer, ctrlCtx.mgr.GetClient())
+	}
 	if !slices.Contains(disabledCollectors, string(kubermaticv1.ProjectCollector)) {
 		// The canonical source of projects is the master cluster, but since they are replicated onto
 		// seeds, we start the project collctor on seed clusters as well, just for convenience for the admin.
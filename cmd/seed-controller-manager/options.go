@@ -58,6 +58,7 @@ type controllerRunOptions struct {
 	addonsPath               string
 	backupInterval           time.Duration
 	backupCount              int
+	certRenewalThreshold     time.Duration
 	etcdDiskSize             resource.Quantity
 	dockerPullConfigJSONFile string
 	kubermaticImage          string
@@ -126,6 +127,7 @@ func newControllerRunOptions() (controllerRunOptions, error) {
 	flag.StringVar(&c.addonsPath, "addons-path", "/opt/addons", "Path to addon manifests. Should contain sub-folders for each addon")
 	flag.DurationVar(&c.backupInterval, "backup-interval", defaulting.DefaultBackupInterval, "Interval in which the etcd gets backed up")
 	flag.IntVar(&c.backupCount, "backup-count", kubermaticv1.DefaultKeptBackupsCount, "Number of backups to keep around before deleting the oldest one")
+	flag.DurationVar(&c.certRenewalThreshold, "certificate-renewal-threshold", resources.DefaultCertificateRenewalThreshold, "Remaining validity below which control plane certificates are renewed")
 	flag.StringVar(&rawEtcdDiskSize, "etcd-disk-size", "5Gi", "Size for the etcd PV's. Only applies to new clusters.")
 	flag.StringVar(&c.dockerPullConfigJSONFile, "docker-pull-config-json-file", "", "The file containing the docker auth config.")
 	flag.Var(&c.featureGates, "feature-gates", "A set of key=value pairs that describe feature gates for various features.")
@@ -209,6 +211,10 @@ func (o controllerRunOptions) validate() error {
 		return fmt.Errorf("seed-name is undefined")
 	}
 
+	if o.certRenewalThreshold <= 0 || o.certRenewalThreshold > resources.MaximumCertificateRenewalThreshold {
+		return fmt.Errorf("certificate-renewal-threshold must be greater than 0 and at most %v", resources.MaximumCertificateRenewalThreshold)
+	}
+
 	return nil
 }
 
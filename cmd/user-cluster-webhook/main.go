@@ -32,8 +32,11 @@ import (
 	applicationinstallationmutation "k8c.io/kubermatic/v2/pkg/webhook/application/applicationinstallation/mutation"
 	applicationinstallationvalidation "k8c.io/kubermatic/v2/pkg/webhook/application/applicationinstallation/validation"
 	machinevalidation "k8c.io/kubermatic/v2/pkg/webhook/machine/validation"
+	machinedeploymentvalidation "k8c.io/kubermatic/v2/pkg/webhook/machinedeployment/validation"
+	servicevalidation "k8c.io/kubermatic/v2/pkg/webhook/service/validation"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
@@ -127,10 +130,10 @@ func main() {
 	applicationinstallationmutation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient()).SetupWebhookWithManager(seedMgr)
 
 	// Setup the validation admission handler for ApplicationInstallation CRDs in seed manager.
-	applicationinstallationvalidation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient(), options.clusterName).SetupWebhookWithManager(seedMgr)
+	applicationinstallationvalidation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient(), userMgr.GetAPIReader(), options.clusterName).SetupWebhookWithManager(seedMgr)
 
 	// Setup Machine Webhook in user manager.
-	machineValidator, err := machinevalidation.NewValidator(seedMgr.GetClient(), userMgr.GetClient(), log, options.caBundle, options.projectID)
+	machineValidator, err := machinevalidation.NewValidator(seedMgr.GetClient(), userMgr.GetClient(), log, options.caBundle, options.projectID, options.datacenter)
 	if err != nil {
 		log.Fatalw("Failed to setup Machine validator", zap.Error(err))
 	}
@@ -138,6 +141,21 @@ func main() {
 		log.Fatalw("Failed to setup Machine validation webhook", zap.Error(err))
 	}
 
+	// Setup MachineDeployment Webhook in user manager.
+	machineDeploymentValidator := machinedeploymentvalidation.NewValidator(seedMgr.GetClient(), userMgr.GetClient(), log, options.caBundle, options.projectID, options.datacenter)
+	if err := builder.WebhookManagedBy(userMgr).For(&clusterv1alpha1.MachineDeployment{}).WithValidator(machineDeploymentValidator).Complete(); err != nil {
+		log.Fatalw("Failed to setup MachineDeployment validation webhook", zap.Error(err))
+	}
+
+	// Setup Service Webhook in user manager.
+	serviceValidator, err := servicevalidation.NewValidator(seedMgr.GetClient(), log, options.projectID, options.datacenter)
+	if err != nil {
+		log.Fatalw("Failed to setup Service validator", zap.Error(err))
+	}
+	if err := builder.WebhookManagedBy(userMgr).For(&corev1.Service{}).WithValidator(serviceValidator).Complete(); err != nil {
+		log.Fatalw("Failed to setup Service validation webhook", zap.Error(err))
+	}
+
 	// /////////////////////////////////////////
 	// Start managers
 
@@ -36,6 +36,7 @@ type appOptions struct {
 	caBundle    *certificates.CABundle
 	projectID   string
 	clusterName string
+	datacenter  string
 }
 
 func initApplicationOptions() (appOptions, error) {
@@ -54,9 +55,11 @@ func initApplicationOptions() (appOptions, error) {
 	var caBundleFile string
 	var projectID string
 	var clusterName string
+	var datacenter string
 	flag.StringVar(&caBundleFile, "ca-bundle", "", "File containing the PEM-encoded CA bundle for all userclusters")
 	flag.StringVar(&projectID, "project-id", "", "Project ID in which cluster the webhook is running in")
 	flag.StringVar(&clusterName, "cluster-name", "", "Cluster name in which the webhook is running in")
+	flag.StringVar(&datacenter, "datacenter", "", "Datacenter of the cluster in which the webhook is running in")
 	flag.Parse()
 
 	caBundle, err := certificates.NewCABundleFromFile(caBundleFile)
@@ -65,6 +68,8 @@ func initApplicationOptions() (appOptions, error) {
 	}
 	c.caBundle = caBundle
 	c.projectID = projectID
+	c.clusterName = clusterName
+	c.datacenter = datacenter
 
 	if err := c.userWebhook.Validate(); err != nil {
 		return c, fmt.Errorf("invalid user cluster webhook configuration: %w", err)
@@ -321,6 +321,7 @@ func createExampleKubermaticConfiguration() *kubermaticv1.KubermaticConfiguratio
 			SeedController: kubermaticv1.KubermaticSeedControllerConfiguration{
 				BackupStoreContainer:  defaulting.DefaultBackupStoreContainer,
 				BackupDeleteContainer: defaulting.DefaultBackupDeleteContainer,
+				BackupObjectName:      defaulting.DefaultBackupObjectName,
 			},
 		},
 	}
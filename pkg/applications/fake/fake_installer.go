@@ -38,6 +38,9 @@ type ApplicationInstallerRecorder struct {
 
 	// DeleteEvents stores the call to delete function. Key is the name of the applicationInstallation.
 	DeleteEvents sync.Map
+
+	// CheckDriftEvents stores the call to checkDrift function. Key is the name of the applicationInstallation.
+	CheckDriftEvents sync.Map
 }
 
 func (a *ApplicationInstallerRecorder) GetAppCache() string {
@@ -68,6 +71,11 @@ func (a *ApplicationInstallerRecorder) Rollback(ctx context.Context, log *zap.Su
 	return nil
 }
 
+func (a *ApplicationInstallerRecorder) CheckDrift(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	a.CheckDriftEvents.Store(applicationInstallation.Name, *applicationInstallation.DeepCopy())
+	return util.NoStatusUpdate, nil
+}
+
 // ApplicationInstallerLogger is a fake ApplicationInstaller that just logs actions. it's used for the development of the controller.
 type ApplicationInstallerLogger struct {
 }
@@ -100,6 +108,11 @@ func (a ApplicationInstallerLogger) Rollback(ctx context.Context, log *zap.Sugar
 	return nil
 }
 
+func (a ApplicationInstallerLogger) CheckDrift(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	log.Debugf("Check drift of application %s. applicationVersion=%v", applicationInstallation.Name, applicationInstallation.Status.ApplicationVersion)
+	return util.NoStatusUpdate, nil
+}
+
 // CustomApplicationInstaller is an applicationInstaller in which every function can be independently mocked.
 // If a function is not mocked, then default values are returned.
 type CustomApplicationInstaller struct {
@@ -107,6 +120,7 @@ type CustomApplicationInstaller struct {
 	DownloadSourceFunc func(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation, downloadDest string) (string, error)
 	ApplyFunc          func(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) (util.StatusUpdater, error)
 	DeleteFunc         func(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error)
+	CheckDriftFunc     func(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error)
 }
 
 func (c CustomApplicationInstaller) GetAppCache() string {
@@ -146,3 +160,10 @@ func (c CustomApplicationInstaller) Rollback(ctx context.Context, log *zap.Sugar
 	// NOOP
 	return nil
 }
+
+func (c CustomApplicationInstaller) CheckDrift(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	if c.CheckDriftFunc != nil {
+		return c.CheckDriftFunc(ctx, log, seedClient, userClient, appDefinition, applicationInstallation)
+	}
+	return util.NoStatusUpdate, nil
+}
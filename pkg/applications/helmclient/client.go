@@ -254,13 +254,21 @@ func NewClient(ctx context.Context, restClientGetter genericclioptions.RESTClien
 // DownloadChart from url into dest folder and return the chart location (eg /tmp/foo/apache-1.0.0.tgz)
 // The dest folder must exist.
 func (h HelmClient) DownloadChart(url string, chartName string, version string, dest string, auth AuthSettings) (string, error) {
+	return h.DownloadVerifiedChart(url, chartName, version, dest, auth, VerifySettings{})
+}
+
+// DownloadVerifiedChart from url into dest folder, verifies it according to the VerifySettings and return the chart
+// location (eg /tmp/foo/apache-1.0.0.tgz). If the chart can not be verified, a *ChartVerificationError is returned.
+// The dest folder must exist.
+func (h HelmClient) DownloadVerifiedChart(url string, chartName string, version string, dest string, auth AuthSettings, verify VerifySettings) (string, error) {
 	var repoName string
 	var err error
 
 	// For oci/oci+* schemes, the repo does not need to be downloaded beforehand,
 	// but the scheme modifiers need to be removed from the repo name to not confuse
 	// Helm.
-	if strings.HasPrefix(url, "oci://") {
+	isOCI := strings.HasPrefix(url, "oci://")
+	if isOCI {
 		repoName = url
 	} else {
 		repoName, err = h.ensureRepository(url, auth)
@@ -273,10 +281,18 @@ func (h HelmClient) DownloadChart(url string, chartName string, version string,
 	if err != nil {
 		return "", err
 	}
+
+	// The provenance file is only downloaded by Helm, the verification is done below so that failed
+	// verifications can be told apart from failed downloads.
+	verifyStrategy := downloader.VerifyNever
+	if verify.Keyring != "" && !isOCI {
+		verifyStrategy = downloader.VerifyLater
+	}
+
 	var out strings.Builder
 	chartDownloader := downloader.ChartDownloader{
 		Out:              &out,
-		Verify:           downloader.VerifyNever,
+		Verify:           verifyStrategy,
 		RepositoryConfig: h.settings.RepositoryConfig,
 		RepositoryCache:  h.settings.RepositoryCache,
 		Getters:          h.getterProviders,
@@ -284,7 +300,6 @@ func (h HelmClient) DownloadChart(url string, chartName string, version string,
 		Options:          options,
 	}
 
-	// todo note: we may want to check the verificaton return by chartDownloader.DownloadTo. for the moment it's set to downloader.VerifyNever in struct init
 	chartRef := repoName + "/" + chartName
 	chartLoc, _, err := chartDownloader.DownloadTo(chartRef, version, dest)
 	if err != nil {
@@ -292,6 +307,10 @@ func (h HelmClient) DownloadChart(url string, chartName string, version string,
 		return "", err
 	}
 
+	if err := h.verifyChart(chartLoc, url, chartName, version, auth, verify); err != nil {
+		return "", err
+	}
+
 	h.logger.Debugw("successfully downloaded chart", "chart", chartRef, "version", version, "log", out.String())
 	return chartLoc, nil
 }
@@ -460,6 +479,19 @@ func (h HelmClient) Uninstall(releaseName string) (*release.UninstallReleaseResp
 	return uninstallReleaseResponse, err
 }
 
+// GetDeployedRelease returns the last successfully deployed revision of the release or nil if the release
+// has never been deployed.
+func (h HelmClient) GetDeployedRelease(releaseName string) (*release.Release, error) {
+	deployedRelease, err := h.actionConfig.Releases.Deployed(releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) || errors.Is(err, driver.ErrNoDeployedReleases) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not fetch last successful release %q: %w", releaseName, err)
+	}
+	return deployedRelease, nil
+}
+
 // GetMetadata wraps helms GetMetadata command to be used with our ActionConfig.
 func (h HelmClient) GetMetadata(releaseName string) (*action.Metadata, error) {
 	client := action.NewGetMetadata(h.actionConfig)
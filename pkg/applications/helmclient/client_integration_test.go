@@ -867,6 +867,105 @@ func TestDownloadChart(t *testing.T) {
 	}
 }
 
+func TestDownloadVerifiedChart(t *testing.T) {
+	log := kubermaticlog.New(true, kubermaticlog.FormatJSON).Sugar()
+	chartArchiveDir := t.TempDir()
+
+	chartArchiveV1Path, _ := test.PackageChart(t, "testdata/examplechart", chartArchiveDir)
+	test.PackageChart(t, "testdata/examplechart-v2", chartArchiveDir)
+	chartArchive2Path, _ := test.PackageChart(t, "testdata/examplechart2", chartArchiveDir)
+
+	// examplechart 0.2.0 has no provenance file.
+	keyring := test.SignChartProvenance(t, chartArchiveV1Path)
+	otherKeyring := test.SignChartProvenance(t, chartArchive2Path)
+
+	// The provenance files are served next to the charts.
+	httpRegistryURL := test.StartHTTPRegistryWithCleanup(t, path.Join(chartArchiveDir, "*"))
+
+	// examplechart 0.2.0 is not signed.
+	ociRegistryURL := test.StartOciRegistry(t, path.Join(chartArchiveDir, "*.tgz"))
+	publicKey := test.SignOciChart(t, ociRegistryURL, "examplechart", "0.1.0")
+	otherPublicKey := test.SignOciChart(t, ociRegistryURL, "examplechart2", "0.1.0")
+
+	testCases := []struct {
+		name                  string
+		repoURL               string
+		chartVersion          string
+		auth                  AuthSettings
+		verify                VerifySettings
+		wantVerificationError bool
+	}{
+		{
+			name:         "Chart with valid provenance file should be downloaded",
+			repoURL:      httpRegistryURL,
+			chartVersion: "0.1.0",
+			verify:       VerifySettings{Keyring: keyring},
+		},
+		{
+			name:                  "Chart with provenance file signed by another key should be rejected",
+			repoURL:               httpRegistryURL,
+			chartVersion:          "0.1.0",
+			verify:                VerifySettings{Keyring: otherKeyring},
+			wantVerificationError: true,
+		},
+		{
+			name:                  "Chart without provenance file should be rejected",
+			repoURL:               httpRegistryURL,
+			chartVersion:          "0.2.0",
+			verify:                VerifySettings{Keyring: keyring},
+			wantVerificationError: true,
+		},
+		{
+			name:         "Chart with valid cosign signature should be downloaded",
+			repoURL:      ociRegistryURL,
+			chartVersion: "0.1.0",
+			auth:         AuthSettings{PlainHTTP: true},
+			verify:       VerifySettings{CosignPublicKey: publicKey, IgnoreTransparencyLog: true},
+		},
+		{
+			name:                  "Chart with cosign signature of another key should be rejected",
+			repoURL:               ociRegistryURL,
+			chartVersion:          "0.1.0",
+			auth:                  AuthSettings{PlainHTTP: true},
+			verify:                VerifySettings{CosignPublicKey: otherPublicKey, IgnoreTransparencyLog: true},
+			wantVerificationError: true,
+		},
+		{
+			name:                  "Chart without cosign signature should be rejected",
+			repoURL:               ociRegistryURL,
+			chartVersion:          "0.2.0",
+			auth:                  AuthSettings{PlainHTTP: true},
+			verify:                VerifySettings{CosignPublicKey: publicKey, IgnoreTransparencyLog: true},
+			wantVerificationError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			downloadDest := t.TempDir()
+			settings := NewSettings(downloadDest)
+
+			tf := cmdtesting.NewTestFactory().WithNamespace(defaultNs)
+			defer tf.Cleanup()
+
+			helmClient, err := NewClient(context.Background(), tf, settings, defaultNs, log)
+			if err != nil {
+				t.Fatalf("can not init helm Client: %s", err)
+			}
+
+			_, err = helmClient.DownloadVerifiedChart(tc.repoURL, "examplechart", tc.chartVersion, downloadDest, tc.auth, tc.verify)
+
+			var verificationErr *ChartVerificationError
+			if tc.wantVerificationError {
+				if !errors.As(err, &verificationErr) {
+					t.Fatalf("DownloadVerifiedChart() should fail with a ChartVerificationError, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("DownloadVerifiedChart() failed: %v", err)
+			}
+		})
+	}
+}
+
 func TestBuildDependencies(t *testing.T) {
 	log := kubermaticlog.New(true, kubermaticlog.FormatJSON).Sugar()
 
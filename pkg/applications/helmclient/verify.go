@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helmclient
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/docker/cli/cli/config"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/cmd/cosign/cli/fulcio"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	cosignsignature "github.com/sigstore/cosign/v2/pkg/signature"
+	rekorclient "github.com/sigstore/rekor/pkg/client"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/registry"
+
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+)
+
+// rekorURL is the URL of the public Rekor transparency log instance.
+const rekorURL = "https://rekor.sigstore.dev"
+
+// VerifySettings holds the settings to verify a chart after it has been downloaded.
+// If no setting is defined, the chart is not verified.
+type VerifySettings struct {
+	// Keyring is the path to the OpenPGP keyring used to verify the provenance file (.prov) of a chart
+	// downloaded from an HTTP(S) repository.
+	Keyring string
+
+	// CosignPublicKey is the PEM-encoded public key used to verify the cosign signature of a chart
+	// downloaded from an OCI registry.
+	CosignPublicKey []byte
+
+	// CosignIssuer and CosignSubject are the identity of the signer used to verify the cosign keyless
+	// signature of a chart downloaded from an OCI registry.
+	CosignIssuer  string
+	CosignSubject string
+
+	// IgnoreTransparencyLog disables the verification of the Rekor transparency log entry of cosign signatures.
+	IgnoreTransparencyLog bool
+}
+
+func (v VerifySettings) cosignEnabled() bool {
+	return len(v.CosignPublicKey) > 0 || v.CosignIssuer != ""
+}
+
+// ChartVerificationError is returned if the signature or provenance of a chart can not be verified.
+type ChartVerificationError struct {
+	Err error
+}
+
+func (e *ChartVerificationError) Error() string {
+	return fmt.Sprintf("chart verification failed: %v", e.Err)
+}
+
+func (e *ChartVerificationError) Unwrap() error {
+	return e.Err
+}
+
+// verifyChart verifies the chart located at chartLoc according to the VerifySettings.
+func (h HelmClient) verifyChart(chartLoc string, url string, chartName string, version string, auth AuthSettings, verify VerifySettings) error {
+	isOCI := strings.HasPrefix(url, "oci://")
+
+	switch {
+	case verify.Keyring != "":
+		if isOCI {
+			return &ChartVerificationError{Err: errors.New("provenance verification is not supported for OCI registries")}
+		}
+
+		if _, err := downloader.VerifyChart(chartLoc, verify.Keyring); err != nil {
+			return &ChartVerificationError{Err: err}
+		}
+
+	case verify.cosignEnabled():
+		if !isOCI {
+			return &ChartVerificationError{Err: errors.New("cosign verification is only supported for OCI registries")}
+		}
+
+		return h.verifyCosignSignature(chartLoc, strings.TrimPrefix(url, "oci://")+"/"+chartName, version, auth, verify)
+	}
+
+	return nil
+}
+
+// verifyCosignSignature verifies that the manifest of the chart in the OCI registry is signed and that the downloaded chart is
+// the one referenced by the signed manifest. Otherwise the chart could have been replaced between the download and the verification.
+func (h HelmClient) verifyCosignSignature(chartLoc string, repository string, version string, auth AuthSettings, verify VerifySettings) error {
+	var nameOpts []name.Option
+	if auth.PlainHTTP {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+
+	// Helm replaces the "+" of the version, which is not allowed in OCI tags.
+	ref, err := name.ParseReference(repository+":"+strings.ReplaceAll(version, "+", "_"), nameOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to parse chart reference: %w", err)
+	}
+
+	remoteOpts, err := auth.remoteOptions(h.ctx, ref.Context().RegistryStr())
+	if err != nil {
+		return err
+	}
+
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to get chart manifest: %w", err)
+	}
+
+	if err := verifyChartLayer(chartLoc, desc.Manifest); err != nil {
+		return &ChartVerificationError{Err: err}
+	}
+
+	checkOpts := &cosign.CheckOpts{
+		RegistryClientOpts: []ociremote.Option{ociremote.WithRemoteOptions(remoteOpts...)},
+		ClaimVerifier:      cosign.SimpleClaimVerifier,
+		IgnoreTlog:         verify.IgnoreTransparencyLog,
+	}
+
+	if len(verify.CosignPublicKey) > 0 {
+		checkOpts.SigVerifier, err = cosignsignature.LoadPublicKeyRaw(verify.CosignPublicKey, crypto.SHA256)
+		if err != nil {
+			return &ChartVerificationError{Err: fmt.Errorf("failed to load public key: %w", err)}
+		}
+	} else {
+		checkOpts.Identities = []cosign.Identity{{Issuer: verify.CosignIssuer, Subject: verify.CosignSubject}}
+
+		if checkOpts.RootCerts, err = fulcio.GetRoots(); err != nil {
+			return fmt.Errorf("failed to get Fulcio root certificates: %w", err)
+		}
+		if checkOpts.IntermediateCerts, err = fulcio.GetIntermediates(); err != nil {
+			return fmt.Errorf("failed to get Fulcio intermediate certificates: %w", err)
+		}
+		if checkOpts.CTLogPubKeys, err = cosign.GetCTLogPubs(h.ctx); err != nil {
+			return fmt.Errorf("failed to get CT log public keys: %w", err)
+		}
+	}
+
+	if !verify.IgnoreTransparencyLog {
+		if checkOpts.RekorPubKeys, err = cosign.GetRekorPubs(h.ctx); err != nil {
+			return fmt.Errorf("failed to get Rekor public keys: %w", err)
+		}
+		if checkOpts.RekorClient, err = rekorclient.GetRekorClient(rekorURL); err != nil {
+			return fmt.Errorf("failed to create Rekor client: %w", err)
+		}
+	}
+
+	// Verify the digest of the manifest we checked the chart against, not the tag which could have been moved in the meantime.
+	if _, _, err := cosign.VerifyImageSignatures(h.ctx, ref.Context().Digest(desc.Digest.String()), checkOpts); err != nil {
+		return &ChartVerificationError{Err: err}
+	}
+
+	return nil
+}
+
+// verifyChartLayer checks that the chart located at chartLoc is the chart layer of the OCI manifest.
+func verifyChartLayer(chartLoc string, rawManifest []byte) error {
+	manifest, err := v1.ParseManifest(bytes.NewReader(rawManifest))
+	if err != nil {
+		return fmt.Errorf("failed to parse chart manifest: %w", err)
+	}
+
+	chart, err := os.Open(chartLoc)
+	if err != nil {
+		return err
+	}
+	defer chart.Close()
+
+	digest, _, err := v1.SHA256(chart)
+	if err != nil {
+		return fmt.Errorf("failed to compute digest of chart: %w", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == registry.ChartLayerMediaType || layer.MediaType == registry.LegacyChartLayerMediaType {
+			if layer.Digest != digest {
+				return fmt.Errorf("digest of downloaded chart %s does not match the chart layer %s of the manifest", digest, layer.Digest)
+			}
+
+			return nil
+		}
+	}
+
+	return errors.New("manifest does not contain a chart layer")
+}
+
+// remoteOptions returns the options to access the OCI registry with go-containerregistry.
+func (a *AuthSettings) remoteOptions(ctx context.Context, registryHost string) ([]remote.Option, error) {
+	opts := []remote.Option{remote.WithContext(ctx)}
+
+	switch {
+	case a.Username != "" && a.Password != "":
+		opts = append(opts, remote.WithAuth(&authn.Basic{Username: a.Username, Password: a.Password}))
+
+	case a.RegistryConfigFile != "":
+		f, err := os.Open(a.RegistryConfigFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open registryConfigFile: %w", err)
+		}
+		defer f.Close()
+
+		configFile, err := config.LoadFromReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load registryConfigFile: %w", err)
+		}
+
+		authConfig, err := configFile.GetAuthConfig(registryHost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credentials for %s from registryConfigFile: %w", registryHost, err)
+		}
+
+		opts = append(opts, remote.WithAuth(authn.FromConfig(authn.AuthConfig{
+			Username:      authConfig.Username,
+			Password:      authConfig.Password,
+			Auth:          authConfig.Auth,
+			IdentityToken: authConfig.IdentityToken,
+			RegistryToken: authConfig.RegistryToken,
+		})))
+	}
+
+	if a.CAFile != "" || a.Insecure {
+		tlsConf := &tls.Config{
+			InsecureSkipVerify: a.Insecure,
+		}
+
+		if a.CAFile != "" {
+			caBundle, err := certificates.NewCABundleFromFile(a.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load CAFile %q: %w", a.CAFile, err)
+			}
+
+			tlsConf.RootCAs = caBundle.CertPool()
+		}
+
+		transport := remote.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConf
+		opts = append(opts, remote.WithTransport(transport))
+	}
+
+	return opts, nil
+}
@@ -59,6 +59,11 @@ func (h HelmSource) DownloadSource(destination string) (string, error) {
 		return "", err
 	}
 
+	verify, err := util.HelmVerifySettingsFromVerification(h.Ctx, h.SeedClient, path.Join(helmCacheDir, "keyring.gpg"), h.SecretNamespace, h.Source.Verification)
+	if err != nil {
+		return "", err
+	}
+
 	// Namespace does not matter to downloading chart.
 	ns := "default"
 	restClientGetter := &genericclioptions.ConfigFlags{
@@ -76,5 +81,5 @@ func (h HelmSource) DownloadSource(destination string) (string, error) {
 		return "", err
 	}
 
-	return helmClient.DownloadChart(h.Source.URL, h.Source.ChartName, h.Source.ChartVersion, destination, auth)
+	return helmClient.DownloadVerifiedChart(h.Source.URL, h.Source.ChartName, h.Source.ChartVersion, destination, auth, verify)
 }
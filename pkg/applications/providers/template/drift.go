@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications/providers/util"
+	"k8c.io/kubermatic/v2/pkg/util/inventory"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// driftCorrectionFieldManager is the field manager used to server-side apply drifted objects.
+	driftCorrectionFieldManager = "kubermatic-application-drift-correction"
+
+	// maxReportedDriftedObjects limits the number of objects listed in the Drifted condition.
+	maxReportedDriftedObjects = 10
+)
+
+// driftedObject is an object of a release that does not match its live state in the cluster.
+type driftedObject struct {
+	// desired is the object as rendered in the release.
+	desired *unstructured.Unstructured
+	// missing is true if the object does not exist in the cluster anymore.
+	missing bool
+}
+
+func (d driftedObject) String() string {
+	state := "modified"
+	if d.missing {
+		state = "missing"
+	}
+
+	return fmt.Sprintf("%s (%s)", inventory.FormatObject(d.desired), state)
+}
+
+// detectDrift compares the objects in the manifest of a release to their live state in the cluster. An object has
+// drifted if it is missing or if any field set in the manifest has a different value in the cluster. Fields that
+// are not part of the manifest, e.g. defaulted fields or the status, are ignored.
+func detectDrift(ctx context.Context, client ctrlruntimeclient.Client, namespace string, manifest string) ([]driftedObject, error) {
+	objs, err := parseManifests([]byte(manifest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse release manifest: %w", err)
+	}
+
+	var drifted []driftedObject
+
+	for _, desired := range objs {
+		namespaced, err := client.IsObjectNamespaced(desired)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to determine scope: %w", inventory.FormatObject(desired), err)
+		}
+
+		switch {
+		case !namespaced:
+			desired.SetNamespace("")
+		case desired.GetNamespace() == "":
+			desired.SetNamespace(namespace)
+		}
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(desired.GroupVersionKind())
+
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(desired), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				drifted = append(drifted, driftedObject{desired: desired, missing: true})
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get %s: %w", inventory.FormatObject(desired), err)
+		}
+
+		if !isSubset(comparableFields(desired), live.Object) {
+			drifted = append(drifted, driftedObject{desired: desired})
+		}
+	}
+
+	return drifted, nil
+}
+
+// correctDrift server-side applies the drifted objects. The Helm ownership metadata is added, as Helm adds it
+// to the objects when applying them, but does not store it in the release manifest. Without it, objects that
+// have been recreated could not be upgraded by Helm anymore.
+func correctDrift(ctx context.Context, client ctrlruntimeclient.Client, releaseName string, releaseNamespace string, drifted []driftedObject) error {
+	var errs []error
+
+	for _, d := range drifted {
+		obj := d.desired.DeepCopy()
+
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels["app.kubernetes.io/managed-by"] = "Helm"
+		obj.SetLabels(labels)
+
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations["meta.helm.sh/release-name"] = releaseName
+		annotations["meta.helm.sh/release-namespace"] = releaseNamespace
+		obj.SetAnnotations(annotations)
+
+		if err := client.Apply(ctx, ctrlruntimeclient.ApplyConfigurationFromUnstructured(obj), ctrlruntimeclient.FieldOwner(driftCorrectionFieldManager), ctrlruntimeclient.ForceOwnership); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", inventory.FormatObject(obj), err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// driftStatusUpdater sets the Drifted condition according to the result of the drift detection and correction.
+func driftStatusUpdater(generation int64, drifted []driftedObject, corrected bool, driftErr error) util.StatusUpdater {
+	return func(status *appskubermaticv1.ApplicationInstallationStatus) {
+		switch {
+		case driftErr != nil:
+			status.SetCondition(appskubermaticv1.Drifted, corev1.ConditionUnknown, "DriftDetectionFailed", driftErr.Error(), generation)
+		case len(drifted) == 0:
+			status.SetCondition(appskubermaticv1.Drifted, corev1.ConditionFalse, "NoDrift", "all objects match the release", generation)
+		case corrected:
+			status.SetCondition(appskubermaticv1.Drifted, corev1.ConditionFalse, "DriftCorrected", "reapplied "+formatDriftedObjects(drifted), generation)
+		default:
+			status.SetCondition(appskubermaticv1.Drifted, corev1.ConditionTrue, "DriftDetected", formatDriftedObjects(drifted), generation)
+		}
+	}
+}
+
+func formatDriftedObjects(drifted []driftedObject) string {
+	var names []string
+	for i, d := range drifted {
+		if i == maxReportedDriftedObjects {
+			names = append(names, fmt.Sprintf("and %d more", len(drifted)-i))
+			break
+		}
+
+		names = append(names, d.String())
+	}
+
+	return fmt.Sprintf("%d drifted objects: %s", len(drifted), strings.Join(names, ", "))
+}
+
+// comparableFields returns the fields of the desired object that can be compared to the live object. The
+// stringData of Secrets is never returned by the API server, as it is merged into the data on write.
+func comparableFields(desired *unstructured.Unstructured) map[string]any {
+	fields := desired.Object
+	if desired.GroupVersionKind().GroupKind() == corev1.SchemeGroupVersion.WithKind("Secret").GroupKind() {
+		fields = desired.DeepCopy().Object
+		delete(fields, "stringData")
+	}
+
+	return fields
+}
+
+// isSubset returns true if all fields set in desired have the same value in live. Lists must have the
+// same length. Empty and zero values in desired match missing values in live, as the API server drops them.
+// Scalars are compared with valuesEqual.
+func isSubset(desired, live any) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return len(d) == 0 && live == nil
+		}
+
+		for key, value := range d {
+			if !isSubset(value, l[key]) {
+				return false
+			}
+		}
+
+		return true
+
+	case []any:
+		l, ok := live.([]any)
+		if !ok {
+			return len(d) == 0 && live == nil
+		}
+
+		if len(d) != len(l) {
+			return false
+		}
+
+		for i := range d {
+			if !isSubset(d[i], l[i]) {
+				return false
+			}
+		}
+
+		return true
+
+	default:
+		if live == nil {
+			return reflect.ValueOf(desired).IsZero()
+		}
+
+		return valuesEqual(desired, live)
+	}
+}
+
+// valuesEqual compares two scalars the way the API server normalises them: numbers are equal regardless
+// of their type (`1` and `1.0`), and quantities are equal if they have the same value (`cpu: 1` and
+// `"1"`, `0.5` and `"500m"`). Two plain decimal strings are compared as strings, as they are usually not
+// quantities (e.g. the versions "1.10" and "1.1").
+func valuesEqual(desired, live any) bool {
+	if reflect.DeepEqual(desired, live) {
+		return true
+	}
+
+	desiredNumber, desiredIsNumber := toFloat(desired)
+	liveNumber, liveIsNumber := toFloat(live)
+	if desiredIsNumber && liveIsNumber {
+		return desiredNumber == liveNumber
+	}
+
+	desiredString, desiredIsString := desired.(string)
+	liveString, liveIsString := live.(string)
+	if desiredIsString && liveIsString && isDecimal(desiredString) && isDecimal(liveString) {
+		return false
+	}
+
+	desiredQuantity, ok := toQuantity(desired)
+	if !ok {
+		return false
+	}
+
+	liveQuantity, ok := toQuantity(live)
+	if !ok {
+		return false
+	}
+
+	return desiredQuantity.Cmp(liveQuantity) == 0
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func toQuantity(value any) (resource.Quantity, bool) {
+	s, ok := value.(string)
+	if !ok {
+		number, isNumber := toFloat(value)
+		if !isNumber {
+			return resource.Quantity{}, false
+		}
+		s = strconv.FormatFloat(number, 'f', -1, 64)
+	}
+
+	quantity, err := resource.ParseQuantity(s)
+	if err != nil {
+		return resource.Quantity{}, false
+	}
+
+	return quantity, true
+}
+
+func isDecimal(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestIsSubset(t *testing.T) {
+	testCases := []struct {
+		name     string
+		desired  any
+		live     any
+		expected bool
+	}{
+		{
+			name:     "additional live fields are ignored",
+			desired:  map[string]any{"a": "b"},
+			live:     map[string]any{"a": "b", "c": "d"},
+			expected: true,
+		},
+		{
+			name:     "changed value is detected",
+			desired:  map[string]any{"a": map[string]any{"b": int64(1)}},
+			live:     map[string]any{"a": map[string]any{"b": int64(2)}},
+			expected: false,
+		},
+		{
+			name:     "missing value is detected",
+			desired:  map[string]any{"a": "b"},
+			live:     map[string]any{},
+			expected: false,
+		},
+		{
+			name:     "empty and zero values match missing values",
+			desired:  map[string]any{"a": map[string]any{}, "b": []any{}, "c": "", "d": false, "e": nil},
+			live:     map[string]any{},
+			expected: true,
+		},
+		{
+			name:     "lists are compared element-wise",
+			desired:  []any{map[string]any{"name": "a"}},
+			live:     []any{map[string]any{"name": "a", "defaulted": true}},
+			expected: true,
+		},
+		{
+			name:     "additional list elements are detected",
+			desired:  []any{"a"},
+			live:     []any{"a", "b"},
+			expected: false,
+		},
+		{
+			name:     "numbers are compared regardless of their type",
+			desired:  map[string]any{"replicas": float64(2)},
+			live:     map[string]any{"replicas": int64(2)},
+			expected: true,
+		},
+		{
+			name:     "changed number is detected regardless of its type",
+			desired:  map[string]any{"replicas": float64(2.5)},
+			live:     map[string]any{"replicas": int64(2)},
+			expected: false,
+		},
+		{
+			name:     "integer quantity matches its string form",
+			desired:  map[string]any{"cpu": int64(1)},
+			live:     map[string]any{"cpu": "1"},
+			expected: true,
+		},
+		{
+			name:     "decimal quantity matches its normalised form",
+			desired:  map[string]any{"cpu": float64(0.5)},
+			live:     map[string]any{"cpu": "500m"},
+			expected: true,
+		},
+		{
+			name:     "quantity string matches its normalised form",
+			desired:  map[string]any{"memory": "1024Mi"},
+			live:     map[string]any{"memory": "1Gi"},
+			expected: true,
+		},
+		{
+			name:     "changed quantity is detected",
+			desired:  map[string]any{"cpu": "500m"},
+			live:     map[string]any{"cpu": "1"},
+			expected: false,
+		},
+		{
+			name:     "decimal strings are compared as strings",
+			desired:  map[string]any{"version": "1.10"},
+			live:     map[string]any{"version": "1.1"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := isSubset(tc.desired, tc.live); result != tc.expected {
+				t.Fatalf("Expected %v, got %v.", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestDrift(t *testing.T) {
+	ctx := context.Background()
+
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	restMapper.Add(rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), meta.RESTScopeRoot)
+
+	client := fake.
+		NewClientBuilder().
+		WithRESTMapper(restMapper).
+		WithObjects(
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "app-ns", Name: "unchanged", Labels: map[string]string{"added": "by-user"}},
+				Data:       map[string]string{"key": "value"},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "app-ns", Name: "modified"},
+				Data:       map[string]string{"key": "changed-by-user"},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "app-ns", Name: "secret"},
+				Data:       map[string][]byte{"key": []byte("value")},
+			},
+		).
+		Build()
+
+	manifest := `---
+# Source: chart/templates/configmaps.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unchanged
+data:
+  key: value
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: modified
+data:
+  key: value
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: secret
+stringData:
+  key: value
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: missing
+rules: []
+`
+
+	drifted, err := detectDrift(ctx, client, "app-ns", manifest)
+	if err != nil {
+		t.Fatalf("Failed to detect drift: %v", err)
+	}
+
+	expected := "2 drifted objects: configmap app-ns/modified (modified), clusterrole.rbac.authorization.k8s.io missing (missing)"
+	if message := formatDriftedObjects(drifted); message != expected {
+		t.Fatalf("Expected drifted objects %q, got %q.", expected, message)
+	}
+
+	status := &appskubermaticv1.ApplicationInstallationStatus{}
+	driftStatusUpdater(3, drifted, false, nil)(status)
+
+	condition := status.Conditions[appskubermaticv1.Drifted]
+	if condition.Status != corev1.ConditionTrue || condition.Reason != "DriftDetected" || condition.ObservedGeneration != 3 {
+		t.Errorf("Expected Drifted condition to be True/DriftDetected for generation 3, got %s/%s for generation %d.", condition.Status, condition.Reason, condition.ObservedGeneration)
+	}
+
+	// correcting the drift reapplies the drifted objects, including the Helm ownership metadata
+	if err := correctDrift(ctx, client, "app", "app-ns", drifted); err != nil {
+		t.Fatalf("Failed to correct drift: %v", err)
+	}
+
+	drifted, err = detectDrift(ctx, client, "app-ns", manifest)
+	if err != nil {
+		t.Fatalf("Failed to detect drift: %v", err)
+	}
+
+	if len(drifted) != 0 {
+		t.Fatalf("Expected no drift after correction, got %s.", formatDriftedObjects(drifted))
+	}
+
+	clusterRole := &rbacv1.ClusterRole{}
+	if err := client.Get(ctx, types.NamespacedName{Name: "missing"}, clusterRole); err != nil {
+		t.Fatalf("Failed to get ClusterRole: %v", err)
+	}
+
+	expectedAnnotations := map[string]string{"meta.helm.sh/release-name": "app", "meta.helm.sh/release-namespace": "app-ns"}
+	if !diff.SemanticallyEqual(expectedAnnotations, clusterRole.Annotations) {
+		t.Errorf("Recreated object does not have the Helm ownership annotations:\n%v", diff.ObjectDiff(expectedAnnotations, clusterRole.Annotations))
+	}
+
+	driftStatusUpdater(3, nil, false, nil)(status)
+	if reason := status.Conditions[appskubermaticv1.Drifted].Reason; reason != "NoDrift" {
+		t.Errorf("Expected Drifted condition reason NoDrift, got %s.", reason)
+	}
+
+	driftStatusUpdater(3, nil, false, errors.New("boom"))(status)
+	if condition := status.Conditions[appskubermaticv1.Drifted]; condition.Status != corev1.ConditionUnknown {
+		t.Errorf("Expected Drifted condition to be Unknown on errors, got %s.", condition.Status)
+	}
+}
+
+func TestDriftCheckInterval(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		defaultDeployOptions *appskubermaticv1.DeployOptions
+		deployOptions        *appskubermaticv1.DeployOptions
+		expected             time.Duration
+	}{
+		{
+			name:     "no deploy options disable the check",
+			expected: 0,
+		},
+		{
+			name:          "drift correction enables the check with the default interval",
+			deployOptions: &appskubermaticv1.DeployOptions{Helm: &appskubermaticv1.HelmDeployOptions{CorrectDrift: true}},
+			expected:      defaultDriftCheckInterval,
+		},
+		{
+			name:                 "drift correction in the definition enables the check with the default interval",
+			defaultDeployOptions: &appskubermaticv1.DeployOptions{Helm: &appskubermaticv1.HelmDeployOptions{CorrectDrift: true}},
+			expected:             defaultDriftCheckInterval,
+		},
+		{
+			name:          "explicit interval enables drift detection without correction",
+			deployOptions: &appskubermaticv1.DeployOptions{Helm: &appskubermaticv1.HelmDeployOptions{DriftCheckInterval: &metav1.Duration{Duration: time.Hour}}},
+			expected:      time.Hour,
+		},
+		{
+			name:          "zero interval disables the check even with drift correction",
+			deployOptions: &appskubermaticv1.DeployOptions{Helm: &appskubermaticv1.HelmDeployOptions{CorrectDrift: true, DriftCheckInterval: &metav1.Duration{}}},
+			expected:      0,
+		},
+		{
+			name:                 "installation options take precedence over the definition",
+			defaultDeployOptions: &appskubermaticv1.DeployOptions{Helm: &appskubermaticv1.HelmDeployOptions{CorrectDrift: true}},
+			deployOptions:        &appskubermaticv1.DeployOptions{Helm: &appskubermaticv1.HelmDeployOptions{}},
+			expected:             0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appDefinition := &appskubermaticv1.ApplicationDefinition{Spec: appskubermaticv1.ApplicationDefinitionSpec{DefaultDeployOptions: tc.defaultDeployOptions}}
+			appInstallation := &appskubermaticv1.ApplicationInstallation{Spec: appskubermaticv1.ApplicationInstallationSpec{DeployOptions: tc.deployOptions}}
+
+			if interval := DriftCheckInterval(appDefinition, appInstallation); interval != tc.expected {
+				t.Errorf("Expected interval %v, got %v.", tc.expected, interval)
+			}
+		})
+	}
+}
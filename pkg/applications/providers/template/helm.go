@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"path"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -34,6 +35,10 @@ import (
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// defaultDriftCheckInterval is the interval at which releases with drift correction are checked for drift,
+// unless configured otherwise.
+const defaultDriftCheckInterval = 15 * time.Minute
+
 // HelmTemplate install upgrade or uninstall helm chart into cluster.
 type HelmTemplate struct {
 	Ctx context.Context
@@ -54,6 +59,9 @@ type HelmTemplate struct {
 
 	// SeedClient to seed cluster.
 	SeedClient ctrlruntimeclient.Client
+
+	// UserClient to user cluster.
+	UserClient ctrlruntimeclient.Client
 }
 
 // InstallOrUpgrade the chart located at chartLoc with parameters (releaseName, values) defined applicationInstallation into cluster.
@@ -94,9 +102,9 @@ func (h HelmTemplate) InstallOrUpgrade(chartLoc string, appDefinition *appskuber
 		return util.NoStatusUpdate, err
 	}
 
-	values, err := applicationInstallation.Spec.GetParsedValues()
+	values, err := GetValues(h.Ctx, h.SeedClient, h.UserClient, h.SecretNamespace, applicationInstallation)
 	if err != nil {
-		return util.NoStatusUpdate, fmt.Errorf("failed to unmarshal values: %w", err)
+		return util.NoStatusUpdate, err
 	}
 
 	renderedValues, err := h.templatePreDefinedValues(values)
@@ -108,9 +116,17 @@ func (h HelmTemplate) InstallOrUpgrade(chartLoc string, appDefinition *appskuber
 	helmRelease, err := helmClient.InstallOrUpgrade(chartLoc, getReleaseName(applicationInstallation), renderedValues, *deployOpts, auth)
 	statusUpdater := util.NoStatusUpdate
 
+	// Drift is only checked for successful releases, failed ones are retried anyway.
+	driftUpdater := util.NoStatusUpdate
+	if err == nil && helmRelease != nil {
+		driftUpdater = h.reconcileDrift(helmRelease.Name, helmRelease.Manifest, appDefinition, applicationInstallation)
+	}
+
 	// In some case, even if an error occurred, the helmRelease is updated.
 	if helmRelease != nil {
 		statusUpdater = func(status *appskubermaticv1.ApplicationInstallationStatus) {
+			driftUpdater(status)
+
 			status.HelmRelease = &appskubermaticv1.HelmRelease{
 				Name:    helmRelease.Name,
 				Version: helmRelease.Version,
@@ -176,6 +192,38 @@ func (h HelmTemplate) Uninstall(applicationInstallation *appskubermaticv1.Applic
 	return statusUpdater, err
 }
 
+// CheckDrift compares the manifest of the deployed release to the objects in the user cluster and reapplies drifted
+// objects if drift correction is enabled. The chart is not downloaded and the release is left untouched.
+func (h HelmTemplate) CheckDrift(appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	restClientGetter := &genericclioptions.ConfigFlags{
+		KubeConfig: &h.Kubeconfig,
+		Namespace:  &applicationInstallation.Spec.Namespace.Name,
+	}
+
+	helmClient, err := helmclient.NewClient(
+		h.Ctx,
+		restClientGetter,
+		helmclient.NewSettings(h.CacheDir),
+		applicationInstallation.Spec.Namespace.Name,
+		h.Log)
+
+	if err != nil {
+		return util.NoStatusUpdate, err
+	}
+
+	helmRelease, err := helmClient.GetDeployedRelease(getReleaseName(applicationInstallation))
+	if err != nil {
+		return util.NoStatusUpdate, err
+	}
+
+	// nothing has been deployed yet
+	if helmRelease == nil {
+		return util.NoStatusUpdate, nil
+	}
+
+	return h.reconcileDrift(helmRelease.Name, helmRelease.Manifest, appDefinition, applicationInstallation), nil
+}
+
 // getReleaseName computes the release name from the applicationInstallation.
 // The releaseName length must be less or equal to 53. So we first start to compute this release Name:
 //
@@ -202,6 +250,71 @@ func getReleaseName(applicationInstallation *appskubermaticv1.ApplicationInstall
 	return namespacedName
 }
 
+// reconcileDrift detects objects of the release that have drifted from the manifest and reapplies them if drift
+// correction is enabled. Failures are only reported in the Drifted condition, as the release itself is fine.
+func (h HelmTemplate) reconcileDrift(releaseName string, manifest string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) util.StatusUpdater {
+	namespace := applicationInstallation.Spec.Namespace.Name
+
+	drifted, err := detectDrift(h.Ctx, h.UserClient, namespace, manifest)
+	if err != nil {
+		h.Log.Warnw("Failed to detect drift of release", "release", releaseName, zap.Error(err))
+		return driftStatusUpdater(applicationInstallation.Generation, nil, false, err)
+	}
+
+	corrected := false
+	if len(drifted) > 0 {
+		h.Log.Infow("Detected drifted objects", "release", releaseName, "objects", formatDriftedObjects(drifted))
+
+		if correctDriftEnabled(appDefinition, applicationInstallation) {
+			if err := correctDrift(h.Ctx, h.UserClient, releaseName, namespace, drifted); err != nil {
+				h.Log.Warnw("Failed to correct drift of release", "release", releaseName, zap.Error(err))
+				return driftStatusUpdater(applicationInstallation.Generation, drifted, false, fmt.Errorf("failed to correct drift: %w", err))
+			}
+			corrected = true
+		}
+	}
+
+	return driftStatusUpdater(applicationInstallation.Generation, drifted, corrected, nil)
+}
+
+// correctDriftEnabled returns the drift correction setting of the appInstall, falling back to the appDefinition.
+func correctDriftEnabled(appDefinition *appskubermaticv1.ApplicationDefinition, appInstall *appskubermaticv1.ApplicationInstallation) bool {
+	if appInstall.Spec.DeployOptions != nil && appInstall.Spec.DeployOptions.Helm != nil {
+		return appInstall.Spec.DeployOptions.Helm.CorrectDrift
+	}
+
+	if appDefinition != nil && appDefinition.Spec.DefaultDeployOptions != nil && appDefinition.Spec.DefaultDeployOptions.Helm != nil {
+		return appDefinition.Spec.DefaultDeployOptions.Helm.CorrectDrift
+	}
+
+	return false
+}
+
+// DriftCheckInterval returns the interval at which the deployed release of the appInstall is checked for drift, falling
+// back to the appDefinition. If not configured, drift is checked periodically only if drift correction is enabled.
+func DriftCheckInterval(appDefinition *appskubermaticv1.ApplicationDefinition, appInstall *appskubermaticv1.ApplicationInstallation) time.Duration {
+	var opts *appskubermaticv1.HelmDeployOptions
+
+	switch {
+	case appInstall.Spec.DeployOptions != nil && appInstall.Spec.DeployOptions.Helm != nil:
+		opts = appInstall.Spec.DeployOptions.Helm
+	case appDefinition != nil && appDefinition.Spec.DefaultDeployOptions != nil && appDefinition.Spec.DefaultDeployOptions.Helm != nil:
+		opts = appDefinition.Spec.DefaultDeployOptions.Helm
+	default:
+		return 0
+	}
+
+	if opts.DriftCheckInterval != nil {
+		return opts.DriftCheckInterval.Duration
+	}
+
+	if opts.CorrectDrift {
+		return defaultDriftCheckInterval
+	}
+
+	return 0
+}
+
 // getDeployOpts builds helmclient.DeployOpts from values provided by appInstall or fallback to the values of appDefinition or fallback to the default options.
 // Default options are wait=false that implies timeout=0 and atomic=false.
 func getDeployOpts(appDefinition *appskubermaticv1.ApplicationDefinition, appInstall *appskubermaticv1.ApplicationInstallation) (*helmclient.DeployOpts, error) {
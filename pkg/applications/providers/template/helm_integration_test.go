@@ -159,6 +159,7 @@ func TestHelmProvider(t *testing.T) {
 					Log:             kubermaticlog.Logger,
 					SecretNamespace: "abc",
 					SeedClient:      client,
+					UserClient:      client,
 				}
 
 				statusUpdater, err := template.Uninstall(app)
@@ -226,6 +227,7 @@ func TestHelmProvider(t *testing.T) {
 					Log:             kubermaticlog.Logger,
 					SecretNamespace: "default",
 					SeedClient:      client,
+					UserClient:      client,
 				}
 
 				_, err := template.InstallOrUpgrade(chartFullPath, &appskubermaticv1.ApplicationDefinition{}, app)
@@ -252,6 +254,7 @@ func TestHelmProvider(t *testing.T) {
 					Log:             kubermaticlog.Logger,
 					SecretNamespace: "default",
 					SeedClient:      client,
+					UserClient:      client,
 					ClusterName:     "cluster-default",
 				}
 
@@ -291,6 +294,7 @@ func TestHelmProvider(t *testing.T) {
 					Log:             kubermaticlog.Logger,
 					SecretNamespace: "default",
 					SeedClient:      client,
+					UserClient:      client,
 					ClusterName:     "cluster-default",
 				}
 
@@ -360,6 +364,7 @@ func installOrUpgradeTest(t *testing.T, ctx context.Context, client ctrlruntimec
 		SecretNamespace: "default",
 		ClusterName:     "cluster-default",
 		SeedClient:      client,
+		UserClient:      client,
 	}
 
 	statusUpdater, err := template.InstallOrUpgrade(chartLoc, &appskubermaticv1.ApplicationDefinition{}, app)
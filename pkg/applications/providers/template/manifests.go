@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications/providers/util"
+	"k8c.io/kubermatic/v2/pkg/util/inventory"
+	yamlutil "k8c.io/kubermatic/v2/pkg/util/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+const (
+	// manifestsFieldManager is the field manager used to server-side apply application manifests.
+	manifestsFieldManager = "kubermatic-application-installation-controller"
+)
+
+var errRollbackNotSupported = errors.New("rollback is not supported for this template method")
+
+// ManifestsTemplate installs, upgrades or uninstalls plain manifests or a kustomization into the cluster
+// using server-side apply. Objects that are removed from the source are pruned from the cluster.
+type ManifestsTemplate struct {
+	Ctx context.Context
+
+	Log *zap.SugaredLogger
+
+	// UserClient to the user-cluster.
+	UserClient ctrlruntimeclient.Client
+
+	// Kustomize renders the source as a kustomization instead of reading plain manifests from it.
+	Kustomize bool
+}
+
+// InstallOrUpgrade renders the manifests located at source and server-side applies them into the namespace defined
+// in the applicationInstallation. Objects that were applied previously, but are not part of the manifests anymore,
+// are deleted.
+func (m ManifestsTemplate) InstallOrUpgrade(source string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	objs, err := m.render(source)
+	if err != nil {
+		return util.NoStatusUpdate, err
+	}
+
+	applier := m.applier(applicationInstallation)
+	previous := toInventory(applicationInstallation.Status.Inventory)
+
+	items, applyErr := applier.Apply(m.Ctx, objs)
+	if applyErr != nil {
+		// do not prune anything if not all objects could be applied, but keep track of all of them
+		return inventoryUpdater(inventory.Merge(items, previous)), applyErr
+	}
+
+	remaining, pruneErr := applier.Prune(m.Ctx, previous, items)
+
+	return inventoryUpdater(append(items, remaining...)), pruneErr
+}
+
+// Uninstall deletes all objects that have been applied for the applicationInstallation from the user cluster.
+func (m ManifestsTemplate) Uninstall(applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	if err := m.applier(applicationInstallation).Delete(m.Ctx, toInventory(applicationInstallation.Status.Inventory)); err != nil {
+		return util.NoStatusUpdate, err
+	}
+
+	return inventoryUpdater(nil), nil
+}
+
+// IsStuck always returns false, as server-side apply does not keep any state that could get stuck.
+func (m ManifestsTemplate) IsStuck(applicationInstallation *appskubermaticv1.ApplicationInstallation) (bool, error) {
+	return false, nil
+}
+
+// CheckDrift does nothing, as drift detection is only supported for Helm releases.
+func (m ManifestsTemplate) CheckDrift(appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	return util.NoStatusUpdate, nil
+}
+
+// Rollback is not supported, as no previous releases are kept.
+func (m ManifestsTemplate) Rollback(applicationInstallation *appskubermaticv1.ApplicationInstallation) error {
+	return errRollbackNotSupported
+}
+
+func (m ManifestsTemplate) render(source string) ([]*unstructured.Unstructured, error) {
+	if m.Kustomize {
+		return renderKustomization(source)
+	}
+
+	return readManifests(source)
+}
+
+// renderKustomization builds the kustomization in dir, just like `kustomize build` would. As per
+// the kustomize defaults, plugins are disabled and files outside of dir cannot be loaded.
+func renderKustomization(dir string) ([]*unstructured.Unstructured, error) {
+	kustomizer := krusty.MakeKustomizer(krusty.MakeDefaultOptions())
+
+	resMap, err := kustomizer.Run(filesys.MakeFsOnDisk(), dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kustomization: %w", err)
+	}
+
+	rendered, err := resMap.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode kustomization: %w", err)
+	}
+
+	return parseManifests(rendered)
+}
+
+// readManifests reads all YAML and JSON files in dir and its subdirectories in lexical order.
+// Hidden files and directories, like the .git directory, are skipped.
+func readManifests(dir string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		switch filepath.Ext(path) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		parsed, err := parseManifests(content)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", strings.TrimPrefix(path, dir), err)
+		}
+
+		objs = append(objs, parsed...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifests: %w", err)
+	}
+
+	return objs, nil
+}
+
+// parseManifests decodes all objects in the given multi-document YAML. Lists are flattened.
+func parseManifests(content []byte) ([]*unstructured.Unstructured, error) {
+	docs, err := yamlutil.ParseMultipleDocuments(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	var objs []*unstructured.Unstructured
+
+	for _, doc := range docs {
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(doc.Raw); err != nil {
+			return nil, err
+		}
+
+		if !obj.IsList() {
+			objs = append(objs, obj)
+			continue
+		}
+
+		err := obj.EachListItem(func(item runtime.Object) error {
+			objs = append(objs, item.(*unstructured.Unstructured))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return objs, nil
+}
+
+// applier returns an applier for the objects of the applicationInstallation. Namespaced objects without a
+// namespace are applied into the namespace of the application.
+func (m ManifestsTemplate) applier(applicationInstallation *appskubermaticv1.ApplicationInstallation) *inventory.Applier {
+	return &inventory.Applier{
+		Client:           m.UserClient,
+		Log:              m.Log,
+		FieldManager:     manifestsFieldManager,
+		OwnerLabels:      map[string]string{appskubermaticv1.ApplicationInstallationLabel: getReleaseName(applicationInstallation)},
+		DefaultNamespace: applicationInstallation.Spec.Namespace.Name,
+	}
+}
+
+func inventoryUpdater(items []inventory.Item) util.StatusUpdater {
+	var result []appskubermaticv1.ApplicationInventoryItem
+	for _, item := range items {
+		result = append(result, appskubermaticv1.ApplicationInventoryItem(item))
+	}
+
+	return func(status *appskubermaticv1.ApplicationInstallationStatus) {
+		status.Inventory = result
+	}
+}
+
+func toInventory(items []appskubermaticv1.ApplicationInventoryItem) []inventory.Item {
+	var result []inventory.Item
+	for _, item := range items {
+		result = append(result, inventory.Item(item))
+	}
+
+	return result
+}
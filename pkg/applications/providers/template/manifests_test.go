@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		filename := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	return dir
+}
+
+func objectNames(objs []*unstructured.Unstructured) []string {
+	names := []string{}
+	for _, obj := range objs {
+		names = append(names, obj.GetKind()+"/"+obj.GetName())
+	}
+
+	return names
+}
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		name          string
+		kustomize     bool
+		files         map[string]string
+		expectedNames []string
+		expectedErr   bool
+	}{
+		{
+			name: "manifests are read recursively in lexical order",
+			files: map[string]string{
+				"b.yaml":       "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n---\n# only a comment\n---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: c\n",
+				"a.json":       `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "a"}}`,
+				"sub/d.yml":    "apiVersion: v1\nkind: List\nitems:\n- apiVersion: v1\n  kind: ConfigMap\n  metadata:\n    name: d\n",
+				"README.md":    "# not a manifest",
+				".git/x.yaml":  "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: git\n",
+				".hidden.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: hidden\n",
+			},
+			expectedNames: []string{"ConfigMap/a", "ConfigMap/b", "Secret/c", "ConfigMap/d"},
+		},
+		{
+			name: "invalid manifests are rejected",
+			files: map[string]string{
+				"a.yaml": "apiVersion: v1\nkind: [\n",
+			},
+			expectedErr: true,
+		},
+		{
+			name:      "kustomization is built",
+			kustomize: true,
+			files: map[string]string{
+				"kustomization.yaml": "resources:\n- configmap.yaml\nnamePrefix: app-\n",
+				"configmap.yaml":     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n",
+				"ignored.yaml":       "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: ignored\n",
+			},
+			expectedNames: []string{"ConfigMap/app-a"},
+		},
+		{
+			name:      "kustomization must not load files outside of its root",
+			kustomize: true,
+			files: map[string]string{
+				"kustomization.yaml": "resources:\n- ../configmap.yaml\n",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := ManifestsTemplate{Kustomize: tc.kustomize}
+
+			objs, err := m.render(writeFiles(t, tc.files))
+			if tc.expectedErr != (err != nil) {
+				t.Fatalf("Expected error: %v, got %v", tc.expectedErr, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if names := objectNames(objs); !diff.SemanticallyEqual(tc.expectedNames, names) {
+				t.Fatalf("Diff:\n%s", diff.ObjectDiff(tc.expectedNames, names))
+			}
+		})
+	}
+}
+
+func TestManifestsTemplate(t *testing.T) {
+	ctx := context.Background()
+
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	restMapper.Add(rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), meta.RESTScopeRoot)
+
+	client := fake.NewClientBuilder().WithRESTMapper(restMapper).Build()
+
+	m := ManifestsTemplate{
+		Ctx:        ctx,
+		Log:        kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+		UserClient: client,
+	}
+
+	appInstallation := &appskubermaticv1.ApplicationInstallation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "app"},
+		Spec: appskubermaticv1.ApplicationInstallationSpec{
+			Namespace: &appskubermaticv1.AppNamespaceSpec{Name: "app-ns"},
+		},
+	}
+
+	install := func(files map[string]string) {
+		statusUpdater, err := m.InstallOrUpgrade(writeFiles(t, files), nil, appInstallation)
+		if err != nil {
+			t.Fatalf("Failed to install: %v", err)
+		}
+
+		statusUpdater(&appInstallation.Status)
+	}
+
+	configMapExists := func(namespace, name string) bool {
+		err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &corev1.ConfigMap{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			t.Fatalf("Failed to get ConfigMap: %v", err)
+		}
+
+		return err == nil
+	}
+
+	// initial installation applies all objects into the application namespace
+	install(map[string]string{
+		"manifests.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n---\napiVersion: rbac.authorization.k8s.io/v1\nkind: ClusterRole\nmetadata:\n  name: c\n  namespace: app-ns\n",
+	})
+
+	expected := []appskubermaticv1.ApplicationInventoryItem{
+		{Version: "v1", Kind: "ConfigMap", Namespace: "app-ns", Name: "a"},
+		{Version: "v1", Kind: "ConfigMap", Namespace: "app-ns", Name: "b"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole", Name: "c"},
+	}
+
+	if !diff.SemanticallyEqual(expected, appInstallation.Status.Inventory) {
+		t.Fatalf("Inventory does not match expectation:\n%v", diff.ObjectDiff(expected, appInstallation.Status.Inventory))
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: "app-ns", Name: "a"}, configMap); err != nil {
+		t.Fatalf("Failed to get ConfigMap: %v", err)
+	}
+
+	if value := configMap.Labels[appskubermaticv1.ApplicationInstallationLabel]; value != "kube-system-app" {
+		t.Errorf("Expected ConfigMap to be labelled with the installation, got %q.", value)
+	}
+
+	// removed objects are pruned, unless they have been taken over by someone else
+	configMap = &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: "app-ns", Name: "b"}, configMap); err != nil {
+		t.Fatalf("Failed to get ConfigMap: %v", err)
+	}
+
+	delete(configMap.Labels, appskubermaticv1.ApplicationInstallationLabel)
+	if err := client.Update(ctx, configMap); err != nil {
+		t.Fatalf("Failed to update ConfigMap: %v", err)
+	}
+
+	install(map[string]string{
+		"manifests.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: d\n",
+	})
+
+	if configMapExists("app-ns", "a") {
+		t.Error("Expected ConfigMap a to be pruned.")
+	}
+
+	if !configMapExists("app-ns", "b") {
+		t.Error("Expected ConfigMap b to be left alone.")
+	}
+
+	if len(appInstallation.Status.Inventory) != 1 {
+		t.Errorf("Expected inventory to contain 1 object, got %d.", len(appInstallation.Status.Inventory))
+	}
+
+	// uninstalling removes all remaining objects
+	statusUpdater, err := m.Uninstall(appInstallation)
+	if err != nil {
+		t.Fatalf("Failed to uninstall: %v", err)
+	}
+
+	statusUpdater(&appInstallation.Status)
+
+	if configMapExists("app-ns", "d") {
+		t.Error("Expected ConfigMap d to be deleted.")
+	}
+
+	if appInstallation.Status.Inventory != nil {
+		t.Errorf("Expected inventory to be empty, got %v.", appInstallation.Status.Inventory)
+	}
+}
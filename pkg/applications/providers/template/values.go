@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"fmt"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// GetValues returns the values of the applicationInstallation. The values referenced in ValuesFrom are merged
+// in order and the inline Values or ValuesBlock are merged last.
+// Note that the returned values, as well as the returned errors, must never be written into the status or
+// events of the applicationInstallation, as they may contain secrets.
+func GetValues(ctx context.Context, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, clusterNamespace string, applicationInstallation *appskubermaticv1.ApplicationInstallation) (map[string]any, error) {
+	values := map[string]any{}
+
+	for _, ref := range applicationInstallation.Spec.ValuesFrom {
+		referencedValues, err := getReferencedValues(ctx, seedClient, userClient, clusterNamespace, applicationInstallation, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		values = mergeValues(values, referencedValues)
+	}
+
+	inlineValues, err := applicationInstallation.Spec.GetParsedValues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal values: %w", err)
+	}
+
+	return mergeValues(values, inlineValues), nil
+}
+
+// ValuesReferenceKey returns the namespaced name of the object referenced by ref.
+func ValuesReferenceKey(clusterNamespace string, applicationInstallation *appskubermaticv1.ApplicationInstallation, ref appskubermaticv1.ValuesReference) types.NamespacedName {
+	namespace := applicationInstallation.Namespace
+	if ref.GetLocation() == appskubermaticv1.ClusterNamespaceValuesReferenceLocation {
+		namespace = clusterNamespace
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}
+}
+
+func getReferencedValues(ctx context.Context, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, clusterNamespace string, applicationInstallation *appskubermaticv1.ApplicationInstallation, ref appskubermaticv1.ValuesReference) (map[string]any, error) {
+	client := userClient
+	if ref.GetLocation() == appskubermaticv1.ClusterNamespaceValuesReferenceLocation {
+		client = seedClient
+	}
+
+	key := ValuesReferenceKey(clusterNamespace, applicationInstallation, ref)
+
+	var (
+		obj   ctrlruntimeclient.Object
+		data  []byte
+		found bool
+	)
+
+	switch ref.Kind {
+	case appskubermaticv1.SecretValuesReferenceKind:
+		obj = &corev1.Secret{}
+	case appskubermaticv1.ConfigMapValuesReferenceKind:
+		obj = &corev1.ConfigMap{}
+	default:
+		return nil, fmt.Errorf("unsupported kind %q for values reference %s", ref.Kind, key)
+	}
+
+	if err := client.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) && ref.Optional {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get %s %s: %w", ref.Kind, key, err)
+	}
+
+	// only objects explicitly provided for applications can be read from the seed cluster
+	if ref.GetLocation() == appskubermaticv1.ClusterNamespaceValuesReferenceLocation && obj.GetLabels()[appskubermaticv1.ApplicationValuesLabel] != "true" {
+		return nil, fmt.Errorf("%s %s is not labelled with %s=true", ref.Kind, key, appskubermaticv1.ApplicationValuesLabel)
+	}
+
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		data, found = o.Data[ref.GetKey()]
+	case *corev1.ConfigMap:
+		var value string
+		value, found = o.Data[ref.GetKey()]
+		data = []byte(value)
+	}
+
+	if !found {
+		if ref.Optional {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("key %q not found in %s %s", ref.GetKey(), ref.Kind, key)
+	}
+
+	values := map[string]any{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		// the error of the YAML parser may quote parts of the values, so it is not returned for secrets
+		if ref.Kind == appskubermaticv1.SecretValuesReferenceKind {
+			return nil, fmt.Errorf("failed to parse values from key %q in %s %s", ref.GetKey(), ref.Kind, key)
+		}
+
+		return nil, fmt.Errorf("failed to parse values from key %q in %s %s: %w", ref.GetKey(), ref.Kind, key, err)
+	}
+
+	return values, nil
+}
+
+// mergeValues merges the overrides into the base values. Nested maps are merged recursively, all other
+// values in overrides replace the ones in base.
+func mergeValues(base, overrides map[string]any) map[string]any {
+	for key, override := range overrides {
+		if overrideMap, ok := override.(map[string]any); ok {
+			if baseMap, ok := base[key].(map[string]any); ok {
+				base[key] = mergeValues(baseMap, overrideMap)
+				continue
+			}
+		}
+
+		base[key] = override
+	}
+
+	return base
+}
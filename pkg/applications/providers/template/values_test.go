@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetValues(t *testing.T) {
+	const clusterNamespace = "cluster-abc"
+
+	seedClient := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "admin-values",
+				Namespace: clusterNamespace,
+				Labels:    map[string]string{appskubermaticv1.ApplicationValuesLabel: "true"},
+			},
+			Data: map[string][]byte{"values.yaml": []byte("license: admin-key\ndb:\n  host: admin-host\n")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: clusterNamespace},
+			Data:       map[string][]byte{"values.yaml": []byte("key: value\n")},
+		},
+	).Build()
+
+	userClient := fake.NewClientBuilder().WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "apps"},
+			Data:       map[string]string{"values.yaml": "db:\n  host: cm-host\n  port: 5432\nreplicas: 1\n", "other": "replicas: 2\n"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "apps"},
+			Data:       map[string][]byte{"password": []byte("db:\n  password: s3cr3t\n"), "broken": []byte("s3cr3t: [")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "other"},
+			Data:       map[string][]byte{"values.yaml": []byte("wrong: namespace\n")},
+		},
+	).Build()
+
+	testCases := []struct {
+		name           string
+		valuesFrom     []appskubermaticv1.ValuesReference
+		valuesBlock    string
+		expectedValues map[string]any
+		expectedErr    bool
+	}{
+		{
+			name:           "inline values are used without references",
+			valuesBlock:    "replicas: 3\n",
+			expectedValues: map[string]any{"replicas": float64(3)},
+		},
+		{
+			name: "references are merged in order and inline values take precedence",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ConfigMapValuesReferenceKind, Name: "values"},
+				{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "credentials", Key: "password"},
+				{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "admin-values", Location: appskubermaticv1.ClusterNamespaceValuesReferenceLocation},
+				{Kind: appskubermaticv1.ConfigMapValuesReferenceKind, Name: "values", Key: "other"},
+			},
+			valuesBlock: "db:\n  port: 3306\n",
+			expectedValues: map[string]any{
+				"license":  "admin-key",
+				"replicas": float64(2),
+				"db": map[string]any{
+					"host":     "admin-host",
+					"port":     float64(3306),
+					"password": "s3cr3t",
+				},
+			},
+		},
+		{
+			name: "missing optional references are ignored",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "does-not-exist", Optional: true},
+				{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "credentials", Key: "does-not-exist", Optional: true},
+			},
+			expectedValues: map[string]any{},
+		},
+		{
+			name: "missing object fails",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "does-not-exist"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "missing key fails",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "credentials"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "objects in the cluster namespace must be labelled",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "ca", Location: appskubermaticv1.ClusterNamespaceValuesReferenceLocation},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "objects in the cluster namespace are not read from the user cluster",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ConfigMapValuesReferenceKind, Name: "values", Location: appskubermaticv1.ClusterNamespaceValuesReferenceLocation},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appInstallation := &appskubermaticv1.ApplicationInstallation{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "apps"},
+				Spec: appskubermaticv1.ApplicationInstallationSpec{
+					ValuesFrom:  tc.valuesFrom,
+					ValuesBlock: tc.valuesBlock,
+				},
+			}
+
+			values, err := GetValues(context.Background(), seedClient, userClient, clusterNamespace, appInstallation)
+			if tc.expectedErr != (err != nil) {
+				t.Fatalf("Expected error: %v, got %v", tc.expectedErr, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !diff.SemanticallyEqual(tc.expectedValues, values) {
+				t.Fatalf("Values do not match expectation:\n%v", diff.ObjectDiff(tc.expectedValues, values))
+			}
+		})
+	}
+}
+
+func TestGetValuesDoesNotLeakSecrets(t *testing.T) {
+	userClient := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "apps"},
+			Data:       map[string][]byte{"values.yaml": []byte("s3cr3t")},
+		},
+	).Build()
+
+	appInstallation := &appskubermaticv1.ApplicationInstallation{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "apps"},
+		Spec: appskubermaticv1.ApplicationInstallationSpec{
+			ValuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "credentials"},
+			},
+		},
+	}
+
+	_, err := GetValues(context.Background(), nil, userClient, "cluster-abc", appInstallation)
+	if err == nil {
+		t.Fatal("Expected invalid values to be rejected.")
+	}
+
+	if strings.Contains(err.Error(), "s3cr3t") {
+		t.Fatalf("Error must not contain the values, got %q.", err)
+	}
+}
@@ -65,13 +65,20 @@ type TemplateProvider interface {
 
 	// Rollback the Application to the previous release
 	Rollback(applicationInstallation *appskubermaticv1.ApplicationInstallation) error
+
+	// CheckDrift checks the deployed release for drifted objects, without installing or upgrading it.
+	CheckDrift(appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error)
 }
 
 // NewTemplateProvider return the concrete implementation of TemplateProvider according to the templateMethod.
-func NewTemplateProvider(ctx context.Context, seedClient ctrlruntimeclient.Client, clusterName string, kubeconfig string, cacheDir string, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation, secretNamespace string) (TemplateProvider, error) {
+func NewTemplateProvider(ctx context.Context, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, clusterName string, kubeconfig string, cacheDir string, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation, secretNamespace string) (TemplateProvider, error) {
 	switch appInstallation.Status.Method {
 	case appskubermaticv1.HelmTemplateMethod:
-		return template.HelmTemplate{Ctx: ctx, Kubeconfig: kubeconfig, CacheDir: cacheDir, Log: log, SecretNamespace: secretNamespace, ClusterName: clusterName, SeedClient: seedClient}, nil
+		return template.HelmTemplate{Ctx: ctx, Kubeconfig: kubeconfig, CacheDir: cacheDir, Log: log, SecretNamespace: secretNamespace, ClusterName: clusterName, SeedClient: seedClient, UserClient: userClient}, nil
+	case appskubermaticv1.KustomizeTemplateMethod:
+		return template.ManifestsTemplate{Ctx: ctx, Log: log, UserClient: userClient, Kustomize: true}, nil
+	case appskubermaticv1.ManifestsTemplateMethod:
+		return template.ManifestsTemplate{Ctx: ctx, Log: log, UserClient: userClient}, nil
 	default:
 		return nil, fmt.Errorf("template method '%v' not implemented", appInstallation.Status.Method)
 	}
@@ -117,6 +117,50 @@ func HelmAuthFromCredentials(
 	return auth, nil
 }
 
+// HelmVerifySettingsFromVerification builds helmclient.VerifySettings from the chart verification.
+// keyringFilePath is the path of the file that stores the OpenPGP keyring for provenance verification.
+// If verification is nil then an empty helmclient.VerifySettings (i.e. no verification) is returned.
+func HelmVerifySettingsFromVerification(
+	ctx context.Context,
+	client ctrlruntimeclient.Client,
+	keyringFilePath string,
+	secretNamespace string,
+	verification *appskubermaticv1.HelmChartVerification,
+) (helmclient.VerifySettings, error) {
+	verify := helmclient.VerifySettings{}
+	if verification == nil {
+		return verify, nil
+	}
+
+	if cosign := verification.Cosign; cosign != nil {
+		if cosign.PublicKey != nil {
+			publicKey, err := GetCredentialFromSecret(ctx, client, secretNamespace, cosign.PublicKey.Name, cosign.PublicKey.Key)
+			if err != nil {
+				return verify, err
+			}
+			verify.CosignPublicKey = []byte(publicKey)
+		}
+		if cosign.Keyless != nil {
+			verify.CosignIssuer = cosign.Keyless.Issuer
+			verify.CosignSubject = cosign.Keyless.Subject
+		}
+		verify.IgnoreTransparencyLog = cosign.IgnoreTransparencyLog
+	}
+
+	if provenance := verification.Provenance; provenance != nil {
+		keyring, err := GetCredentialFromSecret(ctx, client, secretNamespace, provenance.Keyring.Name, provenance.Keyring.Key)
+		if err != nil {
+			return verify, err
+		}
+		if err := os.WriteFile(keyringFilePath, []byte(keyring), 0600); err != nil {
+			return helmclient.VerifySettings{}, fmt.Errorf("failed to write keyring: %w", err)
+		}
+		verify.Keyring = keyringFilePath
+	}
+
+	return verify, nil
+}
+
 func NewAuthSettingsFromHelmSource(source *appskubermaticv1.HelmSource) helmclient.AuthSettings {
 	auth := helmclient.AuthSettings{}
 
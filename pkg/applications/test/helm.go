@@ -17,7 +17,12 @@ limitations under the License.
 package test
 
 import (
+	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -35,12 +40,22 @@ import (
 	"github.com/containerd/containerd/remotes/docker"
 	"github.com/distribution/distribution/v3/configuration"
 	"github.com/distribution/distribution/v3/registry"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/phayes/freeport"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/openpgp" //nolint:staticcheck // Helm requires OpenPGP keys of the deprecated package.
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/provenance"
 	"helm.sh/helm/v3/pkg/pusher"
 	registry2 "helm.sh/helm/v3/pkg/registry"
 	"helm.sh/helm/v3/pkg/repo/repotest"
@@ -500,3 +515,100 @@ func CopyDir(source string, destination string) error {
 		}
 	})
 }
+
+// SignOciChart signs the chart pushed to the plain HTTP OCI registry ociRegistryURL with a new cosign key pair. The
+// signature is not uploaded to a transparency log. It returns the PEM-encoded public key to verify the signature.
+func SignOciChart(t *testing.T, ociRegistryURL string, chartName string, version string) []byte {
+	t.Helper()
+
+	ref, err := name.ParseReference(strings.TrimPrefix(ociRegistryURL, "oci://")+"/"+chartName+":"+version, name.Insecure)
+	if err != nil {
+		t.Fatalf("failed to parse chart reference: %s", err)
+	}
+
+	desc, err := remote.Get(ref)
+	if err != nil {
+		t.Fatalf("failed to get chart manifest: %s", err)
+	}
+	digest := ref.Context().Digest(desc.Digest.String())
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	signer, err := signature.LoadECDSASignerVerifier(privateKey, crypto.SHA256)
+	if err != nil {
+		t.Fatalf("failed to load signer: %s", err)
+	}
+
+	sigPayload, err := payload.Cosign{Image: digest}.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to create signature payload: %s", err)
+	}
+
+	rawSignature, err := signer.SignMessage(bytes.NewReader(sigPayload))
+	if err != nil {
+		t.Fatalf("failed to sign chart: %s", err)
+	}
+
+	sig, err := static.NewSignature(sigPayload, base64.StdEncoding.EncodeToString(rawSignature))
+	if err != nil {
+		t.Fatalf("failed to create signature: %s", err)
+	}
+
+	entity, err := ociremote.SignedEntity(digest)
+	if err != nil {
+		t.Fatalf("failed to get signed entity: %s", err)
+	}
+
+	entity, err = mutate.AttachSignatureToEntity(entity, sig)
+	if err != nil {
+		t.Fatalf("failed to attach signature: %s", err)
+	}
+
+	if err := ociremote.WriteSignatures(digest.Repository, entity); err != nil {
+		t.Fatalf("failed to push signature: %s", err)
+	}
+
+	publicKey, err := cryptoutils.MarshalPublicKeyToPEM(privateKey.Public())
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %s", err)
+	}
+
+	return publicKey
+}
+
+// SignChartProvenance creates the provenance file (.prov) next to the chart archive with a new OpenPGP key. It returns
+// the path to the public keyring to verify the provenance file.
+func SignChartProvenance(t *testing.T, chartArchive string) string {
+	t.Helper()
+
+	entity, err := openpgp.NewEntity("kubermatic-test", "", "test@kubermatic.com", nil)
+	if err != nil {
+		t.Fatalf("failed to generate OpenPGP key: %s", err)
+	}
+
+	signatory := &provenance.Signatory{Entity: entity}
+	prov, err := signatory.ClearSign(chartArchive)
+	if err != nil {
+		t.Fatalf("failed to sign chart: %s", err)
+	}
+
+	if err := os.WriteFile(chartArchive+".prov", []byte(prov), 0600); err != nil {
+		t.Fatalf("failed to write provenance file: %s", err)
+	}
+
+	keyring := filepath.Join(t.TempDir(), "pubring.gpg")
+	f, err := os.Create(keyring)
+	if err != nil {
+		t.Fatalf("failed to create keyring: %s", err)
+	}
+	defer f.Close()
+
+	if err := entity.Serialize(f); err != nil {
+		t.Fatalf("failed to write keyring: %s", err)
+	}
+
+	return keyring
+}
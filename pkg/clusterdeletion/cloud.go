@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/aws"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/azure"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/gcp"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/hetzner"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/kubevirt"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/nutanix"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/openstack"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/vmwareclouddirector"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/vsphere"
+)
+
+// cloudResource is a resource that the cloud provider cleanup removes while the
+// given finalizer is set on the cluster.
+type cloudResource struct {
+	finalizer string
+	kind      string
+	// name is the name or ID of the resource, it is empty if the resource has not been
+	// created or the finalizer does not remove a resource by itself.
+	name string
+}
+
+// cloudResources returns the cloud provider of the cluster and the resources its cleanup
+// removes. The finalizers are listed explicitly, as they do not share a common naming scheme
+// with the provider names; the constants of the providers are used to keep both in sync.
+func cloudResources(cluster *kubermaticv1.Cluster) (kubermaticv1.ProviderType, []cloudResource) {
+	cloud := cluster.Spec.Cloud
+
+	switch {
+	case cloud.AWS != nil:
+		spec := cloud.AWS
+
+		return kubermaticv1.AWSCloudProvider, []cloudResource{
+			{finalizer: aws.CleanupFinalizer, kind: "InstanceProfile", name: spec.InstanceProfileName},
+			{finalizer: aws.CleanupFinalizer, kind: "Role", name: spec.ControlPlaneRoleARN},
+			{finalizer: aws.CleanupFinalizer, kind: "SecurityGroup", name: spec.SecurityGroupID},
+			{finalizer: aws.CleanupFinalizer, kind: "VPCTags", name: spec.VPCID},
+			{finalizer: aws.CleanupFinalizer, kind: "RouteTableTags", name: spec.RouteTableID},
+			// deprecated finalizers that might still exist on older clusters
+			{finalizer: aws.InstanceProfileCleanupFinalizer, kind: "InstanceProfile", name: spec.InstanceProfileName},
+			{finalizer: aws.ControlPlaneRoleCleanupFinalizer, kind: "Role", name: spec.ControlPlaneRoleARN},
+			{finalizer: aws.SecurityGroupCleanupFinalizer, kind: "SecurityGroup", name: spec.SecurityGroupID},
+			{finalizer: aws.TagCleanupFinalizer, kind: "VPCTags", name: spec.VPCID},
+			{finalizer: aws.TagCleanupFinalizer, kind: "RouteTableTags", name: spec.RouteTableID},
+		}
+
+	case cloud.Azure != nil:
+		spec := cloud.Azure
+
+		return kubermaticv1.AzureCloudProvider, []cloudResource{
+			{finalizer: azure.FinalizerSecurityGroup, kind: "SecurityGroup", name: spec.SecurityGroup},
+			{finalizer: azure.FinalizerRouteTable, kind: "RouteTable", name: spec.RouteTableName},
+			{finalizer: azure.FinalizerSubnet, kind: "Subnet", name: spec.SubnetName},
+			{finalizer: azure.FinalizerVNet, kind: "VirtualNetwork", name: spec.VNetName},
+			{finalizer: azure.FinalizerResourceGroup, kind: "ResourceGroup", name: spec.ResourceGroup},
+			{finalizer: azure.FinalizerAvailabilitySet, kind: "AvailabilitySet", name: spec.AvailabilitySet},
+		}
+
+	case cloud.GCP != nil:
+		resources := []cloudResource{
+			{finalizer: gcp.FirewallSelfCleanupFinalizer, kind: "FirewallRule", name: fmt.Sprintf(gcp.SelfRuleNamePattern, cluster.Name)},
+			{finalizer: gcp.RoutesCleanupFinalizer, kind: "Routes", name: cloud.GCP.Network},
+		}
+
+		if cluster.IsIPv4Only() || cluster.IsDualStack() {
+			resources = append(resources,
+				cloudResource{finalizer: gcp.FirewallICMPCleanupFinalizer, kind: "FirewallRule", name: fmt.Sprintf(gcp.ICMPRuleNamePattern, cluster.Name)},
+				cloudResource{finalizer: gcp.FirewallNodePortCleanupFinalizer, kind: "FirewallRule", name: fmt.Sprintf(gcp.NodePortRuleNamePattern, cluster.Name)},
+			)
+		}
+
+		if cluster.IsIPv6Only() || cluster.IsDualStack() {
+			resources = append(resources,
+				cloudResource{finalizer: gcp.FirewallICMPCleanupFinalizer, kind: "FirewallRule", name: fmt.Sprintf(gcp.ICMPIPv6RuleNamePattern, cluster.Name)},
+				cloudResource{finalizer: gcp.FirewallNodePortCleanupFinalizer, kind: "FirewallRule", name: fmt.Sprintf(gcp.NodePortIPv6RuleNamePattern, cluster.Name)},
+			)
+		}
+
+		return kubermaticv1.GCPCloudProvider, resources
+
+	case cloud.Openstack != nil:
+		spec := cloud.Openstack
+
+		return kubermaticv1.OpenstackCloudProvider, []cloudResource{
+			{finalizer: openstack.SecurityGroupCleanupFinalizer, kind: "SecurityGroup", name: spec.SecurityGroups},
+			{finalizer: openstack.RouterSubnetLinkCleanupFinalizer, kind: "RouterInterface", name: spec.SubnetID},
+			{finalizer: openstack.RouterIPv6SubnetLinkCleanupFinalizer, kind: "RouterInterface", name: spec.IPv6SubnetID},
+			{finalizer: openstack.RouterCleanupFinalizer, kind: "Router", name: spec.RouterID},
+			{finalizer: openstack.SubnetCleanupFinalizer, kind: "Subnet", name: spec.SubnetID},
+			{finalizer: openstack.IPv6SubnetCleanupFinalizer, kind: "Subnet", name: spec.IPv6SubnetID},
+			{finalizer: openstack.NetworkCleanupFinalizer, kind: "Network", name: spec.Network},
+			// legacy finalizer that removes all network components
+			{finalizer: openstack.OldNetworkCleanupFinalizer, kind: "Router", name: spec.RouterID},
+			{finalizer: openstack.OldNetworkCleanupFinalizer, kind: "Subnet", name: spec.SubnetID},
+			{finalizer: openstack.OldNetworkCleanupFinalizer, kind: "Network", name: spec.Network},
+		}
+
+	case cloud.Hetzner != nil:
+		return kubermaticv1.HetznerCloudProvider, []cloudResource{
+			{finalizer: hetzner.FirewallCleanupFinalizer, kind: "Firewall", name: cloud.Hetzner.Firewall},
+			{finalizer: hetzner.NetworkCleanupFinalizer, kind: "Network", name: cloud.Hetzner.Network},
+		}
+
+	case cloud.VSphere != nil:
+		spec := cloud.VSphere
+
+		resources := []cloudResource{
+			{finalizer: vsphere.FolderCleanupFinalizer, kind: "Folder", name: spec.Folder},
+			// legacy finalizer that is removed without any cleanup
+			{finalizer: vsphere.TagCategoryCleanupFinalizer},
+		}
+
+		if spec.Tags != nil {
+			for _, tag := range spec.Tags.Tags {
+				resources = append(resources, cloudResource{finalizer: vsphere.TagCleanupFinalizer, kind: "Tag", name: tag})
+			}
+		} else {
+			resources = append(resources, cloudResource{finalizer: vsphere.TagCleanupFinalizer})
+		}
+
+		return kubermaticv1.VSphereCloudProvider, resources
+
+	case cloud.VMwareCloudDirector != nil:
+		return kubermaticv1.VMwareCloudDirectorCloudProvider, []cloudResource{
+			{finalizer: vmwareclouddirector.VAppCleanupFinalizer, kind: "VApp", name: cloud.VMwareCloudDirector.VApp},
+		}
+
+	case cloud.Nutanix != nil:
+		return kubermaticv1.NutanixCloudProvider, []cloudResource{
+			{finalizer: nutanix.CategoryCleanupFinalizer, kind: "CategoryValue", name: nutanix.CategoryValue(cluster.Name)},
+		}
+
+	case cloud.Kubevirt != nil:
+		// datacenters in namespaced mode keep the namespace
+		return kubermaticv1.KubevirtCloudProvider, []cloudResource{
+			{finalizer: kubevirt.FinalizerNamespace, kind: "Namespace", name: cluster.Status.NamespaceName},
+			// the cloner RBAC is removed together with the namespace
+			{finalizer: kubevirt.FinalizerClonerRoleBinding},
+		}
+	}
+
+	return kubermaticv1.ProviderType(cloud.ProviderName), nil
+}
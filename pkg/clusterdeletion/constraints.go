@@ -46,12 +46,12 @@ func (d *Deletion) cleanupConstraints(ctx context.Context, log *zap.SugaredLogge
 		// for example).
 		// So here we just remove the finalizer from constraints so that user-cluster namespace can be garbage-collected.
 		// Ref:https://github.com/kubermatic/kubermatic/issues/6934
-		constraintList := &kubermaticv1.ConstraintList{}
-		if err := d.seedClient.List(ctx, constraintList, ctrlruntimeclient.InNamespace(ns)); err != nil {
+		constraints, err := listConstraints(ctx, d.seedClient, ns)
+		if err != nil {
 			return err
 		}
 
-		for _, constraint := range constraintList.Items {
+		for _, constraint := range constraints {
 			if finalizer := kubermaticv1.GatekeeperConstraintCleanupFinalizer; kuberneteshelper.HasFinalizer(&constraint, finalizer) {
 				log.Infow("Garbage-collecting Constraint", "constraint", constraint.Name)
 
@@ -66,3 +66,13 @@ func (d *Deletion) cleanupConstraints(ctx context.Context, log *zap.SugaredLogge
 
 	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, kubermaticv1.KubermaticConstraintCleanupFinalizer)
 }
+
+// listConstraints returns all Constraints in the given cluster namespace.
+func listConstraints(ctx context.Context, seedClient ctrlruntimeclient.Client, namespace string) ([]kubermaticv1.Constraint, error) {
+	constraintList := &kubermaticv1.ConstraintList{}
+	if err := seedClient.List(ctx, constraintList, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Constraints: %w", err)
+	}
+
+	return constraintList.Items, nil
+}
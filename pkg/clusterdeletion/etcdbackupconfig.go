@@ -34,19 +34,19 @@ func (d *Deletion) cleanupEtcdBackupConfigs(ctx context.Context, cluster *kuberm
 
 	if cluster.Status.NamespaceName != "" {
 		// always attempt to cleanup, even if the controllers might be disabled now
-		backupConfigs := &kubermaticv1.EtcdBackupConfigList{}
-		if err := d.seedClient.List(ctx, backupConfigs, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
-			return fmt.Errorf("failed to get EtcdBackupConfigs: %w", err)
+		backupConfigs, err := listEtcdBackupConfigs(ctx, d.seedClient, cluster.Status.NamespaceName)
+		if err != nil {
+			return err
 		}
 
-		if len(backupConfigs.Items) > 0 {
-			for _, backupConfig := range backupConfigs.Items {
+		if len(backupConfigs) > 0 {
+			for _, backupConfig := range backupConfigs {
 				if err := d.seedClient.Delete(ctx, &backupConfig); err != nil {
 					return fmt.Errorf("failed to delete EtcdBackupConfig %q: %w", backupConfig.Name, err)
 				}
 			}
 
-			d.recorder.Eventf(cluster, corev1.EventTypeNormal, "EtcdBackupConfigCleanup", "There are %d EtcdBackupConfig objects waiting for deletion.", len(backupConfigs.Items))
+			d.recorder.Eventf(cluster, corev1.EventTypeNormal, "EtcdBackupConfigCleanup", "There are %d EtcdBackupConfig objects waiting for deletion.", len(backupConfigs))
 			return nil
 		}
 	}
@@ -55,3 +55,13 @@ func (d *Deletion) cleanupEtcdBackupConfigs(ctx context.Context, cluster *kuberm
 
 	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, kubermaticv1.EtcdBackupConfigCleanupFinalizer)
 }
+
+// listEtcdBackupConfigs returns all EtcdBackupConfigs in the given cluster namespace.
+func listEtcdBackupConfigs(ctx context.Context, seedClient ctrlruntimeclient.Client, namespace string) ([]kubermaticv1.EtcdBackupConfig, error) {
+	backupConfigs := &kubermaticv1.EtcdBackupConfigList{}
+	if err := seedClient.List(ctx, backupConfigs, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to get EtcdBackupConfigs: %w", err)
+	}
+
+	return backupConfigs.Items, nil
+}
@@ -47,33 +47,48 @@ func (d *Deletion) cleanupLBs(ctx context.Context, log *zap.SugaredLogger, clust
 		return false, err
 	}
 
+	services, err := loadBalancerServices(ctx, userClusterClient)
+	if err != nil {
+		return false, err
+	}
+
+	for _, service := range services {
+		serviceName := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
+		slog := log.With("service", serviceName)
+
+		if err := d.cleanupLB(ctx, slog, userClusterClient, &service, cluster); err != nil {
+			return deletedSomeLBs, fmt.Errorf("failed to delete service %q inside user cluster: %w", serviceName, err)
+		}
+		deletedSomeLBs = true
+	}
+
+	return deletedSomeLBs, nil
+}
+
+// loadBalancerServices returns all services of type LoadBalancer in the user cluster
+// that are not yet being deleted.
+func loadBalancerServices(ctx context.Context, userClusterClient ctrlruntimeclient.Client) ([]corev1.Service, error) {
 	serviceList := &corev1.ServiceList{}
 	if err := userClusterClient.List(ctx, serviceList); err != nil {
-		return false, fmt.Errorf("failed to list Service's from user cluster: %w", err)
+		return nil, fmt.Errorf("failed to list Service's from user cluster: %w", err)
 	}
 
+	var services []corev1.Service
 	for _, service := range serviceList.Items {
 		// This service is already in deletion, nothing further needs to happen.
 		if service.DeletionTimestamp != nil {
 			continue
 		}
 
-		serviceName := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
-		slog := log.With("service", serviceName)
-
 		// Only LoadBalancer services incur charges on cloud providers
 		if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
-			slog.Debug("Skipping cleanup of service as it's not a LoadBalancer")
 			continue
 		}
 
-		if err := d.cleanupLB(ctx, slog, userClusterClient, &service, cluster); err != nil {
-			return deletedSomeLBs, fmt.Errorf("failed to delete service %q inside user cluster: %w", serviceName, err)
-		}
-		deletedSomeLBs = true
+		services = append(services, service)
 	}
 
-	return deletedSomeLBs, nil
+	return services, nil
 }
 
 func (d *Deletion) cleanupLB(ctx context.Context, log *zap.SugaredLogger, userClusterClient ctrlruntimeclient.Client, service *corev1.Service, cluster *kubermaticv1.Cluster) error {
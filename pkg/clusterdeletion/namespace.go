@@ -28,6 +28,8 @@ import (
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -36,25 +38,13 @@ func (d *Deletion) cleanupNamespace(ctx context.Context, log *zap.SugaredLogger,
 		return nil
 	}
 
-	// It can happen that the namespace is correctly created, but the status update failed.
-	// In this case we replicate the cluster controller's defaulting behaviour to catch the
-	// default namespace name.
-	namespace := cluster.Status.NamespaceName
-	if namespace == "" {
-		namespace = kubernetesprovider.NamespaceName(cluster.Name)
-	}
-
-	// check if the namespace still exists
-	ns := &corev1.Namespace{}
-	ns.Name = namespace
-
-	err := d.seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(ns), ns)
-	if ctrlruntimeclient.IgnoreNotFound(err) != nil {
-		return fmt.Errorf("failed to check for cluster namespace: %w", err)
+	ns, err := getClusterNamespace(ctx, d.seedClient, cluster)
+	if err != nil {
+		return err
 	}
 
 	// namespace could still be retrieved
-	if err == nil {
+	if ns != nil {
 		if ns.DeletionTimestamp == nil {
 			log.Infow("deleting cluster namespace", "namespace", ns.Name)
 			if err := d.seedClient.Delete(ctx, ns); ctrlruntimeclient.IgnoreNotFound(err) != nil {
@@ -79,3 +69,25 @@ func (d *Deletion) cleanupNamespace(ctx context.Context, log *zap.SugaredLogger,
 
 	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, kubermaticv1.NamespaceCleanupFinalizer)
 }
+
+// getClusterNamespace returns the cluster namespace on the seed, or nil if it does not exist.
+func getClusterNamespace(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (*corev1.Namespace, error) {
+	// It can happen that the namespace is correctly created, but the status update failed.
+	// In this case we replicate the cluster controller's defaulting behaviour to catch the
+	// default namespace name.
+	namespace := cluster.Status.NamespaceName
+	if namespace == "" {
+		namespace = kubernetesprovider.NamespaceName(cluster.Name)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to check for cluster namespace: %w", err)
+	}
+
+	return ns, nil
+}
@@ -68,47 +68,47 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 
 	listOpts := ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)
 
-	machineDeploymentList := &clusterv1alpha1.MachineDeploymentList{}
-	if err := userClusterClient.List(ctx, machineDeploymentList, listOpts); err != nil && !meta.IsNoMatchError(err) {
-		return fmt.Errorf("failed to list MachineDeployments: %w", err)
+	machineDeployments, err := listMachineDeployments(ctx, userClusterClient)
+	if err != nil {
+		return err
 	}
 
-	if len(machineDeploymentList.Items) > 0 {
+	if len(machineDeployments) > 0 {
 		if err = userClusterClient.DeleteAllOf(ctx, &clusterv1alpha1.MachineDeployment{}, listOpts); err != nil {
 			return fmt.Errorf("failed to delete MachineDeployments: %w", err)
 		}
 
 		// Return here to make sure we don't attempt to delete MachineSets until the MachineDeployment is actually gone
-		d.recorder.Eventf(cluster, corev1.EventTypeNormal, "NodeCleanup", "Waiting for %d MachineDeployment(s) to be destroyed.", len(machineDeploymentList.Items))
+		d.recorder.Eventf(cluster, corev1.EventTypeNormal, "NodeCleanup", "Waiting for %d MachineDeployment(s) to be destroyed.", len(machineDeployments))
 		return nil
 	}
 
-	machineSetList := &clusterv1alpha1.MachineSetList{}
-	if err := userClusterClient.List(ctx, machineSetList, listOpts); err != nil && !meta.IsNoMatchError(err) {
-		return fmt.Errorf("failed to list MachineSets: %w", err)
+	machineSets, err := listMachineSets(ctx, userClusterClient)
+	if err != nil {
+		return err
 	}
 
-	if len(machineSetList.Items) > 0 {
+	if len(machineSets) > 0 {
 		if err = userClusterClient.DeleteAllOf(ctx, &clusterv1alpha1.MachineSet{}, listOpts); err != nil {
 			return fmt.Errorf("failed to delete MachineSets: %w", err)
 		}
 
 		// Return here to make sure we don't attempt to delete Machines until the MachineSet is actually gone
-		d.recorder.Eventf(cluster, corev1.EventTypeNormal, "NodeCleanup", "Waiting for %d MachineSet(s) to be destroyed.", len(machineSetList.Items))
+		d.recorder.Eventf(cluster, corev1.EventTypeNormal, "NodeCleanup", "Waiting for %d MachineSet(s) to be destroyed.", len(machineSets))
 		return nil
 	}
 
-	machineList := &clusterv1alpha1.MachineList{}
-	if err := userClusterClient.List(ctx, machineList, listOpts); err != nil && !meta.IsNoMatchError(err) {
-		return fmt.Errorf("failed to get Machines: %w", err)
+	machines, err := listMachines(ctx, userClusterClient)
+	if err != nil {
+		return err
 	}
 
-	if len(machineList.Items) > 0 {
+	if len(machines) > 0 {
 		if err = userClusterClient.DeleteAllOf(ctx, &clusterv1alpha1.Machine{}, listOpts); err != nil {
 			return fmt.Errorf("failed to delete Machines: %w", err)
 		}
 
-		d.recorder.Eventf(cluster, corev1.EventTypeNormal, "NodeCleanup", "Waiting for %d Machine(s) to be destroyed.", len(machineList.Items))
+		d.recorder.Eventf(cluster, corev1.EventTypeNormal, "NodeCleanup", "Waiting for %d Machine(s) to be destroyed.", len(machines))
 		return nil
 	}
 
@@ -116,3 +116,34 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 
 	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, kubermaticv1.NodeDeletionFinalizer)
 }
+
+// listMachineDeployments returns all MachineDeployments in the user cluster. Clusters
+// without the machine CRDs have no MachineDeployments.
+func listMachineDeployments(ctx context.Context, userClusterClient ctrlruntimeclient.Client) ([]clusterv1alpha1.MachineDeployment, error) {
+	machineDeploymentList := &clusterv1alpha1.MachineDeploymentList{}
+	if err := userClusterClient.List(ctx, machineDeploymentList, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil && !meta.IsNoMatchError(err) {
+		return nil, fmt.Errorf("failed to list MachineDeployments: %w", err)
+	}
+
+	return machineDeploymentList.Items, nil
+}
+
+// listMachineSets returns all MachineSets in the user cluster.
+func listMachineSets(ctx context.Context, userClusterClient ctrlruntimeclient.Client) ([]clusterv1alpha1.MachineSet, error) {
+	machineSetList := &clusterv1alpha1.MachineSetList{}
+	if err := userClusterClient.List(ctx, machineSetList, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil && !meta.IsNoMatchError(err) {
+		return nil, fmt.Errorf("failed to list MachineSets: %w", err)
+	}
+
+	return machineSetList.Items, nil
+}
+
+// listMachines returns all Machines in the user cluster.
+func listMachines(ctx context.Context, userClusterClient ctrlruntimeclient.Client) ([]clusterv1alpha1.Machine, error) {
+	machineList := &clusterv1alpha1.MachineList{}
+	if err := userClusterClient.List(ctx, machineList, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil && !meta.IsNoMatchError(err) {
+		return nil, fmt.Errorf("failed to get Machines: %w", err)
+	}
+
+	return machineList.Items, nil
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ReportStep is the cleanup step that removes an item of a deletion report.
+type ReportStep string
+
+const (
+	ReportStepConstraints       ReportStep = "Constraints"
+	ReportStepLoadBalancers     ReportStep = "LoadBalancers"
+	ReportStepVolumes           ReportStep = "Volumes"
+	ReportStepEtcdBackupConfigs ReportStep = "EtcdBackupConfigs"
+	ReportStepNodes             ReportStep = "Nodes"
+	ReportStepNamespace         ReportStep = "Namespace"
+	ReportStepBindings          ReportStep = "Bindings"
+	ReportStepCredentials       ReportStep = "Credentials"
+	ReportStepCloudProvider     ReportStep = "CloudProvider"
+	ReportStepOther             ReportStep = "Other"
+)
+
+const (
+	// ReportLocationSeed marks objects on the seed cluster.
+	ReportLocationSeed = "seed"
+	// ReportLocationUserCluster marks objects inside the user cluster.
+	ReportLocationUserCluster = "usercluster"
+)
+
+// Report lists everything that is removed when a cluster is deleted. It is created by
+// walking the same steps as CleanupCluster, without performing any of them.
+type Report struct {
+	Cluster     string      `json:"cluster"`
+	GeneratedAt metav1.Time `json:"generatedAt"`
+	// Objects are the Kubernetes objects, etcd backups and cloud resources that are removed.
+	Objects []ReportObject `json:"objects,omitempty"`
+	// RetainedBackups are the etcd backups that are kept, as their EtcdBackupConfig
+	// retains its backups.
+	RetainedBackups []ReportObject `json:"retainedBackups,omitempty"`
+	// Warnings lists the parts of the report that could not be determined, for example
+	// because the user cluster was unreachable.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ReportObject is a single item of a deletion report.
+type ReportObject struct {
+	Step ReportStep `json:"step"`
+	// Location is either "seed", "usercluster", the name of an etcd backup destination
+	// or the name of a cloud provider.
+	Location  string `json:"location"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// handledFinalizers are the cluster finalizers that are processed by CleanupCluster itself;
+// all other finalizers belong to cleanups performed by other controllers.
+var handledFinalizers = sets.New(
+	kubermaticv1.KubermaticConstraintCleanupFinalizer,
+	kubermaticv1.InClusterLBCleanupFinalizer,
+	kubermaticv1.InClusterPVCleanupFinalizer,
+	kubermaticv1.EtcdBackupConfigCleanupFinalizer,
+	kubermaticv1.NodeDeletionFinalizer,
+	clusterRoleBindingsCleanupFinalizer,
+	kubermaticv1.NamespaceCleanupFinalizer,
+	kubermaticv1.CredentialsSecretsCleanupFinalizer,
+)
+
+// Report returns everything CleanupCluster would remove for the given cluster. Problems
+// with individual steps, like an unreachable user cluster, are recorded as warnings.
+func (d *Deletion) Report(ctx context.Context, cluster *kubermaticv1.Cluster, now time.Time) (*Report, error) {
+	report := &Report{
+		Cluster:     cluster.Name,
+		GeneratedAt: metav1.NewTime(now),
+	}
+
+	steps := []struct {
+		step ReportStep
+		fn   func(context.Context, *Report, *kubermaticv1.Cluster) error
+	}{
+		{step: ReportStepConstraints, fn: d.reportConstraints},
+		{step: ReportStepLoadBalancers, fn: d.reportLBs},
+		{step: ReportStepVolumes, fn: d.reportVolumes},
+		{step: ReportStepEtcdBackupConfigs, fn: d.reportEtcdBackupConfigs},
+		{step: ReportStepNodes, fn: d.reportNodes},
+		{step: ReportStepNamespace, fn: d.reportNamespace},
+		{step: ReportStepCredentials, fn: d.reportCredentialsSecrets},
+	}
+
+	for _, s := range steps {
+		if err := s.fn(ctx, report, cluster); err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+
+			report.Warnings = append(report.Warnings, fmt.Sprintf("%s: %v", s.step, err))
+		}
+	}
+
+	reportFinalizers(report, cluster)
+
+	return report, nil
+}
+
+func (r *Report) add(step ReportStep, location, kind, namespace, name string) {
+	r.Objects = append(r.Objects, ReportObject{
+		Step:      step,
+		Location:  location,
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+	})
+}
+
+func (d *Deletion) reportConstraints(ctx context.Context, report *Report, cluster *kubermaticv1.Cluster) error {
+	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.KubermaticConstraintCleanupFinalizer) || cluster.Status.NamespaceName == "" {
+		return nil
+	}
+
+	constraints, err := listConstraints(ctx, d.seedClient, cluster.Status.NamespaceName)
+	if err != nil {
+		return err
+	}
+
+	for _, constraint := range constraints {
+		report.add(ReportStepConstraints, ReportLocationSeed, "Constraint", constraint.Namespace, constraint.Name)
+	}
+
+	return nil
+}
+
+func (d *Deletion) reportLBs(ctx context.Context, report *Report, cluster *kubermaticv1.Cluster) error {
+	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.InClusterLBCleanupFinalizer) || cluster.Status.NamespaceName == "" {
+		return nil
+	}
+
+	userClusterClient, err := d.userClusterClientGetter()
+	if err != nil {
+		return err
+	}
+
+	services, err := loadBalancerServices(ctx, userClusterClient)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		report.add(ReportStepLoadBalancers, ReportLocationUserCluster, "Service", service.Namespace, service.Name)
+	}
+
+	return nil
+}
+
+func (d *Deletion) reportVolumes(ctx context.Context, report *Report, cluster *kubermaticv1.Cluster) error {
+	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.InClusterPVCleanupFinalizer) || cluster.Status.NamespaceName == "" {
+		return nil
+	}
+
+	userClusterClient, err := d.userClusterClientGetter()
+	if err != nil {
+		return err
+	}
+
+	pvcList, pvList, err := listVolumes(ctx, userClusterClient)
+	if err != nil {
+		return err
+	}
+
+	if len(pvcList.Items) == 0 && len(pvList.Items) == 0 {
+		return nil
+	}
+
+	pods, err := listPVUsingPods(ctx, userClusterClient)
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods {
+		if pod.DeletionTimestamp == nil {
+			report.add(ReportStepVolumes, ReportLocationUserCluster, "Pod", pod.Namespace, pod.Name)
+		}
+	}
+
+	for _, pvc := range pvcList.Items {
+		if pvc.DeletionTimestamp == nil {
+			report.add(ReportStepVolumes, ReportLocationUserCluster, "PersistentVolumeClaim", pvc.Namespace, pvc.Name)
+		}
+	}
+
+	// PVs are not deleted directly, but removed by their provisioner together with the
+	// underlying cloud volume once their claim is gone.
+	for _, pv := range pvList.Items {
+		report.add(ReportStepVolumes, ReportLocationUserCluster, "PersistentVolume", "", pv.Name)
+	}
+
+	return nil
+}
+
+func (d *Deletion) reportEtcdBackupConfigs(ctx context.Context, report *Report, cluster *kubermaticv1.Cluster) error {
+	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.EtcdBackupConfigCleanupFinalizer) || cluster.Status.NamespaceName == "" {
+		return nil
+	}
+
+	backupConfigs, err := listEtcdBackupConfigs(ctx, d.seedClient, cluster.Status.NamespaceName)
+	if err != nil {
+		return err
+	}
+
+	for _, backupConfig := range backupConfigs {
+		report.add(ReportStepEtcdBackupConfigs, ReportLocationSeed, "EtcdBackupConfig", backupConfig.Namespace, backupConfig.Name)
+
+		// the etcd backup controller deletes all completed backups of a config when
+		// the config itself is deleted, unless they are retained
+		for _, backup := range backupConfig.Status.CurrentBackups {
+			if backup.BackupPhase != kubermaticv1.BackupStatusPhaseCompleted || backup.DeletePhase != "" {
+				continue
+			}
+
+			object := ReportObject{
+				Step:     ReportStepEtcdBackupConfigs,
+				Location: backupConfig.Spec.Destination,
+				Kind:     "EtcdBackup",
+				Name:     backup.BackupName,
+			}
+
+			if backupConfig.Spec.RetainBackups {
+				report.RetainedBackups = append(report.RetainedBackups, object)
+			} else {
+				report.Objects = append(report.Objects, object)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *Deletion) reportNodes(ctx context.Context, report *Report, cluster *kubermaticv1.Cluster) error {
+	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.NodeDeletionFinalizer) || cluster.Status.NamespaceName == "" {
+		return nil
+	}
+
+	userClusterClient, err := d.userClusterClientGetter()
+	if err != nil {
+		return err
+	}
+
+	machineDeployments, err := listMachineDeployments(ctx, userClusterClient)
+	if err != nil {
+		return err
+	}
+
+	for _, md := range machineDeployments {
+		report.add(ReportStepNodes, ReportLocationUserCluster, "MachineDeployment", md.Namespace, md.Name)
+	}
+
+	machineSets, err := listMachineSets(ctx, userClusterClient)
+	if err != nil {
+		return err
+	}
+
+	for _, ms := range machineSets {
+		report.add(ReportStepNodes, ReportLocationUserCluster, "MachineSet", ms.Namespace, ms.Name)
+	}
+
+	machines, err := listMachines(ctx, userClusterClient)
+	if err != nil {
+		return err
+	}
+
+	for _, machine := range machines {
+		report.add(ReportStepNodes, ReportLocationUserCluster, "Machine", machine.Namespace, machine.Name)
+	}
+
+	return nil
+}
+
+func (d *Deletion) reportNamespace(ctx context.Context, report *Report, cluster *kubermaticv1.Cluster) error {
+	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.NamespaceCleanupFinalizer) {
+		return nil
+	}
+
+	ns, err := getClusterNamespace(ctx, d.seedClient, cluster)
+	if err != nil || ns == nil {
+		return err
+	}
+
+	report.add(ReportStepNamespace, ReportLocationSeed, "Namespace", "", ns.Name)
+
+	// Seed RBAC for the control plane is owned by the cluster namespace and garbage
+	// collected once the namespace is gone.
+	clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+	if err := d.seedClient.List(ctx, clusterRoleBindings); err != nil {
+		return fmt.Errorf("failed to list ClusterRoleBindings: %w", err)
+	}
+
+	for _, binding := range clusterRoleBindings.Items {
+		if isOwnedBy(&binding, ns) {
+			report.add(ReportStepBindings, ReportLocationSeed, "ClusterRoleBinding", "", binding.Name)
+		}
+	}
+
+	return nil
+}
+
+func isOwnedBy(obj metav1.Object, owner metav1.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Deletion) reportCredentialsSecrets(ctx context.Context, report *Report, cluster *kubermaticv1.Cluster) error {
+	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.CredentialsSecretsCleanupFinalizer) {
+		return nil
+	}
+
+	// GetSecretName makes up a random name for clusters without a name
+	if cluster.Name == "" {
+		return nil
+	}
+
+	secret, err := getCredentialsSecret(ctx, d.seedClient, cluster)
+	if err != nil || secret == nil {
+		return err
+	}
+
+	report.add(ReportStepCredentials, ReportLocationSeed, "Secret", secret.Namespace, secret.Name)
+
+	return nil
+}
+
+// reportFinalizers lists the cleanups that are not performed by CleanupCluster, but by other
+// controllers, most importantly the cloud provider cleanup. Cloud providers do not support
+// a dry-run, so the resources they remove are derived from their finalizers and the cloud spec.
+func reportFinalizers(report *Report, cluster *kubermaticv1.Cluster) {
+	provider, resources := cloudResources(cluster)
+	reported := sets.New[ReportObject]()
+
+	for _, finalizer := range sets.List(sets.New(cluster.Finalizers...).Difference(handledFinalizers)) {
+		known, named := false, false
+
+		for _, resource := range resources {
+			if resource.finalizer != finalizer {
+				continue
+			}
+
+			known = true
+
+			// resources without a name or ID have not been created (yet)
+			if resource.name == "" {
+				continue
+			}
+
+			named = true
+
+			object := ReportObject{Step: ReportStepCloudProvider, Location: string(provider), Kind: resource.kind, Name: resource.name}
+			if !reported.Has(object) {
+				reported.Insert(object)
+				report.Objects = append(report.Objects, object)
+			}
+		}
+
+		switch {
+		case !known:
+			report.add(ReportStepOther, ReportLocationSeed, "Finalizer", "", finalizer)
+		case !named:
+			report.add(ReportStepCloudProvider, string(provider), "Finalizer", "", finalizer)
+		}
+	}
+}
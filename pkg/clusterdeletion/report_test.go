@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestReport(t *testing.T) {
+	const (
+		clusterName = "report"
+		namespace   = "cluster-report"
+	)
+
+	cluster := getClusterWithFinalizer(clusterName,
+		kubermaticv1.KubermaticConstraintCleanupFinalizer,
+		kubermaticv1.InClusterLBCleanupFinalizer,
+		kubermaticv1.InClusterPVCleanupFinalizer,
+		kubermaticv1.EtcdBackupConfigCleanupFinalizer,
+		kubermaticv1.NodeDeletionFinalizer,
+		kubermaticv1.NamespaceCleanupFinalizer,
+		kubermaticv1.CredentialsSecretsCleanupFinalizer,
+		"kubermatic.k8c.io/cleanup-aws-security-group",
+		"kubermatic.k8c.io/cleanup-usersshkeys-cluster-ids",
+	)
+	cluster.Spec.Cloud.ProviderName = string(kubermaticv1.AWSCloudProvider)
+	cluster.Spec.Cloud.AWS = &kubermaticv1.AWSCloudSpec{SecurityGroupID: "sg-1234"}
+	cluster.Status.NamespaceName = namespace
+
+	seedObjects := []ctrlruntimeclient.Object{
+		cluster,
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace, UID: "ns-uid"},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "usercluster-" + namespace,
+				OwnerReferences: []metav1.OwnerReference{{Kind: "Namespace", Name: namespace, UID: "ns-uid"}},
+			},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated"},
+		},
+		&kubermaticv1.Constraint{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "constraint"},
+		},
+		&kubermaticv1.EtcdBackupConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "default-backups"},
+			Spec:       kubermaticv1.EtcdBackupConfigSpec{Destination: "s3"},
+			Status: kubermaticv1.EtcdBackupConfigStatus{
+				CurrentBackups: []kubermaticv1.BackupStatus{
+					{BackupName: "completed", BackupPhase: kubermaticv1.BackupStatusPhaseCompleted},
+					{BackupName: "failed", BackupPhase: kubermaticv1.BackupStatusPhaseFailed},
+				},
+			},
+		},
+		&kubermaticv1.EtcdBackupConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "final-backup"},
+			Spec:       kubermaticv1.EtcdBackupConfigSpec{Destination: "s3", RetainBackups: true},
+			Status: kubermaticv1.EtcdBackupConfigStatus{
+				CurrentBackups: []kubermaticv1.BackupStatus{
+					{BackupName: "final", BackupPhase: kubermaticv1.BackupStatusPhaseCompleted},
+				},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: resources.KubermaticNamespace, Name: cluster.GetSecretName()},
+		},
+	}
+
+	userClusterObjects := []ctrlruntimeclient.Object{
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "lb"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "clusterip"},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "data"},
+		},
+		&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pv-dynamic",
+				Annotations: map[string]string{AnnDynamicallyProvisioned: "csi"},
+			},
+			Spec: corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+		},
+		&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-static"},
+			Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain},
+		},
+		getPod("", "", true),
+		&clusterv1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "workers"},
+		},
+		&clusterv1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "workers-abc"},
+		},
+	}
+
+	seedClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(seedObjects...).Build()
+	userClusterClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(userClusterObjects...).Build()
+
+	deletion := New(seedClient, &record.FakeRecorder{}, func() (ctrlruntimeclient.Client, error) {
+		return userClusterClient, nil
+	})
+
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	report, err := deletion.Report(context.Background(), cluster, now)
+	if err != nil {
+		t.Fatalf("Failed to create report: %v", err)
+	}
+
+	expected := &Report{
+		Cluster:     clusterName,
+		GeneratedAt: metav1.NewTime(now),
+		Objects: []ReportObject{
+			{Step: ReportStepConstraints, Location: ReportLocationSeed, Kind: "Constraint", Namespace: namespace, Name: "constraint"},
+			{Step: ReportStepLoadBalancers, Location: ReportLocationUserCluster, Kind: "Service", Namespace: "default", Name: "lb"},
+			{Step: ReportStepVolumes, Location: ReportLocationUserCluster, Kind: "Pod", Namespace: testNS, Name: "my-pod"},
+			{Step: ReportStepVolumes, Location: ReportLocationUserCluster, Kind: "PersistentVolumeClaim", Namespace: "default", Name: "data"},
+			{Step: ReportStepVolumes, Location: ReportLocationUserCluster, Kind: "PersistentVolume", Name: "pv-dynamic"},
+			{Step: ReportStepEtcdBackupConfigs, Location: ReportLocationSeed, Kind: "EtcdBackupConfig", Namespace: namespace, Name: "default-backups"},
+			{Step: ReportStepEtcdBackupConfigs, Location: "s3", Kind: "EtcdBackup", Name: "completed"},
+			{Step: ReportStepEtcdBackupConfigs, Location: ReportLocationSeed, Kind: "EtcdBackupConfig", Namespace: namespace, Name: "final-backup"},
+			{Step: ReportStepNodes, Location: ReportLocationUserCluster, Kind: "MachineDeployment", Namespace: metav1.NamespaceSystem, Name: "workers"},
+			{Step: ReportStepNodes, Location: ReportLocationUserCluster, Kind: "Machine", Namespace: metav1.NamespaceSystem, Name: "workers-abc"},
+			{Step: ReportStepNamespace, Location: ReportLocationSeed, Kind: "Namespace", Name: namespace},
+			{Step: ReportStepBindings, Location: ReportLocationSeed, Kind: "ClusterRoleBinding", Name: "usercluster-" + namespace},
+			{Step: ReportStepCredentials, Location: ReportLocationSeed, Kind: "Secret", Namespace: resources.KubermaticNamespace, Name: cluster.GetSecretName()},
+			{Step: ReportStepCloudProvider, Location: "aws", Kind: "SecurityGroup", Name: "sg-1234"},
+			{Step: ReportStepOther, Location: ReportLocationSeed, Kind: "Finalizer", Name: "kubermatic.k8c.io/cleanup-usersshkeys-cluster-ids"},
+		},
+		RetainedBackups: []ReportObject{
+			{Step: ReportStepEtcdBackupConfigs, Location: "s3", Kind: "EtcdBackup", Name: "final"},
+		},
+	}
+
+	if diff := cmp.Diff(expected, report); diff != "" {
+		t.Fatalf("Report does not match:\n%s", diff)
+	}
+
+	// the dry-run must not have touched anything
+	services := &corev1.ServiceList{}
+	if err := userClusterClient.List(context.Background(), services); err != nil {
+		t.Fatalf("Failed to list services: %v", err)
+	}
+	if len(services.Items) != 2 {
+		t.Errorf("Expected services to be left alone, but found %d", len(services.Items))
+	}
+}
+
+func TestReportWithUnreachableUserCluster(t *testing.T) {
+	cluster := getClusterWithFinalizer("report", kubermaticv1.InClusterLBCleanupFinalizer, kubermaticv1.NamespaceCleanupFinalizer)
+	cluster.Status.NamespaceName = "cluster-report"
+
+	seedClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(cluster, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-report"},
+	}).Build()
+
+	deletion := New(seedClient, &record.FakeRecorder{}, func() (ctrlruntimeclient.Client, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	report, err := deletion.Report(context.Background(), cluster, time.Now())
+	if err != nil {
+		t.Fatalf("Failed to create report: %v", err)
+	}
+
+	if len(report.Warnings) != 1 {
+		t.Errorf("Expected exactly one warning, but got %v", report.Warnings)
+	}
+
+	if len(report.Objects) != 1 || report.Objects[0].Kind != "Namespace" {
+		t.Errorf("Expected the namespace to still be reported, but got %v", report.Objects)
+	}
+}
+
+func TestReportFinalizers(t *testing.T) {
+	testcases := []struct {
+		name     string
+		cloud    kubermaticv1.CloudSpec
+		status   kubermaticv1.ClusterStatus
+		expected []ReportObject
+	}{
+		{
+			name: "resources are reported once and only if they exist",
+			cloud: kubermaticv1.CloudSpec{
+				ProviderName: string(kubermaticv1.AWSCloudProvider),
+				AWS: &kubermaticv1.AWSCloudSpec{
+					SecurityGroupID:     "sg-1234",
+					VPCID:               "vpc-1234",
+					InstanceProfileName: "kubernetes-report",
+				},
+			},
+			expected: []ReportObject{
+				{Step: ReportStepCloudProvider, Location: "aws", Kind: "InstanceProfile", Name: "kubernetes-report"},
+				{Step: ReportStepCloudProvider, Location: "aws", Kind: "SecurityGroup", Name: "sg-1234"},
+				{Step: ReportStepCloudProvider, Location: "aws", Kind: "VPCTags", Name: "vpc-1234"},
+				{Step: ReportStepOther, Location: ReportLocationSeed, Kind: "Finalizer", Name: "kubermatic.k8c.io/cleanup-usersshkeys-cluster-ids"},
+			},
+		},
+		{
+			name: "finalizers that do not start with the provider name",
+			cloud: kubermaticv1.CloudSpec{
+				ProviderName:        string(kubermaticv1.VMwareCloudDirectorCloudProvider),
+				VMwareCloudDirector: &kubermaticv1.VMwareCloudDirectorCloudSpec{VApp: "report-vapp"},
+			},
+			expected: []ReportObject{
+				{Step: ReportStepOther, Location: ReportLocationSeed, Kind: "Finalizer", Name: "kubermatic.k8c.io/cleanup-usersshkeys-cluster-ids"},
+				{Step: ReportStepCloudProvider, Location: "vmwareclouddirector", Kind: "VApp", Name: "report-vapp"},
+			},
+		},
+		{
+			name: "finalizers without a resource of their own",
+			cloud: kubermaticv1.CloudSpec{
+				ProviderName: string(kubermaticv1.KubevirtCloudProvider),
+				Kubevirt:     &kubermaticv1.KubevirtCloudSpec{},
+			},
+			status: kubermaticv1.ClusterStatus{NamespaceName: "cluster-report"},
+			expected: []ReportObject{
+				{Step: ReportStepCloudProvider, Location: "kubevirt", Kind: "Finalizer", Name: "kubermatic.k8c.io/cleanup-kubevirt-cloner-rbac"},
+				{Step: ReportStepCloudProvider, Location: "kubevirt", Kind: "Namespace", Name: "cluster-report"},
+				{Step: ReportStepOther, Location: ReportLocationSeed, Kind: "Finalizer", Name: "kubermatic.k8c.io/cleanup-usersshkeys-cluster-ids"},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := getClusterWithFinalizer("report",
+				kubermaticv1.NamespaceCleanupFinalizer,
+				"kubermatic.k8c.io/cleanup-aws",
+				"kubermatic.k8c.io/cleanup-aws-security-group",
+				"kubermatic.k8c.io/cleanup-kubevirt-cloner-rbac",
+				"kubermatic.k8c.io/cleanup-kubevirt-namespace",
+				"kubermatic.k8c.io/cleanup-usersshkeys-cluster-ids",
+				"kubermatic.k8c.io/cleanup-vmware-cloud-director-vapp",
+			)
+			cluster.Spec.Cloud = tc.cloud
+			cluster.Status = tc.status
+
+			report := &Report{}
+			reportFinalizers(report, cluster)
+
+			// finalizers of other providers are unknown and reported as such
+			var objects []ReportObject
+			for _, object := range report.Objects {
+				if object.Step != ReportStepOther || object.Name == "kubermatic.k8c.io/cleanup-usersshkeys-cluster-ids" {
+					objects = append(objects, object)
+				}
+			}
+
+			if diff := cmp.Diff(tc.expected, objects); diff != "" {
+				t.Fatalf("Report does not match:\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (d *Deletion) cleanupCredentialsSecrets(ctx context.Context, cluster *kubermaticv1.Cluster) error {
@@ -38,28 +39,42 @@ func (d *Deletion) cleanupCredentialsSecrets(ctx context.Context, cluster *kuber
 }
 
 func (d *Deletion) deleteSecret(ctx context.Context, cluster *kubermaticv1.Cluster) error {
-	secretName := cluster.GetSecretName()
-	if secretName == "" {
-		return nil
+	secret, err := getCredentialsSecret(ctx, d.seedClient, cluster)
+	if err != nil {
+		return err
 	}
 
-	secret := &corev1.Secret{}
-	name := types.NamespacedName{Name: secretName, Namespace: resources.KubermaticNamespace}
-	err := d.seedClient.Get(ctx, name, secret)
 	// It's already gone
-	if apierrors.IsNotFound(err) {
+	if secret == nil {
 		return nil
 	}
 
-	// Something failed while loading the secret
-	if err != nil {
-		return fmt.Errorf("failed to get Secret %q: %w", name.String(), err)
-	}
-
 	if err := d.seedClient.Delete(ctx, secret); err != nil {
-		return fmt.Errorf("failed to delete Secret %q: %w", name.String(), err)
+		return fmt.Errorf("failed to delete Secret %q: %w", ctrlruntimeclient.ObjectKeyFromObject(secret).String(), err)
 	}
 
 	// We successfully deleted the secret
 	return nil
 }
+
+// getCredentialsSecret returns the cloud provider credentials Secret of the cluster,
+// or nil if it does not exist.
+func getCredentialsSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (*corev1.Secret, error) {
+	secretName := cluster.GetSecretName()
+	if secretName == "" {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Name: secretName, Namespace: resources.KubermaticNamespace}
+	if err := seedClient.Get(ctx, name, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		// Something failed while loading the secret
+		return nil, fmt.Errorf("failed to get Secret %q: %w", name.String(), err)
+	}
+
+	return secret, nil
+}
@@ -49,23 +49,9 @@ func (d *Deletion) cleanupVolumes(ctx context.Context, log *zap.SugaredLogger, c
 		return false, fmt.Errorf("failed to disable future PV & PVC creation: %w", err)
 	}
 
-	pvcList := &corev1.PersistentVolumeClaimList{}
-	if err := userClusterClient.List(ctx, pvcList); err != nil {
-		return false, fmt.Errorf("failed to list PVCs from user cluster: %w", err)
-	}
-
-	allPVList := &corev1.PersistentVolumeList{}
-	if err := userClusterClient.List(ctx, allPVList); err != nil {
-		return false, fmt.Errorf("failed to list PVs from user cluster: %w", err)
-	}
-
-	pvList := &corev1.PersistentVolumeList{}
-	for _, pv := range allPVList.Items {
-		// Check only dynamically provisioned PVs with delete reclaim policy to verify provisioner has done the cleanup
-		// this filters out everything else because we leave those be
-		if pv.Annotations[AnnDynamicallyProvisioned] != "" && pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimDelete {
-			pvList.Items = append(pvList.Items, pv)
-		}
+	pvcList, pvList, err := listVolumes(ctx, userClusterClient)
+	if err != nil {
+		return false, err
 	}
 
 	// Do not attempt to delete any pods when there are no PVs and PVCs
@@ -101,6 +87,31 @@ func (d *Deletion) cleanupVolumes(ctx context.Context, log *zap.SugaredLogger, c
 	return deletedSomeResource, nil
 }
 
+// listVolumes returns all PVCs in the user cluster and all PVs that are removed by their
+// provisioner once their claim has been deleted.
+func listVolumes(ctx context.Context, userClusterClient ctrlruntimeclient.Client) (*corev1.PersistentVolumeClaimList, *corev1.PersistentVolumeList, error) {
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := userClusterClient.List(ctx, pvcList); err != nil {
+		return nil, nil, fmt.Errorf("failed to list PVCs from user cluster: %w", err)
+	}
+
+	allPVList := &corev1.PersistentVolumeList{}
+	if err := userClusterClient.List(ctx, allPVList); err != nil {
+		return nil, nil, fmt.Errorf("failed to list PVs from user cluster: %w", err)
+	}
+
+	pvList := &corev1.PersistentVolumeList{}
+	for _, pv := range allPVList.Items {
+		// Check only dynamically provisioned PVs with delete reclaim policy to verify provisioner has done the cleanup
+		// this filters out everything else because we leave those be
+		if pv.Annotations[AnnDynamicallyProvisioned] != "" && pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimDelete {
+			pvList.Items = append(pvList.Items, pv)
+		}
+	}
+
+	return pvcList, pvList, nil
+}
+
 func (d *Deletion) disablePVCreation(ctx context.Context, userClusterClient ctrlruntimeclient.Client) error {
 	// Prevent re-creation of PVs and PVCs by using an intentionally defunct admissionWebhook
 	creatorGetters := []reconciling.NamedValidatingWebhookConfigurationReconcilerFactory{
@@ -114,17 +125,9 @@ func (d *Deletion) disablePVCreation(ctx context.Context, userClusterClient ctrl
 }
 
 func (d *Deletion) cleanupPVCUsingPods(ctx context.Context, log *zap.SugaredLogger, userClusterClient ctrlruntimeclient.Client) error {
-	podList := &corev1.PodList{}
-	if err := userClusterClient.List(ctx, podList); err != nil {
-		return fmt.Errorf("failed to list Pods from user cluster: %w", err)
-	}
-
-	pvUsingPods := []*corev1.Pod{}
-	for idx := range podList.Items {
-		pod := &podList.Items[idx]
-		if podUsesPV(pod) {
-			pvUsingPods = append(pvUsingPods, pod)
-		}
+	pvUsingPods, err := listPVUsingPods(ctx, userClusterClient)
+	if err != nil {
+		return err
 	}
 
 	for _, pod := range pvUsingPods {
@@ -141,6 +144,23 @@ func (d *Deletion) cleanupPVCUsingPods(ctx context.Context, log *zap.SugaredLogg
 	return nil
 }
 
+func listPVUsingPods(ctx context.Context, userClusterClient ctrlruntimeclient.Client) ([]*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := userClusterClient.List(ctx, podList); err != nil {
+		return nil, fmt.Errorf("failed to list Pods from user cluster: %w", err)
+	}
+
+	pvUsingPods := []*corev1.Pod{}
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+		if podUsesPV(pod) {
+			pvUsingPods = append(pvUsingPods, pod)
+		}
+	}
+
+	return pvUsingPods, nil
+}
+
 func podUsesPV(p *corev1.Pod) bool {
 	for _, volume := range p.Spec.Volumes {
 		if volume.PersistentVolumeClaim != nil {
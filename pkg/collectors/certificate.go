@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CertificateCollector exports metrics for the certificates in cluster namespaces.
+type CertificateCollector struct {
+	client ctrlruntimeclient.Reader
+
+	certificateExpiry *prometheus.Desc
+}
+
+func newCertificateCollector(client ctrlruntimeclient.Reader) *CertificateCollector {
+	return &CertificateCollector{
+		client: client,
+		certificateExpiry: prometheus.NewDesc(
+			clusterPrefix+"certificate_expiry_seconds",
+			"Unix timestamp at which a control plane certificate expires",
+			[]string{"cluster", "purpose"},
+			nil,
+		),
+	}
+}
+
+// MustRegisterCertificateCollector registers the certificate collector at the given prometheus registry.
+// As the collector reads all Secrets in cluster namespaces, the client should be cache-backed.
+func MustRegisterCertificateCollector(registry prometheus.Registerer, client ctrlruntimeclient.Reader) {
+	registry.MustRegister(newCertificateCollector(client))
+}
+
+// Describe returns the metrics descriptors.
+func (cc CertificateCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(cc, ch)
+}
+
+// Collect gets called by prometheus to collect the metrics.
+func (cc CertificateCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+
+	clusters := &kubermaticv1.ClusterList{}
+	if err := cc.client.List(ctx, clusters); err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list clusters in CertificateCollector: %w", err))
+		return
+	}
+
+	for _, cluster := range clusters.Items {
+		if cluster.Status.NamespaceName == "" {
+			continue
+		}
+
+		secrets := &corev1.SecretList{}
+		if err := cc.client.List(ctx, secrets, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to list secrets of cluster %s in CertificateCollector: %w", cluster.Name, err))
+			continue
+		}
+
+		for _, cert := range certificates.Inventory(secrets.Items) {
+			ch <- prometheus.MustNewConstMetric(
+				cc.certificateExpiry,
+				prometheus.GaugeValue,
+				float64(cert.Certificate.NotAfter.Unix()),
+				cluster.Name,
+				cert.Purpose,
+			)
+		}
+	}
+}
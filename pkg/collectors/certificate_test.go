@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCertificateExpiryMetric(t *testing.T) {
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	client, err := triple.NewClientKeyPair(ca, "client", nil)
+	if err != nil {
+		t.Fatalf("Failed to create client certificate: %v", err)
+	}
+
+	kubermaticFakeClient := fake.
+		NewClientBuilder().
+		WithObjects(
+			&kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster1",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-cluster1",
+				},
+			},
+			&kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster2",
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resources.CASecretName,
+					Namespace: "cluster-cluster1",
+				},
+				Data: map[string][]byte{
+					resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "client-cert",
+					Namespace: "cluster-cluster1",
+				},
+				Data: map[string][]byte{
+					"client.crt":              triple.EncodeCertPEM(client.Cert),
+					resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "unrelated",
+					Namespace: "cluster-cluster1",
+				},
+				Data: map[string][]byte{
+					"password": []byte("hunter2"),
+				},
+			},
+		).
+		Build()
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(newCertificateCollector(kubermaticFakeClient)); err != nil {
+		t.Fatal(err)
+	}
+
+	timestamp := func(v int64) string {
+		return strconv.FormatFloat(float64(v), 'g', -1, 64)
+	}
+
+	expected := fmt.Sprintf(`
+# HELP kubermatic_cluster_certificate_expiry_seconds Unix timestamp at which a control plane certificate expires
+# TYPE kubermatic_cluster_certificate_expiry_seconds gauge
+kubermatic_cluster_certificate_expiry_seconds{cluster="cluster1",purpose="ca"} %s
+kubermatic_cluster_certificate_expiry_seconds{cluster="cluster1",purpose="client-cert"} %s
+`, timestamp(ca.Cert.NotAfter.Unix()), timestamp(client.Cert.NotAfter.Unix()))
+
+	if err := testutil.CollectAndCompare(registry, strings.NewReader(expected), "kubermatic_cluster_certificate_expiry_seconds"); err != nil {
+		t.Error(err)
+	}
+}
@@ -41,6 +41,7 @@ type clusterBackupCollector struct {
 	ObjectCount            *prometheus.Desc
 	ObjectLastModifiedDate *prometheus.Desc
 	EmptyObjectCount       *prometheus.Desc
+	LastVerifiedTime       *prometheus.Desc
 	QuerySuccess           *prometheus.Desc
 	client                 ctrlruntimeclient.Reader
 	logger                 *zap.SugaredLogger
@@ -74,6 +75,10 @@ func MustRegisterClusterBackupCollector(
 		"kubermatic_etcdbackup_empty_object_count",
 		"The amount of empty objects (size=0) partitioned by backup destination and cluster",
 		[]string{"destination", "cluster"}, nil)
+	collector.LastVerifiedTime = prometheus.NewDesc(
+		"kubermatic_etcdbackup_last_verified_time_seconds",
+		"Finish time of the most recent successful backup verification",
+		[]string{"destination", "cluster"}, nil)
 	collector.QuerySuccess = prometheus.NewDesc(
 		"kubermatic_etcdbackup_query_success",
 		"Whether querying the S3 was successful",
@@ -86,6 +91,7 @@ func (c *clusterBackupCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.ObjectCount
 	ch <- c.ObjectLastModifiedDate
 	ch <- c.EmptyObjectCount
+	ch <- c.LastVerifiedTime
 	ch <- c.QuerySuccess
 }
 
@@ -115,13 +121,20 @@ func (c *clusterBackupCollector) collect(ctx context.Context, ch chan<- promethe
 		return fmt.Errorf("failed to list clusters: %w", err)
 	}
 
+	backupConfigList := &kubermaticv1.EtcdBackupConfigList{}
+	if err := c.client.List(ctx, backupConfigList); err != nil {
+		return fmt.Errorf("failed to list etcd backup configs: %w", err)
+	}
+
+	lastVerified := getLastVerifiedTimestamps(backupConfigList.Items)
+
 	for destName, destination := range seed.Spec.EtcdBackupRestore.Destinations {
 		logger := c.logger.With("destination", destName)
 		logger.Debug("Collecting metrics")
 
 		success := float64(1)
 
-		if err := c.collectDestination(ctx, ch, clusterList.Items, destName, destination); err != nil {
+		if err := c.collectDestination(ctx, ch, clusterList.Items, destName, destination, lastVerified[destName]); err != nil {
 			// do not return an error, but try to keep gathering data for the other destinations
 			logger.Errorw("Failed to collect metrics for backup destination", zap.Error(err))
 			success = 0
@@ -133,7 +146,7 @@ func (c *clusterBackupCollector) collect(ctx context.Context, ch chan<- promethe
 	return nil
 }
 
-func (c *clusterBackupCollector) collectDestination(ctx context.Context, ch chan<- prometheus.Metric, clusters []kubermaticv1.Cluster, destName string, destination *kubermaticv1.BackupDestination) error {
+func (c *clusterBackupCollector) collectDestination(ctx context.Context, ch chan<- prometheus.Metric, clusters []kubermaticv1.Cluster, destName string, destination *kubermaticv1.BackupDestination, lastVerified map[string]time.Time) error {
 	listOpts := minio.ListObjectsOptions{
 		Recursive: true,
 	}
@@ -153,13 +166,13 @@ func (c *clusterBackupCollector) collectDestination(ctx context.Context, ch chan
 	}
 
 	for _, cluster := range clusters {
-		c.setMetricsForCluster(ch, destination, objects, destName, cluster.Name)
+		c.setMetricsForCluster(ch, destination, objects, destName, cluster.Name, lastVerified[cluster.Name])
 	}
 
 	return nil
 }
 
-func (c *clusterBackupCollector) setMetricsForCluster(ch chan<- prometheus.Metric, _ *kubermaticv1.BackupDestination, allObjects []minio.ObjectInfo, destName string, clusterName string) {
+func (c *clusterBackupCollector) setMetricsForCluster(ch chan<- prometheus.Metric, _ *kubermaticv1.BackupDestination, allObjects []minio.ObjectInfo, destName string, clusterName string, lastVerified time.Time) {
 	var clusterObjects []minio.ObjectInfo
 	for _, object := range allObjects {
 		if strings.HasPrefix(object.Key, fmt.Sprintf("%s-", clusterName)) {
@@ -177,11 +190,18 @@ func (c *clusterBackupCollector) setMetricsForCluster(ch chan<- prometheus.Metri
 	ch <- prometheus.MustNewConstMetric(c.ObjectCount, prometheus.GaugeValue, float64(len(clusterObjects)), labelValues...)
 	ch <- prometheus.MustNewConstMetric(c.ObjectLastModifiedDate, prometheus.GaugeValue, float64(lastModTimestamp), labelValues...)
 	ch <- prometheus.MustNewConstMetric(c.EmptyObjectCount, prometheus.GaugeValue, float64(getEmptyObjectCount(clusterObjects)), labelValues...)
+
+	lastVerifiedTimestamp := int64(0)
+	if !lastVerified.IsZero() {
+		lastVerifiedTimestamp = lastVerified.Unix()
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.LastVerifiedTime, prometheus.GaugeValue, float64(lastVerifiedTimestamp), labelValues...)
 }
 
 func (c *clusterBackupCollector) getS3Client(ctx context.Context, destination *kubermaticv1.BackupDestination) (*minio.Client, error) {
 	if destination.Credentials == nil {
-		return nil, fmt.Errorf("credentials not set for backup destination %q", destination)
+		return nil, fmt.Errorf("credentials not set for backup destination %q", destination.Endpoint)
 	}
 
 	key := types.NamespacedName{
@@ -213,6 +233,33 @@ func getLastModifiedTimestamp(objects []minio.ObjectInfo) (lastmodifiedTimestamp
 	return lastmodifiedTimestamp
 }
 
+// getLastVerifiedTimestamps returns the finish time of the most recent successful
+// backup verification, grouped by destination and cluster name.
+func getLastVerifiedTimestamps(backupConfigs []kubermaticv1.EtcdBackupConfig) map[string]map[string]time.Time {
+	result := map[string]map[string]time.Time{}
+
+	for _, backupConfig := range backupConfigs {
+		destination := backupConfig.Spec.Destination
+		cluster := backupConfig.Spec.Cluster.Name
+
+		for _, backup := range backupConfig.Status.CurrentBackups {
+			if backup.VerifyPhase != kubermaticv1.BackupStatusPhaseVerified {
+				continue
+			}
+
+			if result[destination] == nil {
+				result[destination] = map[string]time.Time{}
+			}
+
+			if backup.VerifyFinishedTime.After(result[destination][cluster]) {
+				result[destination][cluster] = backup.VerifyFinishedTime.Time
+			}
+		}
+	}
+
+	return result
+}
+
 func getEmptyObjectCount(objects []minio.ObjectInfo) (emptyObjects int) {
 	for _, object := range objects {
 		if object.Size == 0 {
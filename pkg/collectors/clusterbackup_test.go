@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetLastVerifiedTimestamps(t *testing.T) {
+	backupConfig := func(cluster, destination string, backups ...kubermaticv1.BackupStatus) kubermaticv1.EtcdBackupConfig {
+		return kubermaticv1.EtcdBackupConfig{
+			Spec: kubermaticv1.EtcdBackupConfigSpec{
+				Cluster:     corev1.ObjectReference{Name: cluster},
+				Destination: destination,
+			},
+			Status: kubermaticv1.EtcdBackupConfigStatus{
+				CurrentBackups: backups,
+			},
+		}
+	}
+
+	backup := func(phase kubermaticv1.BackupStatusPhase, finished int64) kubermaticv1.BackupStatus {
+		return kubermaticv1.BackupStatus{
+			VerifyPhase:        phase,
+			VerifyFinishedTime: metav1.NewTime(time.Unix(finished, 0)),
+		}
+	}
+
+	configs := []kubermaticv1.EtcdBackupConfig{
+		backupConfig("cluster-a", "s3",
+			backup(kubermaticv1.BackupStatusPhaseVerified, 100),
+			backup(kubermaticv1.BackupStatusPhaseVerified, 200),
+			// failed verifications must not count
+			backup(kubermaticv1.BackupStatusPhaseFailed, 300),
+		),
+		backupConfig("cluster-a", "s3",
+			backup(kubermaticv1.BackupStatusPhaseVerified, 150),
+		),
+		backupConfig("cluster-a", "minio",
+			backup(kubermaticv1.BackupStatusPhaseVerified, 50),
+		),
+		// never verified
+		backupConfig("cluster-b", "s3",
+			backup(kubermaticv1.BackupStatusPhaseRunning, 0),
+		),
+	}
+
+	expected := map[string]map[string]time.Time{
+		"s3": {
+			"cluster-a": time.Unix(200, 0),
+		},
+		"minio": {
+			"cluster-a": time.Unix(50, 0),
+		},
+	}
+
+	result := getLastVerifiedTimestamps(configs)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got %v.", expected, result)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	resourceQuotaPrefix = "kubermatic_resource_quota_"
+)
+
+// ResourceQuotaCollector exports metrics for resource quota resources.
+type ResourceQuotaCollector struct {
+	client ctrlruntimeclient.Reader
+
+	resourceQuotaUsageRatio            *prometheus.Desc
+	resourceQuotaSoftThresholdExceeded *prometheus.Desc
+}
+
+func newResourceQuotaCollector(client ctrlruntimeclient.Reader) *ResourceQuotaCollector {
+	labels := []string{"name", "subject_kind", "subject_name", "resource"}
+
+	return &ResourceQuotaCollector{
+		client: client,
+		resourceQuotaUsageRatio: prometheus.NewDesc(
+			resourceQuotaPrefix+"usage_ratio",
+			"Global usage of a resource in relation to its quota",
+			labels,
+			nil,
+		),
+		resourceQuotaSoftThresholdExceeded: prometheus.NewDesc(
+			resourceQuotaPrefix+"soft_threshold_exceeded",
+			"Whether the global usage of a resource reached its soft threshold (1) or not (0)",
+			labels,
+			nil,
+		),
+	}
+}
+
+// MustRegisterResourceQuotaCollector registers the resource quota collector at the given prometheus registry.
+func MustRegisterResourceQuotaCollector(registry prometheus.Registerer, client ctrlruntimeclient.Reader) {
+	registry.MustRegister(newResourceQuotaCollector(client))
+}
+
+// Describe returns the metrics descriptors.
+func (cc ResourceQuotaCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(cc, ch)
+}
+
+// Collect gets called by prometheus to collect the metrics.
+func (cc ResourceQuotaCollector) Collect(ch chan<- prometheus.Metric) {
+	resourceQuotas := &kubermaticv1.ResourceQuotaList{}
+	if err := cc.client.List(context.Background(), resourceQuotas); err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list resource quotas in ResourceQuotaCollector: %w", err))
+		return
+	}
+
+	for _, resourceQuota := range resourceQuotas.Items {
+		cc.collectResourceQuota(ch, &resourceQuota)
+	}
+}
+
+func (cc *ResourceQuotaCollector) collectResourceQuota(ch chan<- prometheus.Metric, rq *kubermaticv1.ResourceQuota) {
+	quotas := rq.Spec.Quota.Values()
+	usages := rq.Status.GlobalUsage.Values()
+	exceeded := sets.New(rq.Spec.ExceededSoftThresholds(rq.Status.GlobalUsage)...)
+
+	for resource, quota := range quotas {
+		// a ratio is meaningless for resources that are not allowed at all
+		if quota > 0 {
+			ch <- prometheus.MustNewConstMetric(
+				cc.resourceQuotaUsageRatio,
+				prometheus.GaugeValue,
+				usages[resource]/quota,
+				rq.Name, rq.Spec.Subject.Kind, rq.Spec.Subject.Name, resource,
+			)
+		}
+	}
+
+	for resource := range rq.Spec.SoftThresholds.Values() {
+		if _, ok := quotas[resource]; !ok {
+			continue
+		}
+
+		value := 0.0
+		if exceeded.Has(resource) {
+			value = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			cc.resourceQuotaSoftThresholdExceeded,
+			prometheus.GaugeValue,
+			value,
+			rq.Name, rq.Spec.Subject.Kind, rq.Spec.Subject.Name, resource,
+		)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestResourceQuotaMetrics(t *testing.T) {
+	kubermaticFakeClient := fake.
+		NewClientBuilder().
+		WithObjects(
+			&kubermaticv1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "project-my-project",
+				},
+				Spec: kubermaticv1.ResourceQuotaSpec{
+					Subject: kubermaticv1.Subject{Kind: kubermaticv1.ProjectSubjectKind, Name: "my-project"},
+					Quota: kubermaticv1.ResourceDetails{
+						CPU:      ptr.To(resource.MustParse("10")),
+						Machines: ptr.To[int64](4),
+					},
+					SoftThresholds: &kubermaticv1.ResourceThresholds{
+						CPU:      ptr.To[int32](80),
+						Machines: ptr.To[int32](80),
+					},
+				},
+				Status: kubermaticv1.ResourceQuotaStatus{
+					GlobalUsage: kubermaticv1.ResourceDetails{
+						CPU:      ptr.To(resource.MustParse("5")),
+						Machines: ptr.To[int64](4),
+					},
+				},
+			},
+		).
+		Build()
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(newResourceQuotaCollector(kubermaticFakeClient)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+# HELP kubermatic_resource_quota_soft_threshold_exceeded Whether the global usage of a resource reached its soft threshold (1) or not (0)
+# TYPE kubermatic_resource_quota_soft_threshold_exceeded gauge
+kubermatic_resource_quota_soft_threshold_exceeded{name="project-my-project",resource="cpu",subject_kind="project",subject_name="my-project"} 0
+kubermatic_resource_quota_soft_threshold_exceeded{name="project-my-project",resource="machines",subject_kind="project",subject_name="my-project"} 1
+# HELP kubermatic_resource_quota_usage_ratio Global usage of a resource in relation to its quota
+# TYPE kubermatic_resource_quota_usage_ratio gauge
+kubermatic_resource_quota_usage_ratio{name="project-my-project",resource="cpu",subject_kind="project",subject_name="my-project"} 0.5
+kubermatic_resource_quota_usage_ratio{name="project-my-project",resource="machines",subject_kind="project",subject_name="my-project"} 1
+`
+
+	if err := testutil.CollectAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Fatalf("Unexpected metrics:\n%v", err)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletdnatcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Backend is the name of a mechanism to install the DNAT rules into the kernel.
+type Backend string
+
+const (
+	// BackendAuto uses legacy iptables if the host's nat rules are installed with it and nftables otherwise.
+	BackendAuto Backend = "auto"
+	// BackendIPTables manages a chain in the nat table using iptables-save/-restore.
+	BackendIPTables Backend = "iptables"
+	// BackendNFTables manages a dedicated nftables table using nft.
+	BackendNFTables Backend = "nftables"
+)
+
+// ruleBackend installs DNAT rules into the kernel.
+type ruleBackend interface {
+	// Name returns the name of the backend.
+	Name() Backend
+	// Sync ensures that exactly the given rules are installed, together with the
+	// jump/hook and masquerade rules required for them to work. It returns true if
+	// the rules in the kernel had to be updated.
+	Sync(ctx context.Context, rules []*dnatRule) (bool, error)
+	// Cleanup removes all rules installed by the backend. It is used to remove the rules of
+	// another backend that was used before.
+	Cleanup(ctx context.Context) error
+}
+
+// commandRunner executes a command, passing stdin to it, and returns its combined output.
+// It is replaced in tests so that no commands touching the kernel are run.
+type commandRunner func(ctx context.Context, stdin string, name string, args ...string) ([]byte, error)
+
+func execCommand(ctx context.Context, stdin string, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+
+	return cmd.CombinedOutput()
+}
+
+// newRuleBackend creates the given backend. For BackendAuto, the backend is detected and the
+// rules installed by the other usable backends are removed, so that switching backends does not
+// leave stale rules behind.
+func newRuleBackend(ctx context.Context, log *zap.SugaredLogger, backend Backend, name string, vpnInterface string, run commandRunner) (ruleBackend, error) {
+	switch backend {
+	case BackendIPTables:
+		return newIPTablesBackend(name, vpnInterface, iptablesDefault, run), nil
+
+	case BackendNFTables:
+		return newNFTablesBackend(name, vpnInterface, run), nil
+
+	case BackendAuto, "":
+		detected, others, err := detectRuleBackend(ctx, log, name, vpnInterface, run)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, other := range others {
+			if err := other.Cleanup(ctx); err != nil {
+				log.Warnw("failed to remove rules of unused backend", "backend", other.Name(), zap.Error(err))
+			}
+		}
+
+		return detected, nil
+
+	default:
+		return nil, fmt.Errorf("unknown backend %q", backend)
+	}
+}
+
+// detectRuleBackend returns the backend to use and all other usable backends. Like the
+// iptables-wrapper used by Kubernetes, the number of nat rules installed with legacy iptables
+// and with iptables-nft are compared: if most rules are installed with legacy iptables, e.g. by
+// kube-proxy, the DNAT rules must be installed with it as well. Otherwise the host uses
+// nf_tables and a dedicated nftables table is used.
+func detectRuleBackend(ctx context.Context, log *zap.SugaredLogger, name string, vpnInterface string, run commandRunner) (ruleBackend, []ruleBackend, error) {
+	var (
+		usable   []ruleBackend
+		detected ruleBackend
+	)
+
+	legacy := newIPTablesBackend(name, vpnInterface, iptablesLegacy, run)
+	legacyRules, legacyErr := countNATRules(ctx, iptablesLegacy, run)
+	if legacyErr == nil {
+		usable = append(usable, legacy)
+	} else {
+		log.Debugw("legacy iptables is not usable", zap.Error(legacyErr))
+	}
+
+	iptablesNFTBackend := newIPTablesBackend(name, vpnInterface, iptablesNFT, run)
+	nftRules, nftErr := countNATRules(ctx, iptablesNFT, run)
+	if nftErr == nil {
+		usable = append(usable, iptablesNFTBackend)
+	} else {
+		log.Debugw("iptables-nft is not usable", zap.Error(nftErr))
+	}
+
+	nftables := newNFTablesBackend(name, vpnInterface, run)
+	_, nftablesErr := run(ctx, "", nftCommand, "list", "tables")
+	if nftablesErr == nil {
+		usable = append(usable, nftables)
+	} else {
+		log.Debugw("nftables is not usable", zap.Error(nftablesErr))
+	}
+
+	log.Debugw("Counted nat rules", "legacy", legacyRules, "nft", nftRules)
+
+	switch {
+	case legacyErr == nil && (nftErr != nil || legacyRules > nftRules):
+		detected = legacy
+	case nftablesErr == nil:
+		detected = nftables
+	case nftErr == nil:
+		detected = iptablesNFTBackend
+	default:
+		// the variants cannot be told apart, so use whatever iptables points to
+		if _, err := run(ctx, "", iptablesDefault.save, "-t", "nat"); err != nil {
+			log.Debugw("iptables is not usable", zap.Error(err))
+			return nil, nil, errors.New("neither iptables nor nftables is usable")
+		}
+
+		return newIPTablesBackend(name, vpnInterface, iptablesDefault, run), nil, nil
+	}
+
+	others := slices.DeleteFunc(usable, func(backend ruleBackend) bool {
+		return backend == detected
+	})
+
+	return detected, others, nil
+}
+
+// countNATRules returns the number of rules in the nat table that are visible to the given
+// iptables variant.
+func countNATRules(ctx context.Context, commands iptablesCommands, run commandRunner) (int, error) {
+	out, err := run(ctx, "", commands.save, "-t", "nat")
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(line, "-A ") {
+			count++
+		}
+	}
+
+	return count, nil
+}
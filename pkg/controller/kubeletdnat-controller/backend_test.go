@@ -0,0 +1,423 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletdnatcontroller
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+var testRules = []*dnatRule{
+	{
+		originalTargetAddress: "10.1.1.12",
+		originalTargetPort:    "10250",
+		translatedAddress:     "10.254.1.12",
+		translatedPort:        "10250",
+	},
+	{
+		originalTargetAddress: "10.1.1.11",
+		originalTargetPort:    "10250",
+		translatedAddress:     "10.254.1.11",
+		translatedPort:        "10250",
+	},
+}
+
+// fakeRunner records all executed commands and answers them with the configured
+// output or error, keyed by the command name and its arguments.
+type fakeRunner struct {
+	outputs  map[string]string
+	failures map[string]bool
+	executed []string
+	stdins   []string
+}
+
+func (f *fakeRunner) run(_ context.Context, stdin string, name string, args ...string) ([]byte, error) {
+	command := strings.Join(append([]string{name}, args...), " ")
+	f.executed = append(f.executed, command)
+	f.stdins = append(f.stdins, stdin)
+
+	if f.failures[command] {
+		return nil, errors.New("command failed")
+	}
+
+	return []byte(f.outputs[command]), nil
+}
+
+func TestNFTablesRuleset(t *testing.T) {
+	backend := newNFTablesBackend("test-table", "tun0", nil)
+
+	expected := `add table ip test-table
+delete table ip test-table
+table ip test-table {
+	chain output {
+		type nat hook output priority -100; policy accept;
+		ip daddr 10.1.1.11 tcp dport 10250 dnat to 10.254.1.11:10250
+		ip daddr 10.1.1.12 tcp dport 10250 dnat to 10.254.1.12:10250
+	}
+	chain postrouting {
+		type nat hook postrouting priority 100; policy accept;
+		oifname "tun0" masquerade
+	}
+}
+`
+
+	if ruleset := backend.ruleset(testRules); ruleset != expected {
+		t.Errorf("unexpected ruleset, expected:\n%s\ngot:\n%s", expected, ruleset)
+	}
+}
+
+func TestNFTablesSync(t *testing.T) {
+	ctx := context.Background()
+	runner := &fakeRunner{}
+	backend := newNFTablesBackend("test-table", "tun0", runner.run)
+
+	updated, err := backend.Sync(ctx, testRules)
+	if err != nil {
+		t.Fatalf("failed to sync rules: %v", err)
+	}
+	if !updated {
+		t.Error("expected the first sync to update the rules")
+	}
+	if len(runner.executed) != 1 || runner.executed[0] != "nft -f -" {
+		t.Fatalf("expected the ruleset to be applied, but executed %v", runner.executed)
+	}
+	if runner.stdins[0] != backend.ruleset(testRules) {
+		t.Errorf("expected the ruleset to be passed to nft, got:\n%s", runner.stdins[0])
+	}
+
+	// syncing the same rules again must only check for the table
+	runner.executed = nil
+	updated, err = backend.Sync(ctx, testRules)
+	if err != nil {
+		t.Fatalf("failed to sync rules: %v", err)
+	}
+	if updated {
+		t.Error("expected the second sync to not update the rules")
+	}
+	if len(runner.executed) != 1 || runner.executed[0] != "nft list table ip test-table" {
+		t.Errorf("expected only the table to be checked, but executed %v", runner.executed)
+	}
+
+	// a removed table must be recreated
+	runner.executed = nil
+	runner.failures = map[string]bool{"nft list table ip test-table": true}
+	updated, err = backend.Sync(ctx, testRules)
+	if err != nil {
+		t.Fatalf("failed to sync rules: %v", err)
+	}
+	if !updated {
+		t.Error("expected the removed table to be recreated")
+	}
+}
+
+func TestIPTablesSync(t *testing.T) {
+	testCases := []struct {
+		name            string
+		saveOutput      string
+		expectedUpdate  bool
+		expectedRestore string
+	}{
+		{
+			name:           "all rules present",
+			expectedUpdate: false,
+			saveOutput: strings.Join([]string{
+				"*nat",
+				":test-chain - [0:0]",
+				"-A OUTPUT -j test-chain",
+				"-A POSTROUTING -o tun0 -j MASQUERADE",
+				"-A test-chain -d 10.1.1.11/32 -p tcp -m tcp --dport 10250 -j DNAT --to-destination 10.254.1.11:10250",
+				"-A test-chain -d 10.1.1.12/32 -p tcp -m tcp --dport 10250 -j DNAT --to-destination 10.254.1.12:10250",
+				"COMMIT",
+			}, "\n"),
+		},
+		{
+			name:           "empty nat table",
+			expectedUpdate: true,
+			saveOutput:     "*nat\nCOMMIT\n",
+			expectedRestore: strings.Join([]string{
+				"*nat",
+				":test-chain - [0:0]",
+				"-I OUTPUT -j test-chain",
+				"-I POSTROUTING -o tun0 -j MASQUERADE",
+				"-A test-chain -d 10.1.1.11/32 -p tcp -m tcp --dport 10250 -j DNAT --to-destination 10.254.1.11:10250",
+				"-A test-chain -d 10.1.1.12/32 -p tcp -m tcp --dport 10250 -j DNAT --to-destination 10.254.1.12:10250",
+				"COMMIT",
+			}, "\n") + "\n",
+		},
+		{
+			name:           "outdated rule",
+			expectedUpdate: true,
+			saveOutput: strings.Join([]string{
+				"*nat",
+				":test-chain - [0:0]",
+				"-A OUTPUT -j test-chain",
+				"-A POSTROUTING -o tun0 -j MASQUERADE",
+				"-A test-chain -d 10.1.1.10/32 -p tcp -m tcp --dport 10250 -j DNAT --to-destination 10.254.1.10:10250",
+				"COMMIT",
+			}, "\n"),
+			expectedRestore: strings.Join([]string{
+				"*nat",
+				":test-chain - [0:0]",
+				"-A test-chain -d 10.1.1.11/32 -p tcp -m tcp --dport 10250 -j DNAT --to-destination 10.254.1.11:10250",
+				"-A test-chain -d 10.1.1.12/32 -p tcp -m tcp --dport 10250 -j DNAT --to-destination 10.254.1.12:10250",
+				"COMMIT",
+			}, "\n") + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := &fakeRunner{
+				outputs: map[string]string{
+					"iptables-save -t nat": tc.saveOutput,
+				},
+			}
+			backend := newIPTablesBackend("test-chain", "tun0", iptablesDefault, runner.run)
+
+			updated, err := backend.Sync(context.Background(), testRules)
+			if err != nil {
+				t.Fatalf("failed to sync rules: %v", err)
+			}
+			if updated != tc.expectedUpdate {
+				t.Fatalf("expected update to be %v, got %v", tc.expectedUpdate, updated)
+			}
+			if !tc.expectedUpdate {
+				if len(runner.executed) != 1 {
+					t.Errorf("expected no rules to be restored, but executed %v", runner.executed)
+				}
+				return
+			}
+
+			if len(runner.executed) != 2 || runner.executed[1] != "iptables-restore --noflush -v -T nat" {
+				t.Fatalf("expected rules to be restored, but executed %v", runner.executed)
+			}
+			if runner.stdins[1] != tc.expectedRestore {
+				t.Errorf("unexpected restore file, expected:\n%s\ngot:\n%s", tc.expectedRestore, runner.stdins[1])
+			}
+		})
+	}
+}
+
+func TestIPTablesCleanup(t *testing.T) {
+	testCases := []struct {
+		name            string
+		saveOutput      string
+		expectedCleanup string
+	}{
+		{
+			name:       "no rules installed",
+			saveOutput: "*nat\n-A POSTROUTING -o eth0 -j MASQUERADE\nCOMMIT\n",
+		},
+		{
+			name: "all rules installed",
+			saveOutput: strings.Join([]string{
+				"*nat",
+				":test-chain - [0:0]",
+				"-A OUTPUT -j test-chain",
+				"-A POSTROUTING -o tun0 -j MASQUERADE",
+				"-A test-chain -d 10.1.1.11/32 -p tcp -m tcp --dport 10250 -j DNAT --to-destination 10.254.1.11:10250",
+				"COMMIT",
+			}, "\n"),
+			expectedCleanup: strings.Join([]string{
+				"*nat",
+				"-D OUTPUT -j test-chain",
+				"-D POSTROUTING -o tun0 -j MASQUERADE",
+				":test-chain - [0:0]",
+				"-X test-chain",
+				"COMMIT",
+			}, "\n") + "\n",
+		},
+		{
+			name: "only the chain is left",
+			saveOutput: strings.Join([]string{
+				"*nat",
+				":test-chain - [0:0]",
+				"COMMIT",
+			}, "\n"),
+			expectedCleanup: strings.Join([]string{
+				"*nat",
+				":test-chain - [0:0]",
+				"-X test-chain",
+				"COMMIT",
+			}, "\n") + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := &fakeRunner{
+				outputs: map[string]string{
+					"iptables-legacy-save -t nat": tc.saveOutput,
+				},
+			}
+			backend := newIPTablesBackend("test-chain", "tun0", iptablesLegacy, runner.run)
+
+			if err := backend.Cleanup(context.Background()); err != nil {
+				t.Fatalf("failed to clean up rules: %v", err)
+			}
+
+			if tc.expectedCleanup == "" {
+				if len(runner.executed) != 1 {
+					t.Errorf("expected no rules to be removed, but executed %v", runner.executed)
+				}
+				return
+			}
+
+			if len(runner.executed) != 2 || runner.executed[1] != "iptables-legacy-restore --noflush -v -T nat" {
+				t.Fatalf("expected rules to be removed, but executed %v", runner.executed)
+			}
+			if runner.stdins[1] != tc.expectedCleanup {
+				t.Errorf("unexpected restore file, expected:\n%s\ngot:\n%s", tc.expectedCleanup, runner.stdins[1])
+			}
+		})
+	}
+}
+
+func TestNFTablesCleanup(t *testing.T) {
+	runner := &fakeRunner{}
+	backend := newNFTablesBackend("test-table", "tun0", runner.run)
+
+	if err := backend.Cleanup(context.Background()); err != nil {
+		t.Fatalf("failed to clean up table: %v", err)
+	}
+	if len(runner.executed) != 2 || runner.executed[1] != "nft delete table ip test-table" {
+		t.Errorf("expected the table to be deleted, but executed %v", runner.executed)
+	}
+
+	// a missing table must not be deleted
+	runner.executed = nil
+	runner.failures = map[string]bool{"nft list table ip test-table": true}
+	if err := backend.Cleanup(context.Background()); err != nil {
+		t.Fatalf("failed to clean up table: %v", err)
+	}
+	if len(runner.executed) != 1 {
+		t.Errorf("expected only the table to be checked, but executed %v", runner.executed)
+	}
+}
+
+func TestNewRuleBackend(t *testing.T) {
+	legacyRules := strings.Join([]string{
+		"*nat",
+		"-A PREROUTING -m comment --comment \"kubernetes service portals\" -j KUBE-SERVICES",
+		"-A OUTPUT -m comment --comment \"kubernetes service portals\" -j KUBE-SERVICES",
+		"-A POSTROUTING -m comment --comment \"kubernetes postrouting rules\" -j KUBE-POSTROUTING",
+		"COMMIT",
+	}, "\n")
+
+	testCases := []struct {
+		name            string
+		backend         Backend
+		outputs         map[string]string
+		failures        map[string]bool
+		expectedBackend Backend
+		expectedRemoval []string
+		expectErr       bool
+	}{
+		{
+			name:            "auto uses legacy iptables if it holds the host's rules",
+			backend:         BackendAuto,
+			outputs:         map[string]string{"iptables-legacy-save -t nat": legacyRules},
+			expectedBackend: BackendIPTables,
+			expectedRemoval: []string{"nft delete table ip test"},
+		},
+		{
+			name:    "auto uses nftables if it holds the host's rules",
+			backend: BackendAuto,
+			outputs: map[string]string{
+				"iptables-legacy-save -t nat": "*nat\n-A POSTROUTING -o tun0 -j MASQUERADE\nCOMMIT\n",
+				"iptables-nft-save -t nat":    legacyRules + "\n:test - [0:0]",
+			},
+			expectedBackend: BackendNFTables,
+			expectedRemoval: []string{"iptables-legacy-restore --noflush -v -T nat", "iptables-nft-restore --noflush -v -T nat"},
+		},
+		{
+			name:            "auto uses nftables if there are no rules",
+			backend:         BackendAuto,
+			expectedBackend: BackendNFTables,
+		},
+		{
+			name:            "auto uses legacy iptables if nf_tables is not usable",
+			backend:         BackendAuto,
+			failures:        map[string]bool{"iptables-nft-save -t nat": true, "nft list tables": true},
+			expectedBackend: BackendIPTables,
+		},
+		{
+			name:    "auto falls back to iptables if the variants are unknown",
+			backend: BackendAuto,
+			failures: map[string]bool{
+				"iptables-legacy-save -t nat": true,
+				"iptables-nft-save -t nat":    true,
+				"nft list tables":             true,
+			},
+			expectedBackend: BackendIPTables,
+		},
+		{
+			name:    "auto fails without any usable tool",
+			backend: BackendAuto,
+			failures: map[string]bool{
+				"iptables-legacy-save -t nat": true,
+				"iptables-nft-save -t nat":    true,
+				"iptables-save -t nat":        true,
+				"nft list tables":             true,
+			},
+			expectErr: true,
+		},
+		{
+			name:            "explicit nftables",
+			backend:         BackendNFTables,
+			failures:        map[string]bool{"nft list tables": true},
+			expectedBackend: BackendNFTables,
+		},
+		{
+			name:      "unknown backend",
+			backend:   Backend("ipfw"),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := &fakeRunner{outputs: tc.outputs, failures: tc.failures}
+
+			backend, err := newRuleBackend(context.Background(), zap.NewNop().Sugar(), tc.backend, "test", "tun0", runner.run)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to create backend: %v", err)
+			}
+
+			if backend.Name() != tc.expectedBackend {
+				t.Errorf("expected backend %q, got %q", tc.expectedBackend, backend.Name())
+			}
+
+			for _, command := range tc.expectedRemoval {
+				if !slices.Contains(runner.executed, command) {
+					t.Errorf("expected the rules of the unused backend to be removed with %q, but executed %v", command, runner.executed)
+				}
+			}
+		})
+	}
+}
@@ -19,13 +19,9 @@ package kubeletdnatcontroller
 import (
 	"context"
 	"fmt"
-	"io"
 	"net"
-	"os/exec"
-	"sort"
 	"strconv"
 	"strings"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -47,7 +43,7 @@ const (
 	ControllerName = "kkp-kubeletdnat-controller"
 )
 
-// Reconciler updates iptable rules to match node addresses.
+// Reconciler updates iptables or nftables rules to match node addresses.
 // Every node address gets a translation to the respective node-access (vpn) address.
 type Reconciler struct {
 	ctrlruntimeclient.Client
@@ -55,6 +51,11 @@ type Reconciler struct {
 	nodeTranslationChainName string
 	nodeAccessNetwork        net.IP
 	vpnInterface             string
+	backendName              Backend
+
+	// backend is determined on the first reconciliation.
+	backend ruleBackend
+	run     commandRunner
 
 	log *zap.SugaredLogger
 }
@@ -75,12 +76,21 @@ func Add(
 	nodeAccessNetwork net.IP,
 	log *zap.SugaredLogger,
 	vpnInterface string,
+	backend Backend,
 ) error {
+	switch backend {
+	case BackendAuto, BackendIPTables, BackendNFTables:
+	default:
+		return fmt.Errorf("unknown backend %q", backend)
+	}
+
 	reconciler := &Reconciler{
 		Client:                   mgr.GetClient(),
 		nodeTranslationChainName: nodeTranslationChainName,
 		nodeAccessNetwork:        nodeAccessNetwork,
 		vpnInterface:             vpnInterface,
+		backendName:              backend,
+		run:                      execCommand,
 		log:                      log,
 	}
 
@@ -132,25 +142,32 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{}, err
 }
 
-func (r *Reconciler) getDesiredRules(nodes []corev1.Node) []string {
-	rules := []string{}
+func (r *Reconciler) getDesiredRules(nodes []corev1.Node) []*dnatRule {
+	rules := []*dnatRule{}
 	for _, node := range nodes {
 		nodeRules, err := r.getRulesForNode(node)
 		if err != nil {
 			r.log.Errorw("could not generate rules for node, skipping", "node", node.Name, zap.Error(err))
 			continue
 		}
-		for _, rule := range nodeRules {
-			rules = append(rules, rule.RestoreLine(r.nodeTranslationChainName))
-		}
+		rules = append(rules, nodeRules...)
 	}
-	sort.Strings(rules)
 	return rules
 }
 
 // syncDnatRules will recreate the complete set of translation rules
 // based on the list of nodes.
 func (r *Reconciler) syncDnatRules(ctx context.Context) error {
+	if r.backend == nil {
+		backend, err := newRuleBackend(ctx, r.log, r.backendName, r.nodeTranslationChainName, r.vpnInterface, r.run)
+		if err != nil {
+			return fmt.Errorf("failed to determine rule backend: %w", err)
+		}
+
+		r.log.Infow("Using rule backend", "backend", backend.Name())
+		r.backend = backend
+	}
+
 	// Get nodes from lister, make a copy.
 	nodeList := &corev1.NodeList{}
 	if err := r.List(ctx, nodeList); err != nil {
@@ -160,20 +177,13 @@ func (r *Reconciler) syncDnatRules(ctx context.Context) error {
 	// Create the set of rules from all listed nodes.
 	desiredRules := r.getDesiredRules(nodeList.Items)
 
-	// Get the actual state (current iptable rules)
-	allActualRules, err := execSave(ctx)
+	updated, err := r.backend.Sync(ctx, desiredRules)
 	if err != nil {
-		return fmt.Errorf("failed to read iptable rules: %w", err)
+		return err
 	}
-	// filter out everything that's not relevant for us
-	actualRules, haveJump, haveMasquerade := r.filterDnatRules(allActualRules, r.nodeTranslationChainName)
-
-	if !equality.Semantic.DeepEqual(actualRules, desiredRules) || !haveJump || !haveMasquerade {
-		// Need to update chain in kernel.
-		r.log.Infow("Updating iptables chain in kernel", "rules-count", len(desiredRules))
-		if err := r.applyDNATRules(ctx, desiredRules, haveJump, haveMasquerade); err != nil {
-			return fmt.Errorf("failed to apply iptable rules: %w", err)
-		}
+
+	if updated {
+		r.log.Infow("Updated rules in kernel", "backend", r.backend.Name(), "rules-count", len(desiredRules))
 	}
 
 	return nil
@@ -247,131 +257,3 @@ func (r *Reconciler) getRulesForNode(node corev1.Node) ([]*dnatRule, error) {
 	}
 	return rules, nil
 }
-
-// applyRules creates a iptables-save file and pipes it to stdin of
-// a iptables-restore process for atomically setting new rules.
-// This function replaces a complete chain (removing all pre-existing rules).
-func (r *Reconciler) applyDNATRules(ctx context.Context, rules []string, haveJump, haveMasquerade bool) error {
-	restore := []string{
-		"*nat",
-		fmt.Sprintf(":%s - [0:0]", r.nodeTranslationChainName)}
-
-	if !haveJump {
-		restore = append(restore,
-			fmt.Sprintf("-I OUTPUT -j %s", r.nodeTranslationChainName))
-	}
-
-	if !haveMasquerade {
-		restore = append(restore,
-			fmt.Sprintf("-I POSTROUTING -o %s -j MASQUERADE", r.vpnInterface))
-	}
-
-	restore = append(restore, rules...)
-	restore = append(restore, "COMMIT")
-
-	return execRestore(ctx, restore)
-}
-
-func execSave(ctx context.Context) ([]string, error) {
-	cmd := exec.CommandContext(ctx, "iptables-save", []string{"-t", "nat"}...)
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute %q: %w. Output: \n%s", strings.Join(cmd.Args, " "), err, out)
-	}
-	return strings.Split(string(out), "\n"), err
-}
-
-func execRestore(ctx context.Context, rules []string) error {
-	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
-
-	cmd := exec.CommandContext(timeoutCtx, "iptables-restore", []string{"--noflush", "-v", "-T", "nat"}...)
-
-	stdin, err := cmd.StdinPipe()
-	if err != nil {
-		return err
-	}
-
-	// if input is bigger than OS' pipe buffer size, the write call blocks indefinitely
-	go func() {
-		// ignore errors here, since it would likely lead to either the command to time out or to fail
-		_, _ = io.WriteString(stdin, strings.Join(rules, "\n")+"\n")
-		stdin.Close()
-	}()
-
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		if len(out) > 0 {
-			return fmt.Errorf("iptables-restore failed: %w (output: %s)", err, string(out))
-		}
-		return fmt.Errorf("iptables-restore failed: %w", err)
-	}
-
-	return nil
-}
-
-// GetMatchArgs returns iptables arguments to match for the
-// rule's originalTargetAddress and Port.
-func (rule *dnatRule) GetMatchArgs() []string {
-	return []string{
-		"-d", rule.originalTargetAddress + "/32",
-		"-p", "tcp",
-		"-m", "tcp",
-		"--dport", rule.originalTargetPort,
-	}
-}
-
-// GetTargetArgs returns iptables arguments to specify the
-// rule's target after translation.
-func (rule *dnatRule) GetTargetArgs() []string {
-	var target string
-	if len(rule.translatedAddress) > 0 {
-		target = rule.translatedAddress
-	}
-	target += ":"
-	if len(rule.translatedPort) > 0 {
-		target += rule.translatedPort
-	}
-	if len(target) == 0 {
-		return []string{}
-	}
-	return []string{
-		"-j", "DNAT",
-		"--to-destination", target,
-	}
-}
-
-// RestoreLine returns a line of `iptables-save`-file representing
-// the rule.
-func (rule *dnatRule) RestoreLine(chain string) string {
-	args := []string{"-A", chain}
-	args = append(args, rule.GetMatchArgs()...)
-	args = append(args, rule.GetTargetArgs()...)
-	return strings.Join(args, " ")
-}
-
-// filterDnatRules enumerates through all given rules and returns all
-// rules matching the given chain. It also returns two booleans to
-// indicate if the jump and the masquerade rule are present.
-func (r *Reconciler) filterDnatRules(rules []string, chain string) ([]string, bool, bool) {
-	out := []string{}
-	haveJump := false
-	haveMasquerade := false
-
-	rulePrefix := fmt.Sprintf("-A %s ", chain)
-	jumpPattern := fmt.Sprintf("-A OUTPUT -j %s", chain)
-	masqPattern := fmt.Sprintf("-A POSTROUTING -o %s -j MASQUERADE", r.vpnInterface)
-	for _, rule := range rules {
-		if rule == jumpPattern {
-			haveJump = true
-		}
-		if rule == masqPattern {
-			haveMasquerade = true
-		}
-		if !strings.HasPrefix(rule, rulePrefix) {
-			continue
-		}
-		out = append(out, rule)
-	}
-	return out, haveJump, haveMasquerade
-}
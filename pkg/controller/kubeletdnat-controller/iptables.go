@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletdnatcontroller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// iptablesCommands are the save and restore commands of an iptables variant.
+type iptablesCommands struct {
+	save    string
+	restore string
+}
+
+var (
+	// iptablesDefault is the variant the iptables commands point to in the image.
+	iptablesDefault = iptablesCommands{save: "iptables-save", restore: "iptables-restore"}
+	// iptablesLegacy uses the legacy x_tables kernel API.
+	iptablesLegacy = iptablesCommands{save: "iptables-legacy-save", restore: "iptables-legacy-restore"}
+	// iptablesNFT uses the nf_tables kernel API.
+	iptablesNFT = iptablesCommands{save: "iptables-nft-save", restore: "iptables-nft-restore"}
+)
+
+// iptablesBackend manages a chain in the nat table, which is jumped to from the
+// OUTPUT chain.
+type iptablesBackend struct {
+	chain        string
+	vpnInterface string
+	commands     iptablesCommands
+	run          commandRunner
+}
+
+func newIPTablesBackend(chain string, vpnInterface string, commands iptablesCommands, run commandRunner) *iptablesBackend {
+	return &iptablesBackend{
+		chain:        chain,
+		vpnInterface: vpnInterface,
+		commands:     commands,
+		run:          run,
+	}
+}
+
+func (b *iptablesBackend) Name() Backend {
+	return BackendIPTables
+}
+
+func (b *iptablesBackend) Sync(ctx context.Context, rules []*dnatRule) (bool, error) {
+	desiredRules := b.desiredRules(rules)
+
+	// Get the actual state (current iptable rules)
+	allActualRules, err := b.execSave(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to read iptable rules: %w", err)
+	}
+	// filter out everything that's not relevant for us
+	actualRules, haveJump, haveMasquerade := b.filterDnatRules(allActualRules)
+
+	if equality.Semantic.DeepEqual(actualRules, desiredRules) && haveJump && haveMasquerade {
+		return false, nil
+	}
+
+	if err := b.execRestore(ctx, b.restoreFile(desiredRules, haveJump, haveMasquerade)); err != nil {
+		return false, fmt.Errorf("failed to apply iptable rules: %w", err)
+	}
+
+	return true, nil
+}
+
+func (b *iptablesBackend) Cleanup(ctx context.Context) error {
+	allActualRules, err := b.execSave(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read iptable rules: %w", err)
+	}
+
+	cleanup := b.cleanupFile(allActualRules)
+	if cleanup == nil {
+		return nil
+	}
+
+	if err := b.execRestore(ctx, cleanup); err != nil {
+		return fmt.Errorf("failed to remove iptable rules: %w", err)
+	}
+
+	return nil
+}
+
+// cleanupFile creates a iptables-save file that removes the jump and masquerade rules
+// and the backend's chain, or returns nil if none of them exist.
+func (b *iptablesBackend) cleanupFile(rules []string) []string {
+	_, haveJump, haveMasquerade := b.filterDnatRules(rules)
+	haveChain := slices.ContainsFunc(rules, func(rule string) bool {
+		return strings.HasPrefix(rule, fmt.Sprintf(":%s ", b.chain))
+	})
+
+	if !haveChain && !haveJump && !haveMasquerade {
+		return nil
+	}
+
+	cleanup := []string{"*nat"}
+
+	if haveJump {
+		cleanup = append(cleanup, fmt.Sprintf("-D OUTPUT -j %s", b.chain))
+	}
+
+	if haveMasquerade {
+		cleanup = append(cleanup, fmt.Sprintf("-D POSTROUTING -o %s -j MASQUERADE", b.vpnInterface))
+	}
+
+	// declaring the chain flushes it, so it can be deleted
+	if haveChain {
+		cleanup = append(cleanup,
+			fmt.Sprintf(":%s - [0:0]", b.chain),
+			fmt.Sprintf("-X %s", b.chain))
+	}
+
+	return append(cleanup, "COMMIT")
+}
+
+// desiredRules returns the sorted `iptables-save` lines for the given rules.
+func (b *iptablesBackend) desiredRules(rules []*dnatRule) []string {
+	lines := []string{}
+	for _, rule := range rules {
+		lines = append(lines, rule.RestoreLine(b.chain))
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+// restoreFile creates a iptables-save file for atomically setting new rules.
+// It replaces the complete chain (removing all pre-existing rules).
+func (b *iptablesBackend) restoreFile(rules []string, haveJump, haveMasquerade bool) []string {
+	restore := []string{
+		"*nat",
+		fmt.Sprintf(":%s - [0:0]", b.chain)}
+
+	if !haveJump {
+		restore = append(restore,
+			fmt.Sprintf("-I OUTPUT -j %s", b.chain))
+	}
+
+	if !haveMasquerade {
+		restore = append(restore,
+			fmt.Sprintf("-I POSTROUTING -o %s -j MASQUERADE", b.vpnInterface))
+	}
+
+	restore = append(restore, rules...)
+	restore = append(restore, "COMMIT")
+
+	return restore
+}
+
+func (b *iptablesBackend) execSave(ctx context.Context) ([]string, error) {
+	args := []string{"-t", "nat"}
+	out, err := b.run(ctx, "", b.commands.save, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute %q: %w. Output: \n%s", strings.Join(append([]string{b.commands.save}, args...), " "), err, out)
+	}
+	return strings.Split(string(out), "\n"), nil
+}
+
+// execRestore pipes the given file to stdin of a iptables-restore process.
+func (b *iptablesBackend) execRestore(ctx context.Context, rules []string) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	out, err := b.run(timeoutCtx, strings.Join(rules, "\n")+"\n", b.commands.restore, "--noflush", "-v", "-T", "nat")
+	if err != nil {
+		if len(out) > 0 {
+			return fmt.Errorf("iptables-restore failed: %w (output: %s)", err, string(out))
+		}
+		return fmt.Errorf("iptables-restore failed: %w", err)
+	}
+
+	return nil
+}
+
+// filterDnatRules enumerates through all given rules and returns all
+// rules matching the backend's chain. It also returns two booleans to
+// indicate if the jump and the masquerade rule are present.
+func (b *iptablesBackend) filterDnatRules(rules []string) ([]string, bool, bool) {
+	out := []string{}
+	haveJump := false
+	haveMasquerade := false
+
+	rulePrefix := fmt.Sprintf("-A %s ", b.chain)
+	jumpPattern := fmt.Sprintf("-A OUTPUT -j %s", b.chain)
+	masqPattern := fmt.Sprintf("-A POSTROUTING -o %s -j MASQUERADE", b.vpnInterface)
+	for _, rule := range rules {
+		if rule == jumpPattern {
+			haveJump = true
+		}
+		if rule == masqPattern {
+			haveMasquerade = true
+		}
+		if !strings.HasPrefix(rule, rulePrefix) {
+			continue
+		}
+		out = append(out, rule)
+	}
+	return out, haveJump, haveMasquerade
+}
+
+// GetMatchArgs returns iptables arguments to match for the
+// rule's originalTargetAddress and Port.
+func (rule *dnatRule) GetMatchArgs() []string {
+	return []string{
+		"-d", rule.originalTargetAddress + "/32",
+		"-p", "tcp",
+		"-m", "tcp",
+		"--dport", rule.originalTargetPort,
+	}
+}
+
+// GetTargetArgs returns iptables arguments to specify the
+// rule's target after translation.
+func (rule *dnatRule) GetTargetArgs() []string {
+	var target string
+	if len(rule.translatedAddress) > 0 {
+		target = rule.translatedAddress
+	}
+	target += ":"
+	if len(rule.translatedPort) > 0 {
+		target += rule.translatedPort
+	}
+	if len(target) == 0 {
+		return []string{}
+	}
+	return []string{
+		"-j", "DNAT",
+		"--to-destination", target,
+	}
+}
+
+// RestoreLine returns a line of `iptables-save`-file representing
+// the rule.
+func (rule *dnatRule) RestoreLine(chain string) string {
+	args := []string{"-A", chain}
+	args = append(args, rule.GetMatchArgs()...)
+	args = append(args, rule.GetTargetArgs()...)
+	return strings.Join(args, " ")
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletdnatcontroller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	nftCommand = "nft"
+
+	// nftDNATPriority and nftSNATPriority are the standard priorities of the
+	// nat hooks (dstnat and srcnat).
+	nftDNATPriority = -100
+	nftSNATPriority = 100
+)
+
+// nftablesBackend manages a dedicated table in the ip family. The table is always
+// replaced as a whole within a single nft transaction, so no other rules on the
+// host are touched and no intermediate state is ever visible.
+type nftablesBackend struct {
+	table        string
+	vpnInterface string
+	run          commandRunner
+
+	// applied is the ruleset that was last applied successfully.
+	applied string
+}
+
+func newNFTablesBackend(table string, vpnInterface string, run commandRunner) *nftablesBackend {
+	return &nftablesBackend{
+		table:        table,
+		vpnInterface: vpnInterface,
+		run:          run,
+	}
+}
+
+func (b *nftablesBackend) Name() Backend {
+	return BackendNFTables
+}
+
+func (b *nftablesBackend) Sync(ctx context.Context, rules []*dnatRule) (bool, error) {
+	ruleset := b.ruleset(rules)
+
+	// the table might have been removed by someone else in the meantime
+	if ruleset == b.applied && b.tableExists(ctx) {
+		return false, nil
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	out, err := b.run(timeoutCtx, ruleset, nftCommand, "-f", "-")
+	if err != nil {
+		if len(out) > 0 {
+			return false, fmt.Errorf("nft failed: %w (output: %s)", err, string(out))
+		}
+		return false, fmt.Errorf("nft failed: %w", err)
+	}
+
+	b.applied = ruleset
+
+	return true, nil
+}
+
+func (b *nftablesBackend) Cleanup(ctx context.Context) error {
+	if !b.tableExists(ctx) {
+		return nil
+	}
+
+	out, err := b.run(ctx, "", nftCommand, "delete", "table", "ip", b.table)
+	if err != nil {
+		return fmt.Errorf("failed to delete table: %w (output: %s)", err, string(out))
+	}
+
+	b.applied = ""
+
+	return nil
+}
+
+func (b *nftablesBackend) tableExists(ctx context.Context) bool {
+	_, err := b.run(ctx, "", nftCommand, "list", "table", "ip", b.table)
+	return err == nil
+}
+
+// ruleset returns the nft script that replaces the backend's table with one containing
+// the given rules. Adding the table before deleting it makes the deletion succeed even
+// if the table does not exist yet.
+func (b *nftablesBackend) ruleset(rules []*dnatRule) string {
+	lines := []string{}
+	for _, rule := range rules {
+		lines = append(lines, rule.NFTablesLine())
+	}
+	sort.Strings(lines)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "add table ip %s\n", b.table)
+	fmt.Fprintf(&buf, "delete table ip %s\n", b.table)
+	fmt.Fprintf(&buf, "table ip %s {\n", b.table)
+	fmt.Fprintf(&buf, "\tchain output {\n")
+	fmt.Fprintf(&buf, "\t\ttype nat hook output priority %d; policy accept;\n", nftDNATPriority)
+	for _, line := range lines {
+		fmt.Fprintf(&buf, "\t\t%s\n", line)
+	}
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\tchain postrouting {\n")
+	fmt.Fprintf(&buf, "\t\ttype nat hook postrouting priority %d; policy accept;\n", nftSNATPriority)
+	fmt.Fprintf(&buf, "\t\toifname %q masquerade\n", b.vpnInterface)
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "}\n")
+
+	return buf.String()
+}
+
+// NFTablesLine returns the nftables rule statement representing the rule.
+func (rule *dnatRule) NFTablesLine() string {
+	return fmt.Sprintf("ip daddr %s tcp dport %s dnat to %s:%s",
+		rule.originalTargetAddress, rule.originalTargetPort,
+		rule.translatedAddress, rule.translatedPort)
+}
@@ -183,6 +183,7 @@ func clusterTemplateReconcilerFactory(template *kubermaticv1.ClusterTemplate) re
 			c.Annotations = template.Annotations
 			c.InheritedClusterLabels = template.InheritedClusterLabels
 			c.Credential = template.Credential
+			c.Parameters = template.Parameters
 			return c, nil
 		}
 	}
@@ -117,6 +117,13 @@ func generateClusterTemplate(name string, deleted bool) *kubermaticv1.ClusterTem
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
 		},
+		Parameters: []kubermaticv1.ClusterTemplateParameter{
+			{
+				Name:  "version",
+				Type:  kubermaticv1.ClusterTemplateParameterTypeString,
+				Paths: []string{"/spec/version"},
+			},
+		},
 		Spec: kubermaticv1.ClusterSpec{
 			Cloud: generator.GenDefaultCluster().Spec.Cloud,
 		},
@@ -469,7 +469,8 @@ func generateRBACRoleBindingForClusterNamespaceNamedResource(cluster *kubermatic
 }
 
 // generateRBACRoleForClusterNamespaceResourceAndServiceAccount generates per-cluster Role for the given cluster and service account in the cluster namespace.
-func generateRBACRoleForClusterNamespaceResourceAndServiceAccount(cluster *kubermaticv1.Cluster, verbs []string, serviceAccountName, policyResource, policyAPIGroups, kind string) (*rbacv1.Role, error) {
+// If resourceNames are given, the Role is limited to these objects.
+func generateRBACRoleForClusterNamespaceResourceAndServiceAccount(cluster *kubermaticv1.Cluster, verbs []string, serviceAccountName, policyResource, policyAPIGroups, kind string, resourceNames ...string) (*rbacv1.Role, error) {
 	role := &rbacv1.Role{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      generateRBACRoleNameForClusterNamespaceResourceAndServiceAccount(kind, serviceAccountName),
@@ -477,9 +478,10 @@ func generateRBACRoleForClusterNamespaceResourceAndServiceAccount(cluster *kuber
 		},
 		Rules: []rbacv1.PolicyRule{
 			{
-				APIGroups: []string{policyAPIGroups},
-				Resources: []string{policyResource},
-				Verbs:     verbs,
+				APIGroups:     []string{policyAPIGroups},
+				Resources:     []string{policyResource},
+				ResourceNames: resourceNames,
+				Verbs:         verbs,
 			},
 		},
 	}
@@ -214,6 +214,8 @@ func (r *Reconciler) cleanupDeletedSeed(ctx context.Context, cfg *kubermaticv1.K
 		common.PolicyTemplateAdmissionWebhookName,
 		kubermaticseed.ClusterAdmissionWebhookName,
 		kubermaticseed.IPAMPoolAdmissionWebhookName,
+		kubermaticseed.ClusterTemplateInstanceAdmissionWebhookName,
+		kubermaticseed.RuleGroupAdmissionWebhookName,
 	}
 
 	for _, name := range names {
@@ -693,6 +695,8 @@ func (r *Reconciler) reconcileAdmissionWebhooks(ctx context.Context, cfg *kuberm
 		common.ApplicationDefinitionValidatingWebhookConfigurationReconciler(ctx, cfg, client),
 		common.PolicyTemplateValidatingWebhookConfigurationReconciler(ctx, cfg, client),
 		kubermaticseed.IPAMPoolValidatingWebhookConfigurationReconciler(ctx, cfg, client),
+		kubermaticseed.ClusterTemplateInstanceValidatingWebhookConfigurationReconciler(ctx, cfg, client),
+		kubermaticseed.RuleGroupValidatingWebhookConfigurationReconciler(ctx, cfg, client),
 		common.PoliciesWebhookConfigurationReconciler(ctx, cfg, client),
 	}
 
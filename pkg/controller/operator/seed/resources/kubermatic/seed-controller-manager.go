@@ -107,6 +107,10 @@ func SeedControllerManagerDeploymentReconciler(workerName string, versions kuber
 				args = append(args, fmt.Sprintf("-backup-interval=%s", cfg.Spec.SeedController.BackupInterval.Duration))
 			}
 
+			if threshold := cfg.Spec.UserCluster.CertificateRenewalThreshold; threshold != nil && threshold.Duration > 0 {
+				args = append(args, fmt.Sprintf("-certificate-renewal-threshold=%s", threshold.Duration))
+			}
+
 			if cfg.Spec.SeedController.BackupCount != nil {
 				args = append(args, fmt.Sprintf("-backup-count=%d", *cfg.Spec.SeedController.BackupCount))
 			}
@@ -17,21 +17,15 @@ limitations under the License.
 package addon
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"os"
-	"os/exec"
-	"path"
 	"slices"
-	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
 	"k8c.io/kubermatic/sdk/v2/apis/equality"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
-	"k8c.io/kubermatic/sdk/v2/semver"
 	"k8c.io/kubermatic/v2/pkg/addon"
 	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/addon/migrations"
@@ -39,7 +33,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling/modifier"
-	"k8c.io/kubermatic/v2/pkg/util/kubectl"
+	"k8c.io/kubermatic/v2/pkg/util/inventory"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -49,7 +43,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -64,7 +57,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
-	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -78,6 +70,8 @@ const (
 	pvMigrationAnnotation        = "pv.kubernetes.io/migrated-to"
 	defaultStorageClassAddonName = "default-storage-class"
 
+	reconcilingFailedReason = "ReconcilingFailed"
+
 	kindDeployment             = "Deployment"
 	openstackCsiDeploymentName = "openstack-cinder-csi-controllerplugin"
 )
@@ -341,9 +335,17 @@ func (r *Reconciler) addonReconcileWrapper(
 		reconcilingStatus = corev1.ConditionTrue
 	}
 
+	// Surface the error in the condition, as it can contain the errors for
+	// every single object that could not be applied.
+	var reason, message string
+	if err != nil {
+		reason = reconcilingFailedReason
+		message = err.Error()
+	}
+
 	errs := []error{err}
 	err = util.UpdateAddonStatus(ctx, r, addon, func(a *kubermaticv1.Addon) {
-		r.setAddonCondition(a, conditionType, reconcilingStatus)
+		r.setAddonCondition(a, conditionType, reconcilingStatus, reason, message)
 		a.Status.Phase = getAddonPhase(a)
 	})
 	if ctrlruntimeclient.IgnoreNotFound(err) != nil {
@@ -497,28 +499,14 @@ func (r *Reconciler) getAddonManifests(ctx context.Context, log *zap.SugaredLogg
 	return addonObj.Render(r.overwriteRegistry, data)
 }
 
-// combineManifests returns all manifests combined into a multi document yaml.
-func (r *Reconciler) combineManifests(manifests []*bytes.Buffer) *bytes.Buffer {
-	parts := make([]string, len(manifests))
-	for i, m := range manifests {
-		s := m.String()
-		s = strings.TrimSuffix(s, "\n")
-		s = strings.TrimSpace(s)
-		parts[i] = s
-	}
-
-	return bytes.NewBufferString(strings.Join(parts, "\n---\n") + "\n")
-}
-
-// ensureAddonLabelOnManifests adds the addonLabelKey label to all manifests.
-// For this to happen we need to decode all yaml files to json, parse them, add the label and finally encode to yaml again.
+// ensureAddonLabelOnManifests parses all manifests and adds the addonLabelKey label to them.
 func (r *Reconciler) ensureAddonLabelOnManifests(
 	ctx context.Context,
 	cluster *kubermaticv1.Cluster,
 	addon *kubermaticv1.Addon,
 	manifests []runtime.RawExtension,
-) ([]*bytes.Buffer, error) {
-	var rawManifests []*bytes.Buffer
+) ([]*metav1unstructured.Unstructured, error) {
+	var objects []*metav1unstructured.Unstructured
 
 	wantLabels := r.getAddonLabel(addon)
 	for _, m := range manifests {
@@ -546,22 +534,10 @@ func (r *Reconciler) ensureAddonLabelOnManifests(
 			}
 		}
 
-		jsonBuffer := &bytes.Buffer{}
-		if err := metav1unstructured.UnstructuredJSONScheme.Encode(parsedUnstructuredObj, jsonBuffer); err != nil {
-			return nil, fmt.Errorf("encoding json failed: %w", err)
-		}
-
-		// Must be encoding back to yaml, otherwise kubectl fails to apply because it tries to parse the whole
-		// thing as json
-		yamlBytes, err := yaml.JSONToYAML(jsonBuffer.Bytes())
-		if err != nil {
-			return nil, err
-		}
-
-		rawManifests = append(rawManifests, bytes.NewBuffer(yamlBytes))
+		objects = append(objects, parsedUnstructuredObj)
 	}
 
-	return rawManifests, nil
+	return objects, nil
 }
 
 func (r *Reconciler) getAddonLabel(addon *kubermaticv1.Addon) map[string]string {
@@ -570,124 +546,42 @@ func (r *Reconciler) getAddonLabel(addon *kubermaticv1.Addon) map[string]string
 	}
 }
 
-type fileHandlingDone func()
-
-func getFileDeleteFinalizer(log *zap.SugaredLogger, filename string) fileHandlingDone {
-	return func() {
-		if err := os.RemoveAll(filename); err != nil {
-			log.Errorw("Failed to delete file", zap.Error(err), "file", filename)
-		}
-	}
-}
-
-func (r *Reconciler) writeCombinedManifest(log *zap.SugaredLogger, manifest *bytes.Buffer, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (string, fileHandlingDone, error) {
-	// Write combined Manifest to disk
-	manifestFilename := path.Join("/tmp", fmt.Sprintf("cluster-%s-%s.yaml", cluster.Name, addon.Name))
-	if err := os.WriteFile(manifestFilename, manifest.Bytes(), 0644); err != nil {
-		return "", nil, fmt.Errorf("failed to write combined manifest to %s: %w", manifestFilename, err)
-	}
-	log.Debugw("Wrote combined manifest", "file", manifestFilename)
-
-	return manifestFilename, getFileDeleteFinalizer(log, manifestFilename), nil
-}
-
-func (r *Reconciler) writeAdminKubeconfig(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (string, fileHandlingDone, error) {
-	// Write kubeconfig to disk
-	kubeconfig, err := r.kubeconfigProvider.GetAdminKubeconfig(ctx, cluster)
-	if err != nil {
-		return "", nil, fmt.Errorf("failed to get admin kubeconfig for cluster %s: %w", cluster.Name, err)
-	}
-	kubeconfigFilename := path.Join("/tmp", fmt.Sprintf("cluster-%s-addon-%s-kubeconfig", cluster.Name, addon.Name))
-	if err := os.WriteFile(kubeconfigFilename, kubeconfig, 0644); err != nil {
-		return "", nil, fmt.Errorf("failed to write admin kubeconfig for cluster %s: %w", cluster.Name, err)
-	}
-	log.Debugw("Wrote admin kubeconfig", "file", kubeconfigFilename)
-
-	return kubeconfigFilename, getFileDeleteFinalizer(log, kubeconfigFilename), nil
-}
-
-func (r *Reconciler) setupManifestInteraction(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (string, string, fileHandlingDone, error) {
+func (r *Reconciler) renderManifests(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) ([]*metav1unstructured.Unstructured, error) {
 	addonObj, exists := r.addons[addon.Name]
 	if !exists {
-		return "", "", nil, fmt.Errorf("no addon manifests configured for %q", addon.Name)
+		return nil, fmt.Errorf("no addon manifests configured for %q", addon.Name)
 	}
 
 	manifests, err := r.getAddonManifests(ctx, log, addon, cluster, addonObj)
 	if err != nil {
-		return "", "", nil, fmt.Errorf("failed to get addon manifests: %w", err)
-	}
-
-	rawManifests, err := r.ensureAddonLabelOnManifests(ctx, cluster, addon, manifests)
-	if err != nil {
-		return "", "", nil, fmt.Errorf("failed to add the addon specific label to all addon resources: %w", err)
-	}
-
-	rawManifest := r.combineManifests(rawManifests)
-	manifestFilename, manifestDone, err := r.writeCombinedManifest(log, rawManifest, addon, cluster)
-	if err != nil {
-		return "", "", nil, fmt.Errorf("failed to write all addon resources into a combined manifest file: %w", err)
-	}
-
-	kubeconfigFilename, kubeconfigDone, err := r.writeAdminKubeconfig(ctx, log, addon, cluster)
-	if err != nil {
-		return "", "", nil, fmt.Errorf("failed to write the admin kubeconfig to the local filesystem: %w", err)
+		return nil, fmt.Errorf("failed to get addon manifests: %w", err)
 	}
 
-	done := func() {
-		kubeconfigDone()
-		manifestDone()
-	}
-	return kubeconfigFilename, manifestFilename, done, nil
-}
-
-func (r *Reconciler) getApplyCommand(ctx context.Context, kubeconfigFilename, manifestFilename string, selector fmt.Stringer, clusterVersion semver.Semver) (*exec.Cmd, error) {
-	binary, err := kubectl.BinaryForClusterVersion(&clusterVersion)
+	objects, err := r.ensureAddonLabelOnManifests(ctx, cluster, addon, manifests)
 	if err != nil {
-		return nil, fmt.Errorf("failed to determine kubectl binary to use: %w", err)
+		return nil, fmt.Errorf("failed to add the addon specific label to all addon resources: %w", err)
 	}
 
-	cmd := exec.CommandContext(
-		ctx,
-		binary,
-		"--kubeconfig", kubeconfigFilename,
-		"apply",
-		"--prune",
-		"--filename", manifestFilename,
-		"--selector", selector.String(),
-	)
-	return cmd, nil
+	return objects, nil
 }
 
 func (r *Reconciler) ensureIsInstalled(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster, migration migrations.AddonMigration) error {
-	kubeconfigFilename, manifestFilename, done, err := r.setupManifestInteraction(ctx, log, addon, cluster)
+	objects, err := r.renderManifests(ctx, log, addon, cluster)
 	if err != nil {
 		return err
 	}
-	defer done()
 
-	d, err := os.ReadFile(manifestFilename)
-	if err != nil {
-		return err
-	}
-	sd := strings.TrimSpace(string(d))
-	if len(sd) == 0 {
-		log.Debug("Skipping addon installation as the manifest is empty after parsing")
+	if len(objects) == 0 {
+		log.Debug("Addon manifest is empty after parsing")
 		// default-storage-class addon's manifests becomes empty once csi drivers are disabled for a cluster.
-		// we remove the resources created by the addon
+		// Its resources are pruned based on the inventory below, but addons that have been installed
+		// before the inventory was introduced need to be cleaned up explicitly.
 		if addon.Name == defaultStorageClassAddonName {
 			err := r.cleanupDefaultStorageClassAddon(ctx, cluster, addon)
 			if err != nil {
 				return fmt.Errorf("failed to cleanup default storageclass addon: %w", err)
 			}
 		}
-		return nil
-	}
-
-	// We delete all resources with this label which are not in the combined manifest
-	selector := labels.SelectorFromSet(r.getAddonLabel(addon))
-	cmd, err := r.getApplyCommand(ctx, kubeconfigFilename, manifestFilename, selector, cluster.Status.Versions.ControlPlane)
-	if err != nil {
-		return fmt.Errorf("failed to create command: %w", err)
 	}
 
 	ver := r.versions.GitVersion
@@ -704,12 +598,33 @@ func (r *Reconciler) ensureIsInstalled(ctx context.Context, log *zap.SugaredLogg
 		}
 	}
 
-	cmdLog := log.With("cmd", strings.Join(cmd.Args, " "))
-	cmdLog.Debug("Applying manifest...")
-	out, err := cmd.CombinedOutput()
-	cmdLog.Debugw("Finished executing command", "output", string(out))
-	if err != nil {
-		return fmt.Errorf("failed to execute '%s' for addon %s of cluster %s: %w\n%s", strings.Join(cmd.Args, " "), addon.Name, cluster.Name, err, string(out))
+	applier := newApplier(log, userClusterClient, r.getAddonLabel(addon))
+
+	// Addons without an inventory have either never been installed or have been
+	// installed using kubectl by a previous KKP release.
+	previousInventory := toInventory(addon.Status.Inventory)
+	if len(previousInventory) == 0 {
+		applier.MigrateFieldManagers = kubectlFieldManagers
+	}
+
+	items, applyErr := applier.Apply(ctx, objects)
+	if applyErr != nil {
+		// do not prune anything as long as the addon is not fully applied, but remember
+		// all objects, so that they can be pruned later on
+		if err := r.updateInventory(ctx, addon, inventory.Merge(items, previousInventory)); err != nil {
+			return fmt.Errorf("failed to update inventory: %w", err)
+		}
+
+		return applyErr
+	}
+
+	// We delete all resources with the addon label which are not part of the manifests anymore
+	remaining, pruneErr := applier.Prune(ctx, previousInventory, items)
+	if err := r.updateInventory(ctx, addon, append(items, remaining...)); err != nil {
+		return fmt.Errorf("failed to update inventory: %w", err)
+	}
+	if pruneErr != nil {
+		return pruneErr
 	}
 
 	if lastSuccess.KubermaticVersion != ver {
@@ -739,38 +654,57 @@ func (r *Reconciler) ensureResourcesCreatedConditionIsSet(ctx context.Context, a
 
 	oldAddon := addon.DeepCopy()
 
-	r.setAddonCondition(addon, kubermaticv1.AddonResourcesCreated, corev1.ConditionTrue)
+	r.setAddonCondition(addon, kubermaticv1.AddonResourcesCreated, corev1.ConditionTrue, "", "")
 	addon.Status.Phase = getAddonPhase(addon)
 
 	return r.Status().Patch(ctx, addon, ctrlruntimeclient.MergeFrom(oldAddon))
 }
 
+func (r *Reconciler) updateInventory(ctx context.Context, addon *kubermaticv1.Addon, items []inventory.Item) error {
+	return util.UpdateAddonStatus(ctx, r, addon, func(a *kubermaticv1.Addon) {
+		a.Status.Inventory = fromInventory(items)
+	})
+}
+
 func (r *Reconciler) cleanupManifests(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) error {
 	if _, exists := r.addons[addon.Name]; !exists {
 		log.Debugf("cleanupManifests failed for addon %s/%s: addon manifest does not exist anymore", addon.Namespace, addon.Name)
 		return nil
 	}
 
-	kubeconfigFilename, manifestFilename, done, err := r.setupManifestInteraction(ctx, log, addon, cluster)
+	objects, err := r.renderManifests(ctx, log, addon, cluster)
 	if err != nil {
 		return err
 	}
-	defer done()
 
-	binary, err := kubectl.BinaryForClusterVersion(&cluster.Status.Versions.ControlPlane)
+	userClusterClient, err := r.kubeconfigProvider.GetClient(ctx, cluster)
 	if err != nil {
-		return fmt.Errorf("failed to determine kubectl binary to use: %w", err)
+		return fmt.Errorf("failed to get client for usercluster: %w", err)
 	}
 
-	cmd := exec.CommandContext(ctx, binary, "--kubeconfig", kubeconfigFilename, "delete", "-f", manifestFilename, "--ignore-not-found")
-	cmdLog := log.With("cmd", strings.Join(cmd.Args, " "))
+	applier := newApplier(log, userClusterClient, r.getAddonLabel(addon))
 
-	cmdLog.Debug("Deleting resources...")
-	out, err := cmd.CombinedOutput()
-	cmdLog.Debugw("Finished executing command", "output", string(out))
-	if err != nil {
-		return fmt.Errorf("failed to execute '%s' for addon %s of cluster %s: %w\n%s", strings.Join(cmd.Args, " "), addon.Name, cluster.Name, err, string(out))
+	// The rendered manifests might not reflect everything that has been installed (e.g. because
+	// the addon variables changed in the meantime), so the inventory is taken into account as well.
+	var rendered []inventory.Item
+	for _, obj := range objects {
+		if err := applier.NormalizeNamespace(obj); err != nil {
+			// the type is not served (anymore), so there is nothing to delete
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return fmt.Errorf("%s: %w", inventory.FormatObject(obj), err)
+		}
+
+		rendered = append(rendered, inventory.ItemForObject(obj))
+	}
+
+	log.Debug("Deleting resources...")
+	if err := applier.Delete(ctx, inventory.Merge(rendered, toInventory(addon.Status.Inventory))); err != nil {
+		return fmt.Errorf("failed to delete resources for addon %s of cluster %s: %w", addon.Name, cluster.Name, err)
 	}
+
 	if addon.Name == csiAddonName {
 		oldCluster := cluster.DeepCopy()
 		_, ok := cluster.Status.Conditions[kubermaticv1.ClusterConditionCSIAddonInUse]
@@ -817,7 +751,7 @@ func formatGVK(gvk kubermaticv1.GroupVersionKind) string {
 	return fmt.Sprintf("%s/%s %s", gvk.Group, gvk.Version, gvk.Kind)
 }
 
-func (r *Reconciler) setAddonCondition(a *kubermaticv1.Addon, condType kubermaticv1.AddonConditionType, status corev1.ConditionStatus) {
+func (r *Reconciler) setAddonCondition(a *kubermaticv1.Addon, condType kubermaticv1.AddonConditionType, status corev1.ConditionStatus, reason, message string) {
 	now := metav1.Now()
 
 	condition, exists := a.Status.Conditions[condType]
@@ -827,6 +761,8 @@ func (r *Reconciler) setAddonCondition(a *kubermaticv1.Addon, condType kubermati
 
 	condition.Status = status
 	condition.LastHeartbeatTime = now
+	condition.Reason = reason
+	condition.Message = message
 
 	if status == corev1.ConditionTrue {
 		condition.KubermaticVersion = r.versions.GitVersion
@@ -31,17 +31,15 @@ import (
 	"k8c.io/kubermatic/v2/pkg/addon"
 	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
 	"k8c.io/kubermatic/v2/pkg/cni"
-	"k8c.io/kubermatic/v2/pkg/defaulting"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/test/fake"
-	"k8c.io/kubermatic/v2/pkg/util/kubectl"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	kyaml "k8s.io/apimachinery/pkg/util/yaml"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
 var testManifests = []string{
@@ -120,34 +118,20 @@ spec:
 `
 )
 
-var (
-	// testManifest1 & testManifest3 have a linebreak at the end, testManifest2 not.
-	combinedTestManifest = fmt.Sprintf("%s---\n%s\n---\n%s", testManifests[0], testManifests[1], testManifests[2])
-)
-
-type fakeKubeconfigProvider struct{}
+type fakeKubeconfigProvider struct {
+	client ctrlruntimeclient.Client
+}
 
 func (f *fakeKubeconfigProvider) GetAdminKubeconfig(_ context.Context, c *kubermaticv1.Cluster) ([]byte, error) {
 	return []byte("foo"), nil
 }
 
 func (f *fakeKubeconfigProvider) GetClient(_ context.Context, c *kubermaticv1.Cluster, options ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
-	return nil, errors.New("not implemented")
-}
-
-func TestController_combineManifests(t *testing.T) {
-	controller := &Reconciler{}
-
-	var manifests []*bytes.Buffer
-	for _, m := range testManifests {
-		manifests = append(manifests, bytes.NewBufferString(m))
+	if f.client == nil {
+		return nil, errors.New("not implemented")
 	}
 
-	manifest := controller.combineManifests(manifests)
-
-	if manifest.String() != combinedTestManifest {
-		t.Fatalf("invalid combined manifest returned. Expected \n%s, Got \n%s", combinedTestManifest, manifest.String())
-	}
+	return f.client, nil
 }
 
 func setupTestCluster(cidrBlock string) *kubermaticv1.Cluster {
@@ -431,37 +415,17 @@ func TestController_ensureAddonLabelOnManifests(t *testing.T) {
 			Name: "test",
 		},
 	}
-	labeledManifests, err := controller.ensureAddonLabelOnManifests(context.Background(), nil, a, []runtime.RawExtension{manifest})
+	labeledObjects, err := controller.ensureAddonLabelOnManifests(context.Background(), nil, a, []runtime.RawExtension{manifest})
 	if err != nil {
 		t.Fatal(err)
 	}
-	if labeledManifests[0].String() != testManifest1WithLabel {
-		t.Fatalf("invalid labeled manifest returned. Expected \n%q, Got \n%q", testManifest1WithLabel, labeledManifests[0].String())
-	}
-}
-
-func TestController_getApplyCommand(t *testing.T) {
-	controller := &Reconciler{}
 
-	clusterVersion := defaulting.DefaultKubernetesVersioning.Default
-	if clusterVersion == nil {
-		t.Fatal("Should be able to determine default Kubernetes version, but got nil")
-	}
-
-	binary, err := kubectl.BinaryForClusterVersion(clusterVersion)
+	labeledManifest, err := yaml.Marshal(labeledObjects[0].Object)
 	if err != nil {
-		t.Fatalf("Should be able to determine a kubectl binary for %q, but got %v", clusterVersion, err)
-	}
-
-	cmd, err := controller.getApplyCommand(context.Background(), "/opt/kubeconfig", "/opt/manifest.yaml", labels.SelectorFromSet(map[string]string{"foo": "bar"}), *clusterVersion)
-	if err != nil {
-		t.Fatalf("Should be able to determine the command, but got %v", err)
+		t.Fatal(err)
 	}
-
-	expected := fmt.Sprintf("%s --kubeconfig /opt/kubeconfig apply --prune --filename /opt/manifest.yaml --selector foo=bar", binary)
-	got := strings.Join(cmd.Args, " ")
-	if got != expected {
-		t.Fatalf("invalid apply command returned. Expected \n%s, Got \n%s", expected, got)
+	if string(labeledManifest) != testManifest1WithLabel {
+		t.Fatalf("invalid labeled manifest returned. Expected \n%q, Got \n%q", testManifest1WithLabel, string(labeledManifest))
 	}
 }
 
@@ -480,7 +444,7 @@ func TestHugeManifest(t *testing.T) {
 		kubeconfigProvider: &fakeKubeconfigProvider{},
 		addons:             allAddons,
 	}
-	if _, _, _, err := r.setupManifestInteraction(context.Background(), log, testAddon, cluster); err != nil {
-		t.Fatalf("failed to setup manifest interaction: %v", err)
+	if _, err := r.renderManifests(context.Background(), log, testAddon, cluster); err != nil {
+		t.Fatalf("failed to render manifests: %v", err)
 	}
 }
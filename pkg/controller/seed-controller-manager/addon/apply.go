@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/util/inventory"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// fieldManager is the field manager used to server-side apply addon manifests.
+	fieldManager = "kubermatic-addon-controller"
+)
+
+// kubectlFieldManagers are the field managers used by `kubectl apply`, which
+// previous KKP releases shelled out to in order to install addons.
+var kubectlFieldManagers = sets.New("kubectl-client-side-apply", "kubectl")
+
+// newApplier returns an applier for the objects of an addon. Namespaced objects without
+// a namespace are applied into the default namespace, just like kubectl would do.
+func newApplier(log *zap.SugaredLogger, client ctrlruntimeclient.Client, addonLabels map[string]string) *inventory.Applier {
+	return &inventory.Applier{
+		Client:       client,
+		Log:          log,
+		FieldManager: fieldManager,
+		OwnerLabels:  addonLabels,
+	}
+}
+
+func toInventory(items []kubermaticv1.AddonInventoryItem) []inventory.Item {
+	var result []inventory.Item
+	for _, item := range items {
+		result = append(result, inventory.Item(item))
+	}
+
+	return result
+}
+
+func fromInventory(items []inventory.Item) []kubermaticv1.AddonInventoryItem {
+	var result []kubermaticv1.AddonInventoryItem
+	for _, item := range items {
+		result = append(result, kubermaticv1.AddonInventoryItem(item))
+	}
+
+	return result
+}
@@ -17,7 +17,8 @@ limitations under the License.
 /*
 Package addon contains a controller that applies addons based on a Addon CRD. It needs
 a folder per addon that contains all manifests, then adds a label to all objects and applies
-the addon via `kubectl apply --purge -l $added-label`, which result in all objects that
-do have the label but are not in the on-disk manifests being removed.
+them using server-side apply. All applied objects are recorded in the Addon's status, so
+that objects which are still labelled but are not part of the on-disk manifests anymore
+can be pruned. Errors for individual objects are reported in the Addon's conditions.
 */
 package addon
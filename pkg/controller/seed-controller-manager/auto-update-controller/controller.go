@@ -19,6 +19,8 @@ package autoupdatecontroller
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -55,6 +57,7 @@ type Reconciler struct {
 	userClusterConnectionProvider *client.Provider
 	log                           *zap.SugaredLogger
 	versions                      kubermatic.Versions
+	now                           func() time.Time
 }
 
 // Add creates a new auto-update controller.
@@ -76,6 +79,7 @@ func Add(
 		recorder:                      mgr.GetEventRecorderFor(ControllerName),
 		log:                           log,
 		versions:                      versions,
+		now:                           time.Now,
 	}
 
 	_, err := builder.ControllerManagedBy(mgr).
@@ -144,35 +148,90 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 
 	updateManager := version.NewFromConfiguration(config)
 
-	if err := r.controlPlaneUpgrade(ctx, log, cluster, updateManager); err != nil {
+	// Automatic updates are only applied while the cluster's update window is open; pending
+	// updates are picked up again once it opens.
+	windowOpen, wait, err := util.UpdateWindowOpen(cluster.Spec.UpdateWindow, r.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate update window: %w", err)
+	}
+
+	cpDeferred, err := r.controlPlaneUpgrade(ctx, log, cluster, updateManager, windowOpen)
+	if err != nil {
 		return nil, fmt.Errorf("failed to update the controlplane: %w", err)
 	}
 
 	// nodeUpdate works based on the Cluster.Status.Versions.ControlPlane field, so it properly waits
 	// for the control plane to be upgraded before updating the nodes.
-	if err := r.nodeUpdate(ctx, log, cluster, updateManager); err != nil {
+	nodesDeferred, err := r.nodeUpdate(ctx, log, cluster, updateManager, windowOpen)
+	if err != nil {
 		return nil, fmt.Errorf("failed to update the controlplane: %w", err)
 	}
 
+	deferred := nodesDeferred
+	if cpDeferred != "" {
+		deferred = append([]string{cpDeferred}, deferred...)
+	}
+
+	if err := r.setDeferredUpdatesCondition(ctx, cluster, deferred); err != nil {
+		return nil, fmt.Errorf("failed to update cluster status: %w", err)
+	}
+
+	if len(deferred) > 0 {
+		return &reconcile.Result{RequeueAfter: wait}, nil
+	}
+
 	return nil, nil
 }
 
-func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager) error {
+// setDeferredUpdatesCondition records the automatic updates that wait for the update window
+// in the cluster status. The AutoUpdateDeferred event is only emitted when the deferred
+// updates change, not on every reconciliation.
+func (r *Reconciler) setDeferredUpdatesCondition(ctx context.Context, cluster *kubermaticv1.Cluster, deferred []string) error {
+	condition, hasCondition := cluster.Status.Conditions[kubermaticv1.ClusterConditionAutomaticUpdatesApplied]
+
+	if len(deferred) == 0 {
+		// clusters without an update window never get the condition
+		if !hasCondition || condition.Status == corev1.ConditionTrue {
+			return nil
+		}
+
+		return util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+			util.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionAutomaticUpdatesApplied, corev1.ConditionTrue, kubermaticv1.ReasonAutomaticUpdatesApplied, "")
+		})
+	}
+
+	message := fmt.Sprintf("%s deferred until the update window opens.", strings.Join(deferred, ", "))
+	if condition.Status == corev1.ConditionFalse && condition.Message == message {
+		return nil
+	}
+
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "AutoUpdateDeferred", message)
+
+	return util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		util.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionAutomaticUpdatesApplied, corev1.ConditionFalse, kubermaticv1.ReasonWaitingForUpdateWindow, message)
+	})
+}
+
+// nodeUpdate applies automatic updates to MachineDeployments. If updates are available
+// but the update window is closed, no changes are made and the deferred updates are returned.
+func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager, windowOpen bool) ([]string, error) {
 	c, err := r.userClusterConnectionProvider.GetClient(ctx, cluster)
 	if err != nil {
-		return fmt.Errorf("failed to get usercluster client: %w", err)
+		return nil, fmt.Errorf("failed to get usercluster client: %w", err)
 	}
 
 	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
 	// Kubermatic only creates MachineDeployments in the kube-system namespace, everything else is essentially unsupported
 	if err := c.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
-		return fmt.Errorf("failed to list MachineDeployments: %w", err)
+		return nil, fmt.Errorf("failed to list MachineDeployments: %w", err)
 	}
 
+	var deferred []string
+
 	for _, md := range machineDeployments.Items {
 		targetVersion, err := updateManager.AutomaticNodeUpdate(md.Spec.Template.Spec.Versions.Kubelet, cluster.Status.Versions.ControlPlane.String())
 		if err != nil {
-			return fmt.Errorf("failed to get automatic update for machinedeployment %s/%s that has version %q: %w", md.Namespace, md.Name, md.Spec.Template.Spec.Versions.Kubelet, err)
+			return nil, fmt.Errorf("failed to get automatic update for machinedeployment %s/%s that has version %q: %w", md.Namespace, md.Name, md.Spec.Template.Spec.Versions.Kubelet, err)
 		}
 		if targetVersion == nil {
 			continue
@@ -185,33 +244,48 @@ func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, clu
 			oldMD := md.DeepCopy()
 			identifier := fmt.Sprintf("%s/%s", md.Namespace, md.Name)
 
+			if !windowOpen {
+				log.Debugw("Deferring automatic update of MachineDeployment until the update window opens", "machinedeployment", identifier, "from", old, "to", target)
+				deferred = append(deferred, fmt.Sprintf("Automatic update of MachineDeployment %s to version %q", identifier, target))
+				continue
+			}
+
 			log.Infow("Applying automatic update to MachineDeployment", "machinedeployment", identifier, "from", old, "to", target)
 
 			md.Spec.Template.Spec.Versions.Kubelet = target
 			if err := c.Patch(ctx, &md, ctrlruntimeclient.MergeFrom(oldMD)); err != nil {
-				return fmt.Errorf("failed to update MachineDeployment: %w", err)
+				return nil, fmt.Errorf("failed to update MachineDeployment: %w", err)
 			}
 
 			r.recorder.Eventf(cluster, corev1.EventTypeNormal, "AutoUpdateMachineDeployment", "Triggered automatic update of MachineDeployment %s to version %q", identifier, target)
 		}
 	}
 
-	return nil
+	return deferred, nil
 }
 
-func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager) error {
+// controlPlaneUpgrade applies automatic updates to the cluster version. If an update
+// is available but the update window is closed, no changes are made and the deferred
+// update is returned.
+func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager, windowOpen bool) (string, error) {
 	update, err := updateManager.AutomaticControlplaneUpdate(cluster.Spec.Version.String())
 	if err != nil {
-		return fmt.Errorf("failed to get automatic update for cluster for version %s: %w", cluster.Spec.Version.String(), err)
+		return "", fmt.Errorf("failed to get automatic update for cluster for version %s: %w", cluster.Spec.Version.String(), err)
 	}
 	if update == nil {
-		return nil
+		return "", nil
 	}
 	oldCluster := cluster.DeepCopy()
 
 	sver, err := semver.NewSemver(update.Version.String())
 	if err != nil {
-		return fmt.Errorf("failed to parse version %q: %w", update.Version.String(), err)
+		return "", fmt.Errorf("failed to parse version %q: %w", update.Version.String(), err)
+	}
+
+	if !windowOpen {
+		log.Debugw("Deferring automatic control-plane upgrade until the update window opens", "from", cluster.Spec.Version, "to", sver)
+
+		return fmt.Sprintf("Automatic update from v%s to v%s", cluster.Spec.Version, sver), nil
 	}
 
 	log.Infow("Applying automatic control-plane upgrade", "from", oldCluster.Spec.Version, "to", cluster.Spec.Version)
@@ -221,7 +295,7 @@ func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLo
 	// set here.
 	cluster.Spec.Version = *sver
 	if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
-		return fmt.Errorf("failed to update cluster: %w", err)
+		return "", fmt.Errorf("failed to update cluster: %w", err)
 	}
 
 	log.Infow("Applied automatic cluster upgrade", "from", oldCluster.Spec.Version, "to", cluster.Spec.Version)
@@ -234,8 +308,8 @@ func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLo
 		c.Status.ExtendedHealth.Scheduler = kubermaticv1.HealthStatusDown
 	})
 	if err != nil {
-		return fmt.Errorf("failed to update cluster status: %w", err)
+		return "", fmt.Errorf("failed to update cluster status: %w", err)
 	}
 
-	return nil
+	return "", nil
 }
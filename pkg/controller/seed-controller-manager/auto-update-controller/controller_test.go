@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoupdatecontroller
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestSetDeferredUpdatesCondition(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "testcluster"},
+	}
+
+	client := fake.NewClientBuilder().WithObjects(cluster).Build()
+	recorder := record.NewFakeRecorder(10)
+
+	r := &Reconciler{
+		Client:   client,
+		recorder: recorder,
+		log:      zap.NewNop().Sugar(),
+		versions: kubermatic.GetFakeVersions(),
+	}
+
+	steps := []struct {
+		name           string
+		deferred       []string
+		expectedStatus corev1.ConditionStatus
+		expectedEvent  bool
+	}{
+		{
+			name:     "no condition without deferred updates",
+			deferred: nil,
+		},
+		{
+			name:           "deferring an update emits an event",
+			deferred:       []string{"Automatic update from v1.33.0 to v1.33.1"},
+			expectedStatus: corev1.ConditionFalse,
+			expectedEvent:  true,
+		},
+		{
+			name:           "deferring the same update again does not emit an event",
+			deferred:       []string{"Automatic update from v1.33.0 to v1.33.1"},
+			expectedStatus: corev1.ConditionFalse,
+		},
+		{
+			name:           "deferring another update emits an event",
+			deferred:       []string{"Automatic update from v1.33.0 to v1.33.2"},
+			expectedStatus: corev1.ConditionFalse,
+			expectedEvent:  true,
+		},
+		{
+			name:           "applied updates reset the condition",
+			deferred:       nil,
+			expectedStatus: corev1.ConditionTrue,
+		},
+	}
+
+	for _, step := range steps {
+		current := &kubermaticv1.Cluster{}
+		if err := client.Get(ctx, types.NamespacedName{Name: cluster.Name}, current); err != nil {
+			t.Fatalf("%s: failed to get cluster: %v", step.name, err)
+		}
+
+		if err := r.setDeferredUpdatesCondition(ctx, current, step.deferred); err != nil {
+			t.Fatalf("%s: failed to set condition: %v", step.name, err)
+		}
+
+		condition := current.Status.Conditions[kubermaticv1.ClusterConditionAutomaticUpdatesApplied]
+		if condition.Status != step.expectedStatus {
+			t.Errorf("%s: expected condition status %q, but got %q", step.name, step.expectedStatus, condition.Status)
+		}
+
+		select {
+		case event := <-recorder.Events:
+			if !step.expectedEvent {
+				t.Errorf("%s: expected no event, but got %q", step.name, event)
+			}
+		default:
+			if step.expectedEvent {
+				t.Errorf("%s: expected an event, but got none", step.name)
+			}
+		}
+	}
+}
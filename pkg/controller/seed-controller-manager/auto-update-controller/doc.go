@@ -19,6 +19,7 @@ Package autoupdatecontroller contains a controller that auto applies updates to
 the cluster version and potentially to the MachineDeployments inside the usercluster.
 It will not itself reconcile any control plane components, this task is handled by
 other controllers that properly handle the version skew policy and are smart enough
-to update step-by-step.
+to update step-by-step. Updates are deferred while the cluster's update window is
+closed.
 */
 package autoupdatecontroller
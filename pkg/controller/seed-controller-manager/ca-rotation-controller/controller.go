@@ -0,0 +1,515 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carotationcontroller
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	k8cuserclusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "kkp-ca-rotation-controller"
+
+	// MachineDeploymentRotationAnnotation is set on the machine template of every MachineDeployment
+	// in order to replace all nodes, so that they pick up the current trust bundle and kubelet
+	// certificates signed by the current CA.
+	MachineDeploymentRotationAnnotation = "kubermatic.k8c.io/ca-rotation"
+
+	// requeueInterval is used while waiting for control plane components or nodes to be rolled out.
+	requeueInterval = 30 * time.Second
+)
+
+// userClusterConnectionProvider offers functions to retrieve clients for the given user clusters.
+type userClusterConnectionProvider interface {
+	GetClient(context.Context, *kubermaticv1.Cluster, ...k8cuserclusterclient.ConfigOption) (ctrlruntimeclient.Client, error)
+}
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	log                     *zap.SugaredLogger
+	userClusterConnProvider userClusterConnectionProvider
+	workerName              string
+	recorder                record.EventRecorder
+	versions                kubermatic.Versions
+	now                     func() time.Time
+}
+
+func Add(
+	mgr manager.Manager,
+	log *zap.SugaredLogger,
+	numWorkers int,
+	workerName string,
+	userClusterConnProvider userClusterConnectionProvider,
+	versions kubermatic.Versions,
+) error {
+	reconciler := &Reconciler{
+		Client:                  mgr.GetClient(),
+		log:                     log.Named(ControllerName),
+		userClusterConnProvider: userClusterConnProvider,
+		workerName:              workerName,
+		recorder:                mgr.GetEventRecorderFor(ControllerName),
+		versions:                versions,
+		now:                     time.Now,
+	}
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		For(&kubermaticv1.Cluster{}, builder.WithPredicates(predicateutil.Factory(func(o ctrlruntimeclient.Object) bool {
+			cluster := o.(*kubermaticv1.Cluster)
+			return rotationRequested(cluster) || cluster.IsCARotationInProgress()
+		}))).
+		Watches(&corev1.Secret{}, controllerutil.EnqueueClusterForNamespacedObject(mgr.GetClient()), builder.WithPredicates(predicateutil.ByName(resources.CASecretName))).
+		Build(reconciler)
+
+	return err
+}
+
+func rotationRequested(cluster *kubermaticv1.Cluster) bool {
+	_, requested := cluster.Annotations[kubermaticv1.RotateCAAnnotation]
+	return requested
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("cluster", request.Name)
+	log.Debug("Reconciling")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Could not find cluster")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	// replicate the predicate from above, as the CA secret watch enqueues all clusters
+	if !rotationRequested(cluster) && !cluster.IsCARotationInProgress() {
+		return reconcile.Result{}, nil
+	}
+
+	// Add a wrapping here so we can emit an event on error
+	result, err := controllerutil.ClusterReconcileWrapper(
+		ctx,
+		r,
+		r.workerName,
+		cluster,
+		r.versions,
+		kubermaticv1.ClusterConditionNone,
+		func() (*reconcile.Result, error) {
+			return r.reconcile(ctx, log, cluster)
+		},
+	)
+
+	if result == nil || err != nil {
+		result = &reconcile.Result{}
+	}
+
+	if err != nil {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "ReconcilingError", err.Error())
+	}
+
+	return *result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	if rotationRequested(cluster) {
+		if !cluster.IsCARotationInProgress() {
+			return r.startRotation(ctx, log, cluster)
+		}
+
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "CARotationInProgress", "Ignoring CA rotation request, because another rotation is still in progress.")
+		if err := r.removeAnnotation(ctx, cluster); err != nil {
+			return nil, fmt.Errorf("failed to remove CA rotation annotation: %w", err)
+		}
+	}
+
+	if !cluster.IsCARotationInProgress() {
+		return nil, nil
+	}
+
+	caSecret, err := r.getCASecret(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	switch phase := cluster.Status.CARotation.Phase; phase {
+	case kubermaticv1.ClusterCARotationPhaseTrustNewCA:
+		return r.advance(ctx, log, cluster, caSecret, true, promoteNextCA, kubermaticv1.ClusterCARotationPhaseSignWithNewCA,
+			"All certificates are being re-issued by the new CA")
+
+	case kubermaticv1.ClusterCARotationPhaseSignWithNewCA:
+		return r.advance(ctx, log, cluster, caSecret, true, removePreviousCAs, kubermaticv1.ClusterCARotationPhaseRemoveOldCA,
+			"The old CA is being removed from all trust bundles")
+
+	case kubermaticv1.ClusterCARotationPhaseRemoveOldCA:
+		return r.advance(ctx, log, cluster, caSecret, false, nil, kubermaticv1.ClusterCARotationPhaseCompleted,
+			"The cluster CA has been rotated")
+
+	default:
+		return nil, fmt.Errorf("unknown CA rotation phase %q", phase)
+	}
+}
+
+// startRotation creates the new CA and adds it to the trust bundle.
+func (r *Reconciler) startRotation(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	if !cluster.Status.ExtendedHealth.ControlPlaneHealthy() {
+		log.Debug("Control plane is not healthy, delaying CA rotation")
+		return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+	}
+
+	caSecret, err := r.getCASecret(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// a previous attempt might have failed after the secret was already updated
+	if !hasNextCA(caSecret) {
+		now := r.now().UTC()
+
+		next, err := triple.NewCA(fmt.Sprintf("root-ca.%s.%s", cluster.Status.Address.ExternalName, now.Format("20060102150405")))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new CA: %w", err)
+		}
+
+		oldSecret := caSecret.DeepCopy()
+		if err := addNextCA(caSecret, next); err != nil {
+			return nil, err
+		}
+
+		if err := r.Patch(ctx, caSecret, ctrlruntimeclient.MergeFrom(oldSecret)); err != nil {
+			return nil, fmt.Errorf("failed to add new CA to CA secret: %w", err)
+		}
+	}
+
+	log.Info("Starting CA rotation")
+
+	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.CARotation = &kubermaticv1.ClusterCARotationStatus{
+			Phase:     kubermaticv1.ClusterCARotationPhaseTrustNewCA,
+			StartedAt: metav1.NewTime(r.now()),
+		}
+
+		controllerutil.SetClusterCondition(
+			c,
+			r.versions,
+			kubermaticv1.ClusterConditionCARotated,
+			corev1.ConditionFalse,
+			kubermaticv1.ReasonCARotationInProgress,
+			"The new CA is being added to all trust bundles",
+		)
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update cluster status: %w", err)
+	}
+
+	if err := r.removeAnnotation(ctx, cluster); err != nil {
+		return nil, fmt.Errorf("failed to remove CA rotation annotation: %w", err)
+	}
+
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "CARotationStarted", "Started rotating the cluster CA.")
+
+	return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+}
+
+// advance waits for the control plane (and optionally all nodes) to pick up the current state of
+// the CA secret, then applies the transition to the secret and moves the rotation into the next phase.
+func (r *Reconciler) advance(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	cluster *kubermaticv1.Cluster,
+	caSecret *corev1.Secret,
+	rollNodes bool,
+	transition func(*corev1.Secret) error,
+	nextPhase kubermaticv1.ClusterCARotationPhase,
+	message string,
+) (*reconcile.Result, error) {
+	upToDate, err := r.controlPlaneUpToDate(ctx, cluster, caSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check control plane: %w", err)
+	}
+
+	if !upToDate {
+		log.Debug("Waiting for control plane to be rolled out")
+		return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+	}
+
+	if rollNodes {
+		rolledOut, err := r.rollNodes(ctx, log, cluster)
+		if err != nil {
+			return nil, fmt.Errorf("failed to roll nodes: %w", err)
+		}
+
+		if !rolledOut {
+			log.Debug("Waiting for nodes to be rolled out")
+			return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+		}
+	}
+
+	if transition != nil {
+		oldSecret := caSecret.DeepCopy()
+		if err := transition(caSecret); err != nil {
+			return nil, err
+		}
+
+		if !reflect.DeepEqual(oldSecret.Data, caSecret.Data) {
+			if err := r.Patch(ctx, caSecret, ctrlruntimeclient.MergeFrom(oldSecret)); err != nil {
+				return nil, fmt.Errorf("failed to update CA secret: %w", err)
+			}
+		}
+	}
+
+	log.Infow("CA rotation progressed", "phase", nextPhase)
+
+	completed := nextPhase == kubermaticv1.ClusterCARotationPhaseCompleted
+
+	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.CARotation.Phase = nextPhase
+
+		status := corev1.ConditionFalse
+		reason := kubermaticv1.ReasonCARotationInProgress
+
+		if completed {
+			c.Status.CARotation.CompletedAt = &metav1.Time{Time: r.now()}
+			status = corev1.ConditionTrue
+			reason = kubermaticv1.ReasonCARotationCompleted
+		}
+
+		controllerutil.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionCARotated, status, reason, message)
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update cluster status: %w", err)
+	}
+
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, "CARotationProgressed", "CA rotation entered phase %s.", nextPhase)
+
+	if completed {
+		return nil, nil
+	}
+
+	return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+}
+
+// controlPlaneUpToDate returns true once the apiserver and etcd serve certificates issued
+// by the current signing CA and all control plane components mounting the CA secret have
+// been rolled out with its current revision.
+func (r *Reconciler) controlPlaneUpToDate(ctx context.Context, cluster *kubermaticv1.Cluster, caSecret *corev1.Secret) (bool, error) {
+	if !cluster.Status.ExtendedHealth.ControlPlaneHealthy() {
+		return false, nil
+	}
+
+	certs, err := caCertificates(caSecret)
+	if err != nil {
+		return false, err
+	}
+
+	if len(certs) == 0 {
+		return false, fmt.Errorf("CA secret contains no certificates")
+	}
+
+	namespace := cluster.Status.NamespaceName
+
+	tlsSecret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.ApiserverTLSSecretName}, tlsSecret); err != nil {
+		return false, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if !isSignedBy(tlsSecret.Data[resources.ApiserverTLSCertSecretKey], certs[0]) {
+		return false, nil
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		return false, fmt.Errorf("failed to list Deployments: %w", err)
+	}
+
+	for i := range deployments.Items {
+		if !deploymentUpToDate(&deployments.Items[i], caSecret.ResourceVersion) {
+			return false, nil
+		}
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := r.List(ctx, statefulSets, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		return false, fmt.Errorf("failed to list StatefulSets: %w", err)
+	}
+
+	for i := range statefulSets.Items {
+		if !statefulSetUpToDate(&statefulSets.Items[i], caSecret.ResourceVersion) {
+			return false, nil
+		}
+	}
+
+	return r.etcdUpToDate(ctx, namespace, certs[0])
+}
+
+// etcdUpToDate returns true once all etcd members serve a certificate issued by the signing CA.
+// The apiserver only trusts the CA bundle, so the old CA must not be removed from it before
+// the slower etcd StatefulSet has been rolled out with re-issued certificates.
+func (r *Reconciler) etcdUpToDate(ctx context.Context, namespace string, signingCA *x509.Certificate) (bool, error) {
+	tlsSecret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.EtcdTLSCertificateSecretName}, tlsSecret); err != nil {
+		return false, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if !isSignedBy(tlsSecret.Data[resources.EtcdTLSCertSecretKey], signingCA) {
+		return false, nil
+	}
+
+	statefulSet := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.EtcdStatefulSetName}, statefulSet); err != nil {
+		return false, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	return statefulSetRolledOut(statefulSet, etcdTLSSecretRevisionLabel, tlsSecret.ResourceVersion), nil
+}
+
+// rollNodes replaces all nodes managed by MachineDeployments once the bootstrap configuration
+// in the user cluster trusts the current CA bundle. It returns true when all MachineDeployments
+// have been rolled out for the current phase.
+func (r *Reconciler) rollNodes(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (bool, error) {
+	userClusterClient, err := r.userClusterConnProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user cluster client: %w", err)
+	}
+
+	caBundle, err := resources.GetClusterRootCABundle(ctx, cluster.Status.NamespaceName, r)
+	if err != nil {
+		return false, err
+	}
+
+	// new nodes are bootstrapped using the cluster-info ConfigMap, which is maintained
+	// by the user cluster controller manager
+	trusted, err := clusterInfoTrusts(ctx, userClusterClient, caBundle)
+	if err != nil {
+		return false, err
+	}
+
+	if !trusted {
+		log.Debug("cluster-info ConfigMap does not contain the current CA bundle yet")
+		return false, nil
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	// Kubermatic only creates MachineDeployments in the kube-system namespace, everything else is essentially unsupported
+	if err := userClusterClient.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return false, fmt.Errorf("failed to list MachineDeployments: %w", err)
+	}
+
+	revision := rotationRevision(cluster.Status.CARotation)
+	rolledOut := true
+
+	for i := range machineDeployments.Items {
+		md := &machineDeployments.Items[i]
+
+		if md.Spec.Template.Annotations[MachineDeploymentRotationAnnotation] != revision {
+			oldMD := md.DeepCopy()
+			if md.Spec.Template.Annotations == nil {
+				md.Spec.Template.Annotations = map[string]string{}
+			}
+			md.Spec.Template.Annotations[MachineDeploymentRotationAnnotation] = revision
+
+			if err := userClusterClient.Patch(ctx, md, ctrlruntimeclient.MergeFrom(oldMD)); err != nil {
+				return false, fmt.Errorf("failed to update MachineDeployment %s: %w", md.Name, err)
+			}
+
+			log.Infow("Rolling MachineDeployment for CA rotation", "machinedeployment", md.Name)
+			rolledOut = false
+
+			continue
+		}
+
+		if !machineDeploymentRolledOut(md) {
+			rolledOut = false
+		}
+	}
+
+	return rolledOut, nil
+}
+
+// rotationRevision identifies a phase of a CA rotation; it changes whenever nodes need to be replaced.
+func rotationRevision(status *kubermaticv1.ClusterCARotationStatus) string {
+	return fmt.Sprintf("%d-%s", status.StartedAt.Unix(), status.Phase)
+}
+
+func clusterInfoTrusts(ctx context.Context, client ctrlruntimeclient.Client, caBundle []byte) (bool, error) {
+	cm := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespacePublic, Name: resources.ClusterInfoConfigMapName}, cm); err != nil {
+		return false, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	kubeconfig, err := clientcmd.Load([]byte(cm.Data["kubeconfig"]))
+	if err != nil {
+		return false, fmt.Errorf("failed to parse cluster-info kubeconfig: %w", err)
+	}
+
+	for _, c := range kubeconfig.Clusters {
+		if !bytes.Equal(c.CertificateAuthorityData, caBundle) {
+			return false, nil
+		}
+	}
+
+	return len(kubeconfig.Clusters) > 0, nil
+}
+
+func (r *Reconciler) getCASecret(ctx context.Context, cluster *kubermaticv1.Cluster) (*corev1.Secret, error) {
+	caSecret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.CASecretName}, caSecret); err != nil {
+		return nil, fmt.Errorf("failed to get CA secret: %w", err)
+	}
+
+	return caSecret, nil
+}
+
+func (r *Reconciler) removeAnnotation(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	oldCluster := cluster.DeepCopy()
+	delete(cluster.Annotations, kubermaticv1.RotateCAAnnotation)
+	return r.Patch(ctx, cluster, ctrlruntimeclient.MergeFromWithOptions(oldCluster, ctrlruntimeclient.MergeFromWithOptimisticLock{}))
+}
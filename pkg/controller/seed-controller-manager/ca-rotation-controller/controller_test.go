@@ -0,0 +1,528 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carotationcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	clusterName      = "testcluster"
+	clusterNamespace = "cluster-testcluster"
+)
+
+var testScheme = fake.NewScheme()
+
+func init() {
+	utilruntime.Must(clusterv1alpha1.AddToScheme(testScheme))
+}
+
+type fakeClientProvider struct {
+	client ctrlruntimeclient.Client
+}
+
+func (f *fakeClientProvider) GetClient(ctx context.Context, c *kubermaticv1.Cluster, options ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+	return f.client, nil
+}
+
+func genCluster() *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Annotations: map[string]string{
+				kubermaticv1.RotateCAAnnotation: "",
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: clusterNamespace,
+			Address: kubermaticv1.ClusterAddress{
+				ExternalName: "testcluster.example.com",
+			},
+			ExtendedHealth: kubermaticv1.ExtendedClusterHealth{
+				Apiserver:  kubermaticv1.HealthStatusUp,
+				Scheduler:  kubermaticv1.HealthStatusUp,
+				Controller: kubermaticv1.HealthStatusUp,
+				Etcd:       kubermaticv1.HealthStatusUp,
+			},
+		},
+	}
+}
+
+type testEnv struct {
+	ctx        context.Context
+	t          *testing.T
+	reconciler *Reconciler
+	seedClient ctrlruntimeclient.Client
+	userClient ctrlruntimeclient.Client
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	ca, err := triple.NewCA("root-ca.testcluster.example.com")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.CASecretName,
+			Namespace: clusterNamespace,
+		},
+		Data: map[string][]byte{
+			resources.CACertSecretKey:       triple.EncodeCertPEM(ca.Cert),
+			resources.CACertBundleSecretKey: triple.EncodeCertPEM(ca.Cert),
+			resources.CAKeySecretKey:        triple.EncodePrivateKeyPEM(ca.Key),
+		},
+	}
+
+	apiserver := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.ApiserverDeploymentName,
+			Namespace: clusterNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+		},
+	}
+
+	etcd := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.EtcdStatefulSetName,
+			Namespace: clusterNamespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](3),
+		},
+	}
+
+	md := &clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "workers",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: clusterv1alpha1.MachineDeploymentSpec{
+			Replicas: ptr.To[int32](2),
+		},
+	}
+
+	env := &testEnv{
+		ctx: context.Background(),
+		t:   t,
+		seedClient: fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithObjects(genCluster(), caSecret, apiserver, etcd).
+			Build(),
+		userClient: fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithObjects(md).
+			Build(),
+	}
+
+	env.reconciler = &Reconciler{
+		Client:                  env.seedClient,
+		log:                     zap.NewNop().Sugar(),
+		userClusterConnProvider: &fakeClientProvider{client: env.userClient},
+		recorder:                &record.FakeRecorder{},
+		versions:                kubermatic.GetFakeVersions(),
+		now:                     time.Now,
+	}
+
+	env.rollOutControlPlane()
+
+	return env
+}
+
+func (e *testEnv) reconcile() {
+	e.t.Helper()
+
+	if _, err := e.reconciler.Reconcile(e.ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterName}}); err != nil {
+		e.t.Fatalf("Reconciling failed: %v", err)
+	}
+}
+
+func (e *testEnv) cluster() *kubermaticv1.Cluster {
+	e.t.Helper()
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := e.seedClient.Get(e.ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+		e.t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	return cluster
+}
+
+func (e *testEnv) caSecret() *corev1.Secret {
+	e.t.Helper()
+
+	secret := &corev1.Secret{}
+	if err := e.seedClient.Get(e.ctx, types.NamespacedName{Namespace: clusterNamespace, Name: resources.CASecretName}, secret); err != nil {
+		e.t.Fatalf("Failed to get CA secret: %v", err)
+	}
+
+	return secret
+}
+
+func (e *testEnv) machineDeployment() *clusterv1alpha1.MachineDeployment {
+	e.t.Helper()
+
+	md := &clusterv1alpha1.MachineDeployment{}
+	if err := e.userClient.Get(e.ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "workers"}, md); err != nil {
+		e.t.Fatalf("Failed to get MachineDeployment: %v", err)
+	}
+
+	return md
+}
+
+// rollOutControlPlane mimics the kubernetes controller, which re-issues leaf certificates
+// and rolls out all control plane components after the CA secret has changed.
+func (e *testEnv) rollOutControlPlane() {
+	e.t.Helper()
+
+	ca, err := resources.GetClusterRootCA(e.ctx, clusterNamespace, e.seedClient)
+	if err != nil {
+		e.t.Fatalf("Failed to get CA: %v", err)
+	}
+
+	serving, err := triple.NewServerKeyPair(ca, "apiserver", "apiserver", clusterNamespace, "cluster.local", nil, nil)
+	if err != nil {
+		e.t.Fatalf("Failed to create serving certificate: %v", err)
+	}
+
+	tlsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.ApiserverTLSSecretName,
+			Namespace: clusterNamespace,
+		},
+		Data: map[string][]byte{
+			resources.ApiserverTLSCertSecretKey: triple.EncodeCertPEM(serving.Cert),
+		},
+	}
+
+	if err := e.seedClient.Delete(e.ctx, tlsSecret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		e.t.Fatalf("Failed to delete apiserver TLS secret: %v", err)
+	}
+	if err := e.seedClient.Create(e.ctx, tlsSecret); err != nil {
+		e.t.Fatalf("Failed to create apiserver TLS secret: %v", err)
+	}
+
+	apiserver := &appsv1.Deployment{}
+	if err := e.seedClient.Get(e.ctx, types.NamespacedName{Namespace: clusterNamespace, Name: resources.ApiserverDeploymentName}, apiserver); err != nil {
+		e.t.Fatalf("Failed to get apiserver Deployment: %v", err)
+	}
+
+	apiserver.Spec.Template.Labels = map[string]string{caSecretRevisionLabel: e.caSecret().ResourceVersion}
+	if err := e.seedClient.Update(e.ctx, apiserver); err != nil {
+		e.t.Fatalf("Failed to update apiserver Deployment: %v", err)
+	}
+
+	apiserver.Status.Replicas = 1
+	apiserver.Status.UpdatedReplicas = 1
+	apiserver.Status.AvailableReplicas = 1
+	if err := e.seedClient.Status().Update(e.ctx, apiserver); err != nil {
+		e.t.Fatalf("Failed to update apiserver Deployment status: %v", err)
+	}
+
+	e.reissueEtcdCertificate(ca)
+	e.rollOutEtcd()
+}
+
+// reissueEtcdCertificate mimics the kubernetes controller, which re-issues the etcd
+// serving certificate if it has not been issued by the signing CA.
+func (e *testEnv) reissueEtcdCertificate(ca *triple.KeyPair) {
+	e.t.Helper()
+
+	serving, err := triple.NewServerKeyPair(ca, "etcd", "etcd", clusterNamespace, "cluster.local", nil, nil)
+	if err != nil {
+		e.t.Fatalf("Failed to create etcd serving certificate: %v", err)
+	}
+
+	tlsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.EtcdTLSCertificateSecretName,
+			Namespace: clusterNamespace,
+		},
+		Data: map[string][]byte{
+			resources.EtcdTLSCertSecretKey: triple.EncodeCertPEM(serving.Cert),
+		},
+	}
+
+	if err := e.seedClient.Delete(e.ctx, tlsSecret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		e.t.Fatalf("Failed to delete etcd TLS secret: %v", err)
+	}
+	if err := e.seedClient.Create(e.ctx, tlsSecret); err != nil {
+		e.t.Fatalf("Failed to create etcd TLS secret: %v", err)
+	}
+}
+
+// rollOutEtcd mimics the StatefulSet controller rolling out etcd with the current Secrets.
+func (e *testEnv) rollOutEtcd() {
+	e.t.Helper()
+
+	tlsSecret := &corev1.Secret{}
+	if err := e.seedClient.Get(e.ctx, types.NamespacedName{Namespace: clusterNamespace, Name: resources.EtcdTLSCertificateSecretName}, tlsSecret); err != nil {
+		e.t.Fatalf("Failed to get etcd TLS secret: %v", err)
+	}
+
+	etcd := e.etcdStatefulSet()
+	etcd.Spec.Template.Labels = map[string]string{
+		caSecretRevisionLabel:      e.caSecret().ResourceVersion,
+		etcdTLSSecretRevisionLabel: tlsSecret.ResourceVersion,
+	}
+	if err := e.seedClient.Update(e.ctx, etcd); err != nil {
+		e.t.Fatalf("Failed to update etcd StatefulSet: %v", err)
+	}
+
+	etcd.Status.Replicas = 3
+	etcd.Status.UpdatedReplicas = 3
+	etcd.Status.ReadyReplicas = 3
+	if err := e.seedClient.Status().Update(e.ctx, etcd); err != nil {
+		e.t.Fatalf("Failed to update etcd StatefulSet status: %v", err)
+	}
+}
+
+func (e *testEnv) etcdStatefulSet() *appsv1.StatefulSet {
+	e.t.Helper()
+
+	etcd := &appsv1.StatefulSet{}
+	if err := e.seedClient.Get(e.ctx, types.NamespacedName{Namespace: clusterNamespace, Name: resources.EtcdStatefulSetName}, etcd); err != nil {
+		e.t.Fatalf("Failed to get etcd StatefulSet: %v", err)
+	}
+
+	return etcd
+}
+
+// updateClusterInfo mimics the user cluster controller manager.
+func (e *testEnv) updateClusterInfo() {
+	e.t.Helper()
+
+	caBundle, err := resources.GetClusterRootCABundle(e.ctx, clusterNamespace, e.seedClient)
+	if err != nil {
+		e.t.Fatalf("Failed to get CA bundle: %v", err)
+	}
+
+	kubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"": {
+				Server:                   "https://testcluster.example.com",
+				CertificateAuthorityData: caBundle,
+			},
+		},
+	})
+	if err != nil {
+		e.t.Fatalf("Failed to encode kubeconfig: %v", err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.ClusterInfoConfigMapName,
+			Namespace: metav1.NamespacePublic,
+		},
+		Data: map[string]string{"kubeconfig": string(kubeconfig)},
+	}
+
+	if err := e.userClient.Delete(e.ctx, cm); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		e.t.Fatalf("Failed to delete cluster-info ConfigMap: %v", err)
+	}
+	if err := e.userClient.Create(e.ctx, cm); err != nil {
+		e.t.Fatalf("Failed to create cluster-info ConfigMap: %v", err)
+	}
+}
+
+// rollOutNodes mimics the machine-controller.
+func (e *testEnv) rollOutNodes() {
+	e.t.Helper()
+
+	md := e.machineDeployment()
+	md.Status.Replicas = 2
+	md.Status.UpdatedReplicas = 2
+	md.Status.AvailableReplicas = 2
+
+	if err := e.userClient.Update(e.ctx, md); err != nil {
+		e.t.Fatalf("Failed to update MachineDeployment: %v", err)
+	}
+}
+
+func TestStartRotation(t *testing.T) {
+	env := newTestEnv(t)
+	env.reconcile()
+
+	cluster := env.cluster()
+	if _, exists := cluster.Annotations[kubermaticv1.RotateCAAnnotation]; exists {
+		t.Error("Rotation annotation should have been removed")
+	}
+
+	if cluster.Status.CARotation == nil || cluster.Status.CARotation.Phase != kubermaticv1.ClusterCARotationPhaseTrustNewCA {
+		t.Fatalf("Expected rotation to be in phase %s, got %+v", kubermaticv1.ClusterCARotationPhaseTrustNewCA, cluster.Status.CARotation)
+	}
+
+	if !cluster.Status.HasConditionValue(kubermaticv1.ClusterConditionCARotated, corev1.ConditionFalse) {
+		t.Errorf("Expected %s condition to be false", kubermaticv1.ClusterConditionCARotated)
+	}
+
+	secret := env.caSecret()
+	if !hasNextCA(secret) {
+		t.Error("CA secret should contain the next CA key")
+	}
+
+	if certs := mustCertificates(t, secret); len(certs) != 2 {
+		t.Errorf("Expected 2 certificates in the CA bundle, got %d", len(certs))
+	}
+
+	// the control plane has not picked up the new bundle yet, nothing must change
+	env.reconcile()
+
+	if phase := env.cluster().Status.CARotation.Phase; phase != kubermaticv1.ClusterCARotationPhaseTrustNewCA {
+		t.Errorf("Rotation should not have progressed before the control plane was rolled out, but is in phase %s", phase)
+	}
+
+	if _, exists := env.machineDeployment().Spec.Template.Annotations[MachineDeploymentRotationAnnotation]; exists {
+		t.Error("Nodes should not be rolled before the control plane was rolled out")
+	}
+}
+
+func TestFullRotation(t *testing.T) {
+	env := newTestEnv(t)
+	originalCA := mustCertificates(t, env.caSecret())[0]
+
+	env.reconcile()
+
+	nextCA := mustCertificates(t, env.caSecret())[1]
+
+	phases := []kubermaticv1.ClusterCARotationPhase{kubermaticv1.ClusterCARotationPhaseTrustNewCA}
+
+	for i := 0; i < 20 && env.cluster().IsCARotationInProgress(); i++ {
+		env.rollOutControlPlane()
+		env.updateClusterInfo()
+		env.reconcile()
+		env.rollOutNodes()
+
+		if phase := env.cluster().Status.CARotation.Phase; phase != phases[len(phases)-1] {
+			phases = append(phases, phase)
+		}
+	}
+
+	expected := []kubermaticv1.ClusterCARotationPhase{
+		kubermaticv1.ClusterCARotationPhaseTrustNewCA,
+		kubermaticv1.ClusterCARotationPhaseSignWithNewCA,
+		kubermaticv1.ClusterCARotationPhaseRemoveOldCA,
+		kubermaticv1.ClusterCARotationPhaseCompleted,
+	}
+
+	if len(phases) != len(expected) {
+		t.Fatalf("Expected phases %v, got %v", expected, phases)
+	}
+	for i := range expected {
+		if phases[i] != expected[i] {
+			t.Fatalf("Expected phases %v, got %v", expected, phases)
+		}
+	}
+
+	cluster := env.cluster()
+	if !cluster.Status.HasConditionValue(kubermaticv1.ClusterConditionCARotated, corev1.ConditionTrue) {
+		t.Errorf("Expected %s condition to be true", kubermaticv1.ClusterConditionCARotated)
+	}
+	if cluster.Status.CARotation.CompletedAt == nil {
+		t.Error("Expected completion time to be set")
+	}
+
+	certs := mustCertificates(t, env.caSecret())
+	if len(certs) != 1 || !certs[0].Equal(nextCA) || certs[0].Equal(originalCA) {
+		t.Fatalf("Expected the CA secret to only contain the new CA")
+	}
+
+	ca, err := resources.GetClusterRootCA(env.ctx, clusterNamespace, env.seedClient)
+	if err != nil {
+		t.Fatalf("Failed to get cluster CA: %v", err)
+	}
+	if !ca.Cert.Equal(nextCA) {
+		t.Error("Expected the new CA to be the signing CA")
+	}
+
+	if value := env.machineDeployment().Spec.Template.Annotations[MachineDeploymentRotationAnnotation]; value == "" {
+		t.Error("Expected MachineDeployment to have been rolled")
+	}
+}
+
+func TestRotationWaitsForEtcdCertificates(t *testing.T) {
+	env := newTestEnv(t)
+
+	originalCA, err := resources.GetClusterRootCA(env.ctx, clusterNamespace, env.seedClient)
+	if err != nil {
+		t.Fatalf("Failed to get cluster CA: %v", err)
+	}
+
+	env.reconcile()
+
+	for i := 0; i < 10 && env.cluster().Status.CARotation.Phase == kubermaticv1.ClusterCARotationPhaseTrustNewCA; i++ {
+		env.rollOutControlPlane()
+		env.updateClusterInfo()
+		env.reconcile()
+		env.rollOutNodes()
+	}
+
+	if phase := env.cluster().Status.CARotation.Phase; phase != kubermaticv1.ClusterCARotationPhaseSignWithNewCA {
+		t.Fatalf("Expected rotation to be in phase %s, got %s", kubermaticv1.ClusterCARotationPhaseSignWithNewCA, phase)
+	}
+
+	// etcd has not been rolled out with a certificate from the new CA yet
+	for i := 0; i < 5; i++ {
+		env.rollOutControlPlane()
+		env.reissueEtcdCertificate(originalCA)
+		env.rollOutEtcd()
+		env.updateClusterInfo()
+		env.reconcile()
+		env.rollOutNodes()
+	}
+
+	if phase := env.cluster().Status.CARotation.Phase; phase != kubermaticv1.ClusterCARotationPhaseSignWithNewCA {
+		t.Fatalf("Rotation should wait for etcd to serve certificates from the new CA, but is in phase %s", phase)
+	}
+
+	for i := 0; i < 5 && env.cluster().Status.CARotation.Phase == kubermaticv1.ClusterCARotationPhaseSignWithNewCA; i++ {
+		env.rollOutControlPlane()
+		env.updateClusterInfo()
+		env.reconcile()
+		env.rollOutNodes()
+	}
+
+	if phase := env.cluster().Status.CARotation.Phase; phase != kubermaticv1.ClusterCARotationPhaseRemoveOldCA {
+		t.Fatalf("Expected rotation to be in phase %s, got %s", kubermaticv1.ClusterCARotationPhaseRemoveOldCA, phase)
+	}
+}
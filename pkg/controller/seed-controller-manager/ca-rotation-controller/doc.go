@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package carotationcontroller contains a controller that rotates the root CA of a user
+cluster when the cluster is annotated with `kubermatic.k8c.io/rotate-ca`.
+
+The rotation happens in multiple phases, each of which is only left once all control
+plane components have been rolled out with the current CA secret:
+
+  - TrustNewCA: a new CA is appended to the CA bundle. The old CA keeps signing, but
+    kubeconfigs, webhooks and the cluster-info ConfigMap used to bootstrap nodes trust
+    both. All nodes are replaced so that kubelets trust the new CA as well.
+  - SignWithNewCA: the new CA becomes the signing CA. The kubernetes controller re-issues
+    all leaf certificates, as they are no longer signed by the current CA. Nodes are
+    replaced again to obtain kubelet certificates from the new CA.
+  - RemoveOldCA: the old CA is removed from the bundle.
+
+The CA secret always contains only the signing CA in `ca.crt`, as kube-controller-manager
+refuses to sign with a bundle; all trusted CAs are kept in `ca-bundle.crt`. Client certificate
+secrets, like the one used by the apiserver to connect to etcd, contain the bundle, and the
+rotation only removes the old CA once etcd serves certificates from the new one.
+
+Progress is reported in the cluster status and the `CARotated` condition. The OpenVPN
+and MLA gateway CAs are separate and not affected.
+*/
+package carotationcontroller
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carotationcontroller
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/utils/ptr"
+)
+
+// caSecretRevisionLabel is the pod template label that modifier.RelatedRevisionsLabels
+// puts onto every control plane component that mounts the CA secret.
+var caSecretRevisionLabel = fmt.Sprintf("%s-secret-revision", resources.CASecretName)
+
+// etcdTLSSecretRevisionLabel is the equivalent to caSecretRevisionLabel for the etcd serving certificate.
+var etcdTLSSecretRevisionLabel = fmt.Sprintf("%s-secret-revision", resources.EtcdTLSCertificateSecretName)
+
+// caCertificates returns all certificates in the trust bundle of the CA secret. The first
+// certificate always belongs to the key that is used for signing.
+func caCertificates(secret *corev1.Secret) ([]*x509.Certificate, error) {
+	certs, err := certutil.ParseCertsPEM(resources.GetCABundleFromSecret(secret))
+	if err != nil {
+		return nil, fmt.Errorf("CA secret contains invalid certificates: %w", err)
+	}
+
+	return certs, nil
+}
+
+func hasNextCA(secret *corev1.Secret) bool {
+	_, exists := secret.Data[resources.CANextKeySecretKey]
+	return exists
+}
+
+// addNextCA appends the certificate of next to the trust bundle and stores its key
+// next to the current one. The current CA keeps signing all certificates, so ca.crt
+// is left untouched.
+func addNextCA(secret *corev1.Secret, next *triple.KeyPair) error {
+	certs, err := caCertificates(secret)
+	if err != nil {
+		return err
+	}
+
+	if len(certs) != 1 {
+		return fmt.Errorf("expected exactly one CA certificate, found %d; is another rotation still in progress?", len(certs))
+	}
+
+	secret.Data[resources.CACertBundleSecretKey] = triple.EncodeCertBundlePEM([]*x509.Certificate{certs[0], next.Cert})
+	secret.Data[resources.CANextKeySecretKey] = triple.EncodePrivateKeyPEM(next.Key)
+
+	return nil
+}
+
+// promoteNextCA turns the next CA into the signing CA. The previous CA remains part
+// of the trust bundle so that certificates issued by it stay valid until they have
+// been replaced. Calling this on an already promoted secret is a no-op.
+func promoteNextCA(secret *corev1.Secret) error {
+	if !hasNextCA(secret) {
+		return nil
+	}
+
+	certs, err := caCertificates(secret)
+	if err != nil {
+		return err
+	}
+
+	if len(certs) != 2 {
+		return fmt.Errorf("expected the current and the next CA certificate, found %d certificates", len(certs))
+	}
+
+	key, err := triple.ParsePrivateKeyPEM(secret.Data[resources.CANextKeySecretKey])
+	if err != nil {
+		return fmt.Errorf("CA secret contains an invalid next key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return errors.New("next CA key is not a RSA key")
+	}
+
+	if !rsaKey.PublicKey.Equal(certs[1].PublicKey) {
+		return errors.New("next CA key does not belong to the next CA certificate")
+	}
+
+	secret.Data[resources.CACertSecretKey] = triple.EncodeCertPEM(certs[1])
+	secret.Data[resources.CACertBundleSecretKey] = triple.EncodeCertBundlePEM([]*x509.Certificate{certs[1], certs[0]})
+	secret.Data[resources.CAKeySecretKey] = triple.EncodePrivateKeyPEM(rsaKey)
+	delete(secret.Data, resources.CANextKeySecretKey)
+
+	return nil
+}
+
+// removePreviousCAs drops all certificates but the signing one from the trust bundle.
+func removePreviousCAs(secret *corev1.Secret) error {
+	if hasNextCA(secret) {
+		return errors.New("next CA has not been promoted yet")
+	}
+
+	certs, err := caCertificates(secret)
+	if err != nil {
+		return err
+	}
+
+	if len(certs) == 0 {
+		return errors.New("CA secret contains no certificates")
+	}
+
+	secret.Data[resources.CACertBundleSecretKey] = triple.EncodeCertPEM(certs[0])
+
+	return nil
+}
+
+// isSignedBy returns true if the first certificate in the given PEM data was issued by ca.
+func isSignedBy(certPEM []byte, ca *x509.Certificate) bool {
+	certs, err := certutil.ParseCertsPEM(certPEM)
+	if err != nil || len(certs) == 0 {
+		return false
+	}
+
+	return certs[0].CheckSignatureFrom(ca) == nil
+}
+
+// deploymentUpToDate returns true if the Deployment has been rolled out with the given CA
+// secret revision. Deployments that do not mount the CA secret are always up to date.
+func deploymentUpToDate(deployment *appsv1.Deployment, caRevision string) bool {
+	revision, exists := deployment.Spec.Template.Labels[caSecretRevisionLabel]
+	if !exists {
+		return true
+	}
+
+	replicas := ptr.Deref(deployment.Spec.Replicas, 1)
+
+	return revision == caRevision &&
+		deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.Replicas == replicas &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas
+}
+
+// statefulSetUpToDate is the StatefulSet equivalent to deploymentUpToDate.
+func statefulSetUpToDate(statefulSet *appsv1.StatefulSet, caRevision string) bool {
+	if _, exists := statefulSet.Spec.Template.Labels[caSecretRevisionLabel]; !exists {
+		return true
+	}
+
+	return statefulSetRolledOut(statefulSet, caSecretRevisionLabel, caRevision)
+}
+
+// statefulSetRolledOut returns true if the StatefulSet has been rolled out with the given
+// revision of the Secret identified by revisionLabel.
+func statefulSetRolledOut(statefulSet *appsv1.StatefulSet, revisionLabel string, secretRevision string) bool {
+	replicas := ptr.Deref(statefulSet.Spec.Replicas, 1)
+
+	return statefulSet.Spec.Template.Labels[revisionLabel] == secretRevision &&
+		statefulSet.Status.ObservedGeneration >= statefulSet.Generation &&
+		statefulSet.Status.UpdatedReplicas == replicas &&
+		statefulSet.Status.ReadyReplicas == replicas
+}
+
+// machineDeploymentRolledOut returns true once all machines of the MachineDeployment have
+// been replaced according to its current template.
+func machineDeploymentRolledOut(md *clusterv1alpha1.MachineDeployment) bool {
+	replicas := ptr.Deref(md.Spec.Replicas, 1)
+
+	return md.Status.ObservedGeneration >= md.Generation &&
+		md.Status.Replicas == replicas &&
+		md.Status.UpdatedReplicas == replicas &&
+		md.Status.AvailableReplicas == replicas
+}
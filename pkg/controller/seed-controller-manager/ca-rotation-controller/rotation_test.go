@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carotationcontroller
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func newCASecret(t *testing.T, ca *triple.KeyPair) *corev1.Secret {
+	t.Helper()
+
+	return &corev1.Secret{
+		Data: map[string][]byte{
+			resources.CACertSecretKey:       triple.EncodeCertPEM(ca.Cert),
+			resources.CACertBundleSecretKey: triple.EncodeCertPEM(ca.Cert),
+			resources.CAKeySecretKey:        triple.EncodePrivateKeyPEM(ca.Key),
+		},
+	}
+}
+
+func mustCertificates(t *testing.T, secret *corev1.Secret) []*x509.Certificate {
+	t.Helper()
+
+	certs, err := caCertificates(secret)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificates: %v", err)
+	}
+
+	return certs
+}
+
+// signingCert returns the signing certificate and ensures that it belongs to the CA key and
+// is the first certificate of the bundle. As kube-controller-manager uses ca.crt for signing,
+// it must always contain exactly one certificate.
+func signingCert(t *testing.T, secret *corev1.Secret) *x509.Certificate {
+	t.Helper()
+
+	key, err := triple.ParsePrivateKeyPEM(secret.Data[resources.CAKeySecretKey])
+	if err != nil {
+		t.Fatalf("Failed to parse CA key: %v", err)
+	}
+
+	certs, err := certutil.ParseCertsPEM(secret.Data[resources.CACertSecretKey])
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+
+	if len(certs) != 1 {
+		t.Fatalf("Expected exactly one signing certificate, got %d", len(certs))
+	}
+
+	cert := certs[0]
+	if !key.(*rsa.PrivateKey).PublicKey.Equal(cert.PublicKey) {
+		t.Fatal("CA key does not belong to the signing certificate")
+	}
+
+	if !mustCertificates(t, secret)[0].Equal(cert) {
+		t.Fatal("Signing certificate is not the first certificate in the bundle")
+	}
+
+	return cert
+}
+
+func TestSecretTransitions(t *testing.T) {
+	oldCA, err := triple.NewCA("old-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	newCA, err := triple.NewCA("new-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	oldLeaf, err := triple.NewClientKeyPair(oldCA, "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to create client certificate: %v", err)
+	}
+
+	secret := newCASecret(t, oldCA)
+
+	if err := removePreviousCAs(secret); err != nil {
+		t.Fatalf("Removing previous CAs from a single CA should be a no-op, but failed: %v", err)
+	}
+
+	// phase 1: trust both, sign with the old CA
+	if err := addNextCA(secret, newCA); err != nil {
+		t.Fatalf("Failed to add next CA: %v", err)
+	}
+
+	if err := addNextCA(secret, newCA); err == nil {
+		t.Fatal("Adding a second next CA should have failed")
+	}
+
+	certs := mustCertificates(t, secret)
+	if len(certs) != 2 || !certs[0].Equal(oldCA.Cert) || !certs[1].Equal(newCA.Cert) {
+		t.Fatalf("Expected bundle [old, new], got %d certificates", len(certs))
+	}
+
+	signing := signingCert(t, secret)
+	if !signing.Equal(oldCA.Cert) {
+		t.Fatal("Old CA should still be the signing CA")
+	}
+
+	if err := removePreviousCAs(secret); err == nil {
+		t.Fatal("Removing previous CAs before promoting the next CA should have failed")
+	}
+
+	// phase 2: trust both, sign with the new CA
+	if err := promoteNextCA(secret); err != nil {
+		t.Fatalf("Failed to promote next CA: %v", err)
+	}
+
+	if hasNextCA(secret) {
+		t.Fatal("Next CA key should have been removed after promotion")
+	}
+
+	certs = mustCertificates(t, secret)
+	if len(certs) != 2 || !certs[0].Equal(newCA.Cert) || !certs[1].Equal(oldCA.Cert) {
+		t.Fatalf("Expected bundle [new, old], got %d certificates", len(certs))
+	}
+
+	signing = signingCert(t, secret)
+	if !signing.Equal(newCA.Cert) {
+		t.Fatal("New CA should be the signing CA after promotion")
+	}
+
+	// leaf certificates issued by the old CA must be re-issued now
+	if resources.IsClientCertificateValidForAllOf(oldLeaf.Cert, "test", nil, signing) {
+		t.Fatal("Client certificate issued by the old CA should no longer be considered valid")
+	}
+
+	before := secret.DeepCopy()
+	if err := promoteNextCA(secret); err != nil {
+		t.Fatalf("Promoting twice should be a no-op, but failed: %v", err)
+	}
+	if string(before.Data[resources.CACertBundleSecretKey]) != string(secret.Data[resources.CACertBundleSecretKey]) {
+		t.Fatal("Promoting twice should not change the bundle")
+	}
+
+	// phase 3: only trust the new CA
+	if err := removePreviousCAs(secret); err != nil {
+		t.Fatalf("Failed to remove previous CAs: %v", err)
+	}
+
+	certs = mustCertificates(t, secret)
+	if len(certs) != 1 || !certs[0].Equal(newCA.Cert) {
+		t.Fatalf("Expected only the new CA, got %d certificates", len(certs))
+	}
+
+	if signing = signingCert(t, secret); !signing.Equal(newCA.Cert) {
+		t.Fatal("New CA should remain the signing CA")
+	}
+}
+
+func TestPromoteNextCARejectsMismatchingKey(t *testing.T) {
+	oldCA, err := triple.NewCA("old-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	newCA, err := triple.NewCA("new-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	secret := newCASecret(t, oldCA)
+	if err := addNextCA(secret, newCA); err != nil {
+		t.Fatalf("Failed to add next CA: %v", err)
+	}
+
+	secret.Data[resources.CANextKeySecretKey] = triple.EncodePrivateKeyPEM(oldCA.Key)
+
+	if err := promoteNextCA(secret); err == nil {
+		t.Fatal("Promoting a next CA with a mismatching key should have failed")
+	}
+}
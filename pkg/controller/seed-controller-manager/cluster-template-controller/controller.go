@@ -27,6 +27,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/util"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	utilcluster "k8c.io/kubermatic/v2/pkg/util/cluster"
+	"k8c.io/kubermatic/v2/pkg/util/clustertemplate"
 	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
 	"k8c.io/reconciler/pkg/reconciling"
 
@@ -35,10 +36,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -83,11 +86,47 @@ func Add(
 			MaxConcurrentReconciles: numWorkers,
 		}).
 		For(&kubermaticv1.ClusterTemplateInstance{}).
+		Watches(&kubermaticv1.ClusterTemplate{}, handler.EnqueueRequestsFromMapFunc(enqueueInstancesForTemplate(reconciler.seedClient, reconciler.log))).
+		Watches(&kubermaticv1.Cluster{}, handler.EnqueueRequestsFromMapFunc(enqueueInstanceForCluster)).
 		Build(reconciler)
 
 	return err
 }
 
+// enqueueInstancesForTemplate enqueues all instances of a template that have a rollout policy,
+// so that changes to the template are propagated to the clusters created from it.
+func enqueueInstancesForTemplate(client ctrlruntimeclient.Client, log *zap.SugaredLogger) handler.MapFunc {
+	return func(ctx context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
+		instances := &kubermaticv1.ClusterTemplateInstanceList{}
+		if err := client.List(ctx, instances); err != nil {
+			log.Errorw("Failed to list cluster template instances", zap.Error(err), "template", a.GetName())
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, instance := range instances.Items {
+			if instance.Spec.ClusterTemplateID == a.GetName() && instance.Spec.RolloutPolicy != nil {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: instance.Name},
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+// enqueueInstanceForCluster enqueues the instance a cluster was created from, so that
+// the rollout can continue once an updated cluster has become healthy again.
+func enqueueInstanceForCluster(_ context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
+	instance := a.GetLabels()[kubermaticv1.ClusterTemplateInstanceLabelKey]
+	if instance == "" {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: instance}}}
+}
+
 // Reconcile reconciles the kubermatic cluster template instance in the seed cluster.
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := r.log.With("templateinstance", request.Name)
@@ -121,20 +160,34 @@ func (r *reconciler) reconcile(ctx context.Context, instance *kubermaticv1.Clust
 		return err
 	}
 
-	log.Info("all clusters created successfully, deleting temporary ClusterTemplateInstance")
+	if instance.Spec.RolloutPolicy == nil {
+		log.Info("all clusters created successfully, deleting temporary ClusterTemplateInstance")
 
-	// now that all clusters are created, delete this temporary object
-	return r.seedClient.Delete(ctx, instance)
+		// now that all clusters are created, delete this temporary object
+		return r.seedClient.Delete(ctx, instance)
+	}
+
+	// instances with a rollout policy are kept to propagate template changes to their clusters
+	template := &kubermaticv1.ClusterTemplate{}
+	if err := r.seedClient.Get(ctx, ctrlruntimeclient.ObjectKey{Name: instance.Spec.ClusterTemplateID}, template); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get template %s: %w", instance.Spec.ClusterTemplateID, err)
+	}
+
+	return r.reconcileRollout(ctx, log, instance, template)
 }
 
-func (r *reconciler) patchInstance(ctx context.Context, instance *kubermaticv1.ClusterTemplateInstance, patch func(instance *kubermaticv1.ClusterTemplateInstance)) error {
+func (r *reconciler) patchInstanceStatus(ctx context.Context, instance *kubermaticv1.ClusterTemplateInstance, patch func(instance *kubermaticv1.ClusterTemplateInstance)) error {
 	oldInstance := instance.DeepCopy()
 
 	patch(instance)
 
 	if !reflect.DeepEqual(oldInstance, instance) {
-		if err := r.seedClient.Patch(ctx, instance, ctrlruntimeclient.MergeFrom(oldInstance)); err != nil {
-			return fmt.Errorf("failed to update cluster template instance %s: %w", instance.Name, err)
+		if err := r.seedClient.Status().Patch(ctx, instance, ctrlruntimeclient.MergeFrom(oldInstance)); err != nil {
+			return fmt.Errorf("failed to update status of cluster template instance %s: %w", instance.Name, err)
 		}
 	}
 
@@ -142,26 +195,31 @@ func (r *reconciler) patchInstance(ctx context.Context, instance *kubermaticv1.C
 }
 
 func (r *reconciler) createClusters(ctx context.Context, instance *kubermaticv1.ClusterTemplateInstance, log *zap.SugaredLogger) error {
-	if instance.Spec.Replicas > 0 {
-		log.Infof("creating %d clusters", instance.Spec.Replicas)
+	remaining := instance.Spec.Replicas - instance.Status.CreatedClusters
+	if remaining > 0 {
+		log.Infof("creating %d clusters", remaining)
 
 		template := &kubermaticv1.ClusterTemplate{}
 		if err := r.seedClient.Get(ctx, ctrlruntimeclient.ObjectKey{Name: instance.Spec.ClusterTemplateID}, template); err != nil {
 			return fmt.Errorf("failed to get template %s: %w", instance.Spec.ClusterTemplateID, err)
 		}
 
-		for i := range instance.Spec.Replicas {
-			if err := r.createCluster(ctx, log, template, instance); err != nil {
-				created := i
-				totalReplicas := instance.Spec.Replicas
+		// the values are validated by the webhook, but the template could have changed since then
+		values, errs := clustertemplate.ResolveValues(template.Parameters, instance.Spec.Values, field.NewPath("spec", "values"))
+		if len(errs) > 0 {
+			return fmt.Errorf("invalid parameter values: %w", errs.ToAggregate())
+		}
 
-				if patchErr := r.patchInstance(ctx, instance, func(i *kubermaticv1.ClusterTemplateInstance) {
-					i.Spec.Replicas = totalReplicas - created
-				}); patchErr != nil {
-					return fmt.Errorf("error patching cluster template instance (%w), after cluster creation fail: %w", patchErr, err)
-				}
+		for range remaining {
+			if err := r.createCluster(ctx, log, template, instance, values); err != nil {
+				return fmt.Errorf("failed to create desired number of clusters. Created %d of %d: %w", instance.Status.CreatedClusters, instance.Spec.Replicas, err)
+			}
 
-				return fmt.Errorf("failed to create desired number of clusters. Created %d of %d: %w", created, totalReplicas, err)
+			// record every created cluster, so that a failure or restart does not create it again
+			if err := r.patchInstanceStatus(ctx, instance, func(i *kubermaticv1.ClusterTemplateInstance) {
+				i.Status.CreatedClusters++
+			}); err != nil {
+				return err
 			}
 		}
 	}
@@ -169,7 +227,7 @@ func (r *reconciler) createClusters(ctx context.Context, instance *kubermaticv1.
 	return nil
 }
 
-func (r *reconciler) createCluster(ctx context.Context, log *zap.SugaredLogger, template *kubermaticv1.ClusterTemplate, instance *kubermaticv1.ClusterTemplateInstance) error {
+func (r *reconciler) createCluster(ctx context.Context, log *zap.SugaredLogger, template *kubermaticv1.ClusterTemplate, instance *kubermaticv1.ClusterTemplateInstance, values map[string]interface{}) error {
 	// This is temporary cluster with cloud spec from the template.
 	// It holds credential for the new cluster
 	partialCluster := &kubermaticv1.Cluster{
@@ -186,11 +244,14 @@ func (r *reconciler) createCluster(ctx context.Context, log *zap.SugaredLogger,
 		template.Annotations[kubermaticv1.ClusterTemplateUserAnnotationKey] = instance.Annotations[kubermaticv1.ClusterTemplateInstanceOwnerAnnotationKey]
 	}
 
-	newCluster := genNewCluster(template, instance, r.workerName)
+	newCluster, err := genNewCluster(template, instance, r.workerName, values)
+	if err != nil {
+		return fmt.Errorf("failed to apply template parameters: %w", err)
+	}
 	newStatus := newCluster.Status.DeepCopy()
 
 	// Here partialCluster is used to copy credentials to the new cluster
-	err := resources.CopyCredentials(resources.NewCredentialsData(ctx, partialCluster, r.seedClient), newCluster)
+	err = resources.CopyCredentials(resources.NewCredentialsData(ctx, partialCluster, r.seedClient), newCluster)
 	if err != nil {
 		return fmt.Errorf("failed to get credentials: %w", err)
 	}
@@ -239,7 +300,7 @@ func (r *reconciler) assignSSHKeyToCluster(ctx context.Context, clusterID string
 	return nil
 }
 
-func genNewCluster(template *kubermaticv1.ClusterTemplate, instance *kubermaticv1.ClusterTemplateInstance, workerName string) *kubermaticv1.Cluster {
+func genNewCluster(template *kubermaticv1.ClusterTemplate, instance *kubermaticv1.ClusterTemplateInstance, workerName string, values map[string]interface{}) (*kubermaticv1.Cluster, error) {
 	name := utilcluster.MakeClusterName()
 
 	newCluster := &kubermaticv1.Cluster{
@@ -261,8 +322,12 @@ func genNewCluster(template *kubermaticv1.ClusterTemplate, instance *kubermaticv
 	newCluster.Labels[kubermaticv1.ClusterTemplateInstanceLabelKey] = instance.Name
 	newCluster.Spec = template.Spec
 
+	if err := clustertemplate.Apply(newCluster, template.Parameters, values); err != nil {
+		return nil, err
+	}
+
 	newCluster.Spec.HumanReadableName = fmt.Sprintf("%s-%s", newCluster.Spec.HumanReadableName, name)
 	newCluster.Status.UserEmail = template.Annotations[kubermaticv1.ClusterTemplateUserAnnotationKey]
 
-	return newCluster
+	return newCluster, nil
 }
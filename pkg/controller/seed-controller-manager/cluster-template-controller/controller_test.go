@@ -32,6 +32,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -74,6 +75,27 @@ func TestReconcile(t *testing.T) {
 				).
 				Build(),
 		},
+		{
+			name: "scenario 2: substitutes the instance's parameter values into the new clusters",
+			namespacedName: types.NamespacedName{
+				Name: "my-first-project-ID-ctID5",
+			},
+			expectedClusters: []*kubermaticv1.Cluster{
+				func() *kubermaticv1.Cluster {
+					cluster := genCluster("ct5-0", "bob@acme.com", *genParameterizedTemplateInstance(projectName))
+					cluster.Spec.Cloud.DatacenterName = "other-dc"
+					cluster.Spec.EnableUserSSHKeyAgent = ptr.To(true)
+					return cluster
+				}(),
+			},
+			seedClient: fake.
+				NewClientBuilder().
+				WithObjects(
+					genParameterizedTemplate(projectName),
+					genParameterizedTemplateInstance(projectName),
+				).
+				Build(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -151,6 +173,34 @@ func sortClusters(clusters []*kubermaticv1.Cluster) {
 	})
 }
 
+func genParameterizedTemplate(projectName string) *kubermaticv1.ClusterTemplate {
+	template := generator.GenClusterTemplate("ct5", "ctID5", projectName, kubermaticv1.UserClusterTemplateScope, "john@acme.com")
+	template.Parameters = []kubermaticv1.ClusterTemplateParameter{
+		{
+			Name:  "datacenter",
+			Type:  kubermaticv1.ClusterTemplateParameterTypeString,
+			Paths: []string{"/spec/cloud/dc"},
+		},
+		{
+			Name:    "sshKeyAgent",
+			Type:    kubermaticv1.ClusterTemplateParameterTypeBoolean,
+			Paths:   []string{"/spec/enableUserSSHKeyAgent"},
+			Default: ptr.To("true"),
+		},
+	}
+
+	return template
+}
+
+func genParameterizedTemplateInstance(projectName string) *kubermaticv1.ClusterTemplateInstance {
+	instance := generator.GenClusterTemplateInstance(projectName, "ctID5", "bob@acme.com", 1)
+	instance.Spec.Values = map[string]string{
+		"datacenter": "other-dc",
+	}
+
+	return instance
+}
+
 func genCluster(name, userEmail string, instance kubermaticv1.ClusterTemplateInstance) *kubermaticv1.Cluster {
 	return &kubermaticv1.Cluster{
 		ObjectMeta: metav1.ObjectMeta{
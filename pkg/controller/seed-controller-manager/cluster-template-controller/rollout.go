@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplatecontroller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"dario.cat/mergo"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/util/clustertemplate"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	driftVersion            = "version"
+	driftComponentsOverride = "componentsOverride"
+	driftFeatures           = "features"
+	driftAdmissionPlugins   = "admissionPlugins"
+
+	defaultMaxUnavailable = 1
+)
+
+// reconcileRollout compares the clusters created from the instance to the template, reports
+// the drift in the instance's status and, if the rollout policy allows it, updates the clusters.
+func (r *reconciler) reconcileRollout(ctx context.Context, log *zap.SugaredLogger, instance *kubermaticv1.ClusterTemplateInstance, template *kubermaticv1.ClusterTemplate) error {
+	desired, err := desiredClusterSpec(template, instance)
+	if err != nil {
+		return err
+	}
+
+	clusters := &kubermaticv1.ClusterList{}
+	if err := r.seedClient.List(ctx, clusters, ctrlruntimeclient.MatchingLabels{kubermaticv1.ClusterTemplateInstanceLabelKey: instance.Name}); err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	status := kubermaticv1.ClusterTemplateInstanceStatus{
+		TemplateGeneration: template.Generation,
+		Clusters:           map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus{},
+		CreatedClusters:    instance.Status.CreatedClusters,
+	}
+
+	unavailable := 0
+	drifted := []*kubermaticv1.Cluster{}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if cluster.DeletionTimestamp != nil {
+			continue
+		}
+
+		drift := clusterDrift(desired, &cluster.Spec)
+		available := clusterAvailable(cluster)
+
+		if !available {
+			unavailable++
+		}
+
+		status.Clusters[cluster.Name] = kubermaticv1.ClusterTemplateInstanceClusterStatus{
+			Drift:    drift,
+			Updating: len(drift) == 0 && !available,
+		}
+
+		// updating unavailable clusters could make things worse
+		if len(drift) > 0 && available {
+			drifted = append(drifted, cluster)
+		}
+	}
+
+	if rolloutApproved(instance.Spec.RolloutPolicy, template) {
+		sort.Slice(drifted, func(i, j int) bool {
+			return drifted[i].Name < drifted[j].Name
+		})
+
+		budget := maxUnavailable(instance.Spec.RolloutPolicy) - unavailable
+
+		for i := 0; i < budget && i < len(drifted); i++ {
+			cluster := drifted[i]
+
+			log.Infow("updating cluster to match template", "cluster", cluster.Name, "drift", status.Clusters[cluster.Name].Drift)
+
+			oldCluster := cluster.DeepCopy()
+			applyTemplateFields(desired, &cluster.Spec)
+
+			if err := r.seedClient.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+				return fmt.Errorf("failed to update cluster %s: %w", cluster.Name, err)
+			}
+
+			status.Clusters[cluster.Name] = kubermaticv1.ClusterTemplateInstanceClusterStatus{
+				Updating: true,
+			}
+		}
+	}
+
+	if reflect.DeepEqual(instance.Status, status) {
+		return nil
+	}
+
+	oldInstance := instance.DeepCopy()
+	instance.Status = status
+
+	if err := r.seedClient.Status().Patch(ctx, instance, ctrlruntimeclient.MergeFrom(oldInstance)); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// desiredClusterSpec returns the cluster spec that results from the template and the instance's values.
+func desiredClusterSpec(template *kubermaticv1.ClusterTemplate, instance *kubermaticv1.ClusterTemplateInstance) (*kubermaticv1.ClusterSpec, error) {
+	values, errs := clustertemplate.ResolveValues(template.Parameters, instance.Spec.Values, field.NewPath("spec", "values"))
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid parameter values: %w", errs.ToAggregate())
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	cluster.Annotations = template.Annotations
+	cluster.Spec = *template.Spec.DeepCopy()
+
+	if err := clustertemplate.Apply(cluster, template.Parameters, values); err != nil {
+		return nil, fmt.Errorf("failed to apply template parameters: %w", err)
+	}
+
+	return &cluster.Spec, nil
+}
+
+// clusterDrift returns the propagated fields in which the cluster differs from the desired spec.
+// As clusters are defaulted during creation, component settings and features only count as
+// drifted if a value set in the template differs from the cluster's. As clusters cannot be
+// downgraded, the version only counts as drifted if the template's version is newer.
+func clusterDrift(desired *kubermaticv1.ClusterSpec, current *kubermaticv1.ClusterSpec) []string {
+	var drift []string
+
+	if desired.Version.GreaterThan(&current.Version) {
+		drift = append(drift, driftVersion)
+	}
+
+	if !equality.Semantic.DeepEqual(mergedComponentsOverride(desired, current), current.ComponentsOverride) {
+		drift = append(drift, driftComponentsOverride)
+	}
+
+	for feature, enabled := range desired.Features {
+		if current.Features[feature] != enabled {
+			drift = append(drift, driftFeatures)
+			break
+		}
+	}
+
+	if !sets.New(desired.AdmissionPlugins...).Equal(sets.New(current.AdmissionPlugins...)) {
+		drift = append(drift, driftAdmissionPlugins)
+	}
+
+	return drift
+}
+
+// applyTemplateFields updates the propagated fields of the cluster spec to match the desired spec.
+// Clusters that have been updated to a newer version than the template's keep their version.
+func applyTemplateFields(desired *kubermaticv1.ClusterSpec, spec *kubermaticv1.ClusterSpec) {
+	if desired.Version.GreaterThan(&spec.Version) {
+		spec.Version = desired.Version
+	}
+	spec.ComponentsOverride = mergedComponentsOverride(desired, spec)
+	spec.AdmissionPlugins = desired.AdmissionPlugins
+
+	if len(desired.Features) > 0 && spec.Features == nil {
+		spec.Features = map[string]bool{}
+	}
+
+	for feature, enabled := range desired.Features {
+		spec.Features[feature] = enabled
+	}
+}
+
+// mergedComponentsOverride returns the desired component settings, with all settings
+// that are not set in the template taken from the current cluster spec.
+func mergedComponentsOverride(desired *kubermaticv1.ClusterSpec, current *kubermaticv1.ClusterSpec) kubermaticv1.ComponentSettings {
+	merged := desired.ComponentsOverride.DeepCopy()
+
+	// this cannot fail, as both arguments have the same type
+	_ = mergo.Merge(merged, current.ComponentsOverride.DeepCopy())
+
+	return *merged
+}
+
+// clusterAvailable returns true if the cluster's control plane runs the desired version and is healthy.
+func clusterAvailable(cluster *kubermaticv1.Cluster) bool {
+	return cluster.Status.Versions.ControlPlane.Equal(&cluster.Spec.Version) && cluster.Status.ExtendedHealth.AllHealthy()
+}
+
+func rolloutApproved(policy *kubermaticv1.ClusterTemplateRolloutPolicy, template *kubermaticv1.ClusterTemplate) bool {
+	switch policy.Strategy {
+	case kubermaticv1.ClusterTemplateRolloutStrategyRolling:
+		return true
+	case kubermaticv1.ClusterTemplateRolloutStrategyManual:
+		return policy.ApprovedTemplateGeneration == template.Generation
+	default:
+		return false
+	}
+}
+
+func maxUnavailable(policy *kubermaticv1.ClusterTemplateRolloutPolicy) int {
+	if policy.MaxUnavailable == nil {
+		return defaultMaxUnavailable
+	}
+
+	return int(*policy.MaxUnavailable)
+}
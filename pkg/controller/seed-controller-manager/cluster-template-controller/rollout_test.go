@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplatecontroller
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/test/generator"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	rolloutProject  = "project"
+	rolloutTemplate = "ctID"
+	oldVersion      = "1.32.0"
+	newVersion      = "1.33.0"
+	newerVersion    = "1.34.0"
+)
+
+func genRolloutTemplate() *kubermaticv1.ClusterTemplate {
+	template := generator.GenClusterTemplate("ct", rolloutTemplate, rolloutProject, kubermaticv1.ProjectClusterTemplateScope, "john@acme.com")
+	template.Generation = 2
+	template.Spec.Version = *semver.NewSemverOrDie(newVersion)
+	template.Spec.Features = map[string]bool{kubermaticv1.ApiserverNetworkPolicy: true}
+	template.Spec.AdmissionPlugins = []string{"PodNodeSelector"}
+	template.Spec.ComponentsOverride.Apiserver.Replicas = ptr.To[int32](3)
+
+	return template
+}
+
+func genRolloutInstance(policy *kubermaticv1.ClusterTemplateRolloutPolicy) *kubermaticv1.ClusterTemplateInstance {
+	instance := generator.GenClusterTemplateInstance(rolloutProject, rolloutTemplate, "bob@acme.com", 0)
+	instance.Spec.RolloutPolicy = policy
+
+	return instance
+}
+
+// genRolloutCluster returns a healthy cluster that was created from an older revision of the template.
+func genRolloutCluster(name string) *kubermaticv1.Cluster {
+	cluster := genCluster(name, "bob@acme.com", *genRolloutInstance(nil))
+	cluster.Spec.Version = *semver.NewSemverOrDie(oldVersion)
+	cluster.Spec.Features = map[string]bool{kubermaticv1.ApiserverNetworkPolicy: true, kubermaticv1.KubeSystemNetworkPolicies: true}
+	cluster.Spec.AdmissionPlugins = []string{"PodNodeSelector"}
+	cluster.Spec.ComponentsOverride.Apiserver.Replicas = ptr.To[int32](3)
+	cluster.Spec.ComponentsOverride.Scheduler.Replicas = ptr.To[int32](1)
+	cluster.Status.Versions.ControlPlane = cluster.Spec.Version
+	cluster.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{
+		Apiserver:                    kubermaticv1.HealthStatusUp,
+		Scheduler:                    kubermaticv1.HealthStatusUp,
+		Controller:                   kubermaticv1.HealthStatusUp,
+		Etcd:                         kubermaticv1.HealthStatusUp,
+		CloudProviderInfrastructure:  kubermaticv1.HealthStatusUp,
+		UserClusterControllerManager: kubermaticv1.HealthStatusUp,
+	}
+
+	return cluster
+}
+
+func genUpToDateCluster(name string) *kubermaticv1.Cluster {
+	cluster := genRolloutCluster(name)
+	cluster.Spec.Version = *semver.NewSemverOrDie(newVersion)
+	cluster.Status.Versions.ControlPlane = cluster.Spec.Version
+
+	return cluster
+}
+
+func TestReconcileRollout(t *testing.T) {
+	versionDrift := []string{driftVersion}
+
+	testCases := []struct {
+		name             string
+		policy           *kubermaticv1.ClusterTemplateRolloutPolicy
+		clusters         []*kubermaticv1.Cluster
+		expectedVersions map[string]string
+		expectedStatus   map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus
+	}{
+		{
+			name:   "drift is only reported without a rollout strategy",
+			policy: &kubermaticv1.ClusterTemplateRolloutPolicy{Strategy: kubermaticv1.ClusterTemplateRolloutStrategyNone},
+			clusters: []*kubermaticv1.Cluster{
+				genRolloutCluster("a"),
+				genUpToDateCluster("b"),
+			},
+			expectedVersions: map[string]string{"a": oldVersion, "b": newVersion},
+			expectedStatus: map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus{
+				"a": {Drift: versionDrift},
+				"b": {},
+			},
+		},
+		{
+			name:   "all propagated fields are reported as drift",
+			policy: &kubermaticv1.ClusterTemplateRolloutPolicy{Strategy: kubermaticv1.ClusterTemplateRolloutStrategyNone},
+			clusters: []*kubermaticv1.Cluster{
+				func() *kubermaticv1.Cluster {
+					cluster := genRolloutCluster("a")
+					cluster.Spec.Features[kubermaticv1.ApiserverNetworkPolicy] = false
+					cluster.Spec.AdmissionPlugins = nil
+					cluster.Spec.ComponentsOverride.Apiserver.Replicas = ptr.To[int32](1)
+					return cluster
+				}(),
+			},
+			expectedVersions: map[string]string{"a": oldVersion},
+			expectedStatus: map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus{
+				"a": {Drift: []string{driftVersion, driftComponentsOverride, driftFeatures, driftAdmissionPlugins}},
+			},
+		},
+		{
+			name:   "rolling strategy updates no more than maxUnavailable clusters at once",
+			policy: &kubermaticv1.ClusterTemplateRolloutPolicy{Strategy: kubermaticv1.ClusterTemplateRolloutStrategyRolling, MaxUnavailable: ptr.To[int32](2)},
+			clusters: []*kubermaticv1.Cluster{
+				genRolloutCluster("c"),
+				genRolloutCluster("a"),
+				genRolloutCluster("b"),
+			},
+			expectedVersions: map[string]string{"a": newVersion, "b": newVersion, "c": oldVersion},
+			expectedStatus: map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus{
+				"a": {Updating: true},
+				"b": {Updating: true},
+				"c": {Drift: versionDrift},
+			},
+		},
+		{
+			name:   "rolling strategy waits for unavailable clusters",
+			policy: &kubermaticv1.ClusterTemplateRolloutPolicy{Strategy: kubermaticv1.ClusterTemplateRolloutStrategyRolling},
+			clusters: []*kubermaticv1.Cluster{
+				genRolloutCluster("a"),
+				func() *kubermaticv1.Cluster {
+					cluster := genUpToDateCluster("b")
+					cluster.Status.Versions.ControlPlane = *semver.NewSemverOrDie(oldVersion)
+					return cluster
+				}(),
+			},
+			expectedVersions: map[string]string{"a": oldVersion, "b": newVersion},
+			expectedStatus: map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus{
+				"a": {Drift: versionDrift},
+				"b": {Updating: true},
+			},
+		},
+		{
+			name:   "clusters newer than the template are not downgraded",
+			policy: &kubermaticv1.ClusterTemplateRolloutPolicy{Strategy: kubermaticv1.ClusterTemplateRolloutStrategyRolling},
+			clusters: []*kubermaticv1.Cluster{
+				func() *kubermaticv1.Cluster {
+					cluster := genRolloutCluster("a")
+					cluster.Spec.Version = *semver.NewSemverOrDie(newerVersion)
+					cluster.Status.Versions.ControlPlane = cluster.Spec.Version
+					return cluster
+				}(),
+				func() *kubermaticv1.Cluster {
+					cluster := genRolloutCluster("b")
+					cluster.Spec.Version = *semver.NewSemverOrDie(newerVersion)
+					cluster.Status.Versions.ControlPlane = cluster.Spec.Version
+					cluster.Spec.AdmissionPlugins = nil
+					return cluster
+				}(),
+			},
+			expectedVersions: map[string]string{"a": newerVersion, "b": newerVersion},
+			expectedStatus: map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus{
+				"a": {},
+				"b": {Updating: true},
+			},
+		},
+		{
+			name:   "manual strategy does not update clusters without approval",
+			policy: &kubermaticv1.ClusterTemplateRolloutPolicy{Strategy: kubermaticv1.ClusterTemplateRolloutStrategyManual, ApprovedTemplateGeneration: 1},
+			clusters: []*kubermaticv1.Cluster{
+				genRolloutCluster("a"),
+			},
+			expectedVersions: map[string]string{"a": oldVersion},
+			expectedStatus: map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus{
+				"a": {Drift: versionDrift},
+			},
+		},
+		{
+			name:   "manual strategy updates clusters once the template generation is approved",
+			policy: &kubermaticv1.ClusterTemplateRolloutPolicy{Strategy: kubermaticv1.ClusterTemplateRolloutStrategyManual, ApprovedTemplateGeneration: 2},
+			clusters: []*kubermaticv1.Cluster{
+				genRolloutCluster("a"),
+			},
+			expectedVersions: map[string]string{"a": newVersion},
+			expectedStatus: map[string]kubermaticv1.ClusterTemplateInstanceClusterStatus{
+				"a": {Updating: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			instance := genRolloutInstance(tc.policy)
+
+			objects := []ctrlruntimeclient.Object{genRolloutTemplate(), instance}
+			for _, cluster := range tc.clusters {
+				objects = append(objects, cluster)
+			}
+
+			client := fake.NewClientBuilder().WithObjects(objects...).Build()
+			r := &reconciler{
+				log:        kubermaticlog.Logger,
+				seedClient: client,
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.Name}}
+			if _, err := r.Reconcile(ctx, request); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			for name, expectedVersion := range tc.expectedVersions {
+				cluster := &kubermaticv1.Cluster{}
+				if err := client.Get(ctx, types.NamespacedName{Name: name}, cluster); err != nil {
+					t.Fatalf("failed to get cluster %s: %v", name, err)
+				}
+
+				if cluster.Spec.Version.String() != expectedVersion {
+					t.Errorf("Expected cluster %s to have version %s, got %s.", name, expectedVersion, cluster.Spec.Version.String())
+				}
+
+				// settings not managed by the template must be retained
+				if cluster.Spec.ComponentsOverride.Scheduler.Replicas == nil || !cluster.Spec.Features[kubermaticv1.KubeSystemNetworkPolicies] {
+					t.Errorf("Expected cluster %s to retain its own settings.", name)
+				}
+			}
+
+			updated := &kubermaticv1.ClusterTemplateInstance{}
+			if err := client.Get(ctx, request.NamespacedName, updated); err != nil {
+				t.Fatalf("failed to get instance: %v", err)
+			}
+
+			if updated.Status.TemplateGeneration != 2 {
+				t.Errorf("Expected status to reference template generation 2, got %d.", updated.Status.TemplateGeneration)
+			}
+
+			if !diff.SemanticallyEqual(tc.expectedStatus, updated.Status.Clusters) {
+				t.Fatalf("Diff:\n%s", diff.ObjectDiff(tc.expectedStatus, updated.Status.Clusters))
+			}
+		})
+	}
+}
+
+func TestReconcileKeepsInstanceWithRolloutPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	instance := genRolloutInstance(&kubermaticv1.ClusterTemplateRolloutPolicy{Strategy: kubermaticv1.ClusterTemplateRolloutStrategyNone})
+	instance.Spec.Replicas = 2
+
+	client := fake.NewClientBuilder().WithObjects(genRolloutTemplate(), instance).Build()
+	r := &reconciler{
+		log:        kubermaticlog.Logger,
+		seedClient: client,
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.Name}}
+
+	// the second reconciliation must not create the clusters again
+	for range 2 {
+		if _, err := r.Reconcile(ctx, request); err != nil {
+			t.Fatalf("reconciling failed: %v", err)
+		}
+	}
+
+	clusters := &kubermaticv1.ClusterList{}
+	if err := client.List(ctx, clusters); err != nil {
+		t.Fatalf("failed to list clusters: %v", err)
+	}
+
+	if len(clusters.Items) != 2 {
+		t.Errorf("Expected 2 clusters, got %d.", len(clusters.Items))
+	}
+
+	updated := &kubermaticv1.ClusterTemplateInstance{}
+	if err := client.Get(ctx, request.NamespacedName, updated); err != nil {
+		t.Fatalf("failed to get instance: %v", err)
+	}
+
+	if updated.Spec.Replicas != 2 {
+		t.Errorf("Expected replicas to remain 2, got %d.", updated.Spec.Replicas)
+	}
+
+	if updated.Status.CreatedClusters != 2 {
+		t.Errorf("Expected status to record 2 created clusters, got %d.", updated.Status.CreatedClusters)
+	}
+
+	if len(updated.Status.Clusters) != 2 {
+		t.Errorf("Expected status to contain 2 clusters, got %d.", len(updated.Status.Clusters))
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deletionreportcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	k8cuserclusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	"k8c.io/kubermatic/v2/pkg/clusterdeletion"
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ControllerName = "kkp-deletion-report-controller"
+
+	// ConfigMapName is the name of the ConfigMap in the cluster namespace that holds the report.
+	ConfigMapName = "cluster-deletion-report"
+
+	// ConfigMapKey is the key in the ConfigMap that holds the report as YAML.
+	ConfigMapKey = "report.yaml"
+)
+
+// userClusterConnectionProvider offers functions to retrieve clients for the given user clusters.
+type userClusterConnectionProvider interface {
+	GetClient(context.Context, *kubermaticv1.Cluster, ...k8cuserclusterclient.ConfigOption) (ctrlruntimeclient.Client, error)
+}
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	log                     *zap.SugaredLogger
+	userClusterConnProvider userClusterConnectionProvider
+	workerName              string
+	recorder                record.EventRecorder
+	now                     func() time.Time
+}
+
+func Add(
+	mgr manager.Manager,
+	log *zap.SugaredLogger,
+	numWorkers int,
+	workerName string,
+	userClusterConnProvider userClusterConnectionProvider,
+) error {
+	reconciler := &Reconciler{
+		Client:                  mgr.GetClient(),
+		log:                     log.Named(ControllerName),
+		userClusterConnProvider: userClusterConnProvider,
+		workerName:              workerName,
+		recorder:                mgr.GetEventRecorderFor(ControllerName),
+		now:                     time.Now,
+	}
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		For(&kubermaticv1.Cluster{}, builder.WithPredicates(predicateutil.Factory(func(o ctrlruntimeclient.Object) bool {
+			return reportRequested(o.(*kubermaticv1.Cluster))
+		}))).
+		Build(reconciler)
+
+	return err
+}
+
+func reportRequested(cluster *kubermaticv1.Cluster) bool {
+	_, requested := cluster.Annotations[kubermaticv1.DeletionReportAnnotation]
+	return requested
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("cluster", request.Name)
+	log.Debug("Reconciling")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Could not find cluster")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if cluster.DeletionTimestamp != nil || !reportRequested(cluster) {
+		return reconcile.Result{}, nil
+	}
+
+	// Creating a report does not modify the cluster, so paused clusters are not skipped;
+	// hence controllerutil.ClusterReconcileWrapper cannot be used.
+	if cluster.Labels[kubermaticv1.WorkerNameLabelKey] != r.workerName {
+		return reconcile.Result{}, nil
+	}
+
+	err := r.reconcile(ctx, log, cluster)
+	if err != nil {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "ReconcilingError", err.Error())
+	}
+
+	return reconcile.Result{}, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	if cluster.Status.NamespaceName == "" {
+		return errors.New("cluster has no namespace yet")
+	}
+
+	userClusterClientGetter := func() (ctrlruntimeclient.Client, error) {
+		client, err := r.userClusterConnProvider.GetClient(ctx, cluster)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user cluster client: %w", err)
+		}
+		return client, nil
+	}
+
+	report, err := clusterdeletion.New(r, r.recorder, userClusterClientGetter).Report(ctx, cluster, r.now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create deletion report: %w", err)
+	}
+
+	encoded, err := yaml.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode deletion report: %w", err)
+	}
+
+	factories := []reconciling.NamedConfigMapReconcilerFactory{
+		reportConfigMapReconciler(string(encoded)),
+	}
+
+	if err := reconciling.ReconcileConfigMaps(ctx, factories, cluster.Status.NamespaceName, r); err != nil {
+		return fmt.Errorf("failed to store deletion report: %w", err)
+	}
+
+	oldCluster := cluster.DeepCopy()
+	delete(cluster.Annotations, kubermaticv1.DeletionReportAnnotation)
+	if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+		return fmt.Errorf("failed to remove deletion report annotation: %w", err)
+	}
+
+	log.Infow("Created deletion report", "objects", len(report.Objects), "warnings", len(report.Warnings))
+
+	if len(report.Warnings) > 0 {
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "DeletionReportIncomplete", "The deletion report in ConfigMap %s/%s is incomplete, see its warnings.", cluster.Status.NamespaceName, ConfigMapName)
+	} else {
+		r.recorder.Eventf(cluster, corev1.EventTypeNormal, "DeletionReportCreated", "The deletion report has been written to ConfigMap %s/%s.", cluster.Status.NamespaceName, ConfigMapName)
+	}
+
+	return nil
+}
+
+func reportConfigMapReconciler(report string) reconciling.NamedConfigMapReconcilerFactory {
+	return func() (string, reconciling.ConfigMapReconciler) {
+		return ConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			cm.Data = map[string]string{
+				ConfigMapKey: report,
+			}
+
+			return cm, nil
+		}
+	}
+}
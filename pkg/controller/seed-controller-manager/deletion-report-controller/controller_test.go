@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deletionreportcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	"k8c.io/kubermatic/v2/pkg/clusterdeletion"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	clusterName      = "testcluster"
+	clusterNamespace = "cluster-testcluster"
+)
+
+type fakeClientProvider struct {
+	client ctrlruntimeclient.Client
+}
+
+func (f *fakeClientProvider) GetClient(ctx context.Context, c *kubermaticv1.Cluster, options ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+	return f.client, nil
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Annotations: map[string]string{
+				kubermaticv1.DeletionReportAnnotation: "",
+			},
+			Finalizers: []string{kubermaticv1.InClusterLBCleanupFinalizer},
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Pause: true,
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: clusterNamespace,
+		},
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "lb"},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	}
+
+	seedClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+	userClusterClient := fake.NewClientBuilder().WithObjects(service).Build()
+
+	r := &Reconciler{
+		Client:                  seedClient,
+		log:                     zap.NewNop().Sugar(),
+		userClusterConnProvider: &fakeClientProvider{client: userClusterClient},
+		recorder:                &record.FakeRecorder{},
+		now:                     time.Now,
+	}
+
+	if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterName}}); err != nil {
+		t.Fatalf("Reconciling failed: %v", err)
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Namespace: clusterNamespace, Name: ConfigMapName}, cm); err != nil {
+		t.Fatalf("Failed to get report ConfigMap: %v", err)
+	}
+
+	report := &clusterdeletion.Report{}
+	if err := yaml.Unmarshal([]byte(cm.Data[ConfigMapKey]), report); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+
+	if len(report.Objects) != 1 || report.Objects[0].Name != "lb" {
+		t.Errorf("Expected the LoadBalancer service to be reported, but got %+v", report.Objects)
+	}
+
+	if err := seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	if _, exists := cluster.Annotations[kubermaticv1.DeletionReportAnnotation]; exists {
+		t.Error("Expected the deletion report annotation to be removed.")
+	}
+
+	if err := userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(service), service); err != nil {
+		t.Errorf("Expected the service to be left alone: %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package deletionreportcontroller contains a controller that creates a dry-run report of
+everything that would be removed by deleting a user cluster, when the cluster is annotated
+with `kubermatic.k8c.io/deletion-report`.
+
+The report is created by walking the same steps as the cluster cleanup and contains the
+LoadBalancer services, volumes, machines, etcd backups, seed objects and pending cloud
+provider cleanups of the cluster. It is written as YAML into the `cluster-deletion-report`
+ConfigMap in the cluster namespace, after which the annotation is removed again.
+*/
+package deletionreportcontroller
@@ -22,7 +22,9 @@ related tasks.
 While updating the configuration and the kube-apiserver Pods happens in the
 `kubernetes_controller`, the `encryption_controller` will update the Cluster
 status according to changes observed in kube-apiserver and launch a re-encryption
-job based on the observed phase of the encryption process.
+job based on the observed phase of the encryption process. Both static keys
+(secretbox) and KMS v2 plugins are supported as encryption providers; the KMS
+plugin itself runs as a sidecar of kube-apiserver.
 */
 
 package encryptionatrestcontroller
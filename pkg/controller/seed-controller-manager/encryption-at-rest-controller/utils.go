@@ -99,16 +99,19 @@ func getActiveConfiguration(ctx context.Context, client ctrlruntimeclient.Client
 		}
 	}
 
-	// we expect two providers, (1) the configured encryption provider as per the ClusterSpec (secretbox or KMS plugins)
-	// and (2) the "identity" provider, which is there for reading (and if at the top of the list, writing) resources as
+	// we expect up to three providers, (1) the configured encryption provider as per the ClusterSpec (secretbox or KMS plugins),
+	// (2) optionally secretbox, if it is configured next to a KMS plugin to decrypt data that has not been migrated to KMS yet,
+	// and (3) the "identity" provider, which is there for reading (and if at the top of the list, writing) resources as
 	// unencrypted.
-	if len(config.Resources) != 1 || (len(config.Resources[0].Providers) != 1 && len(config.Resources[0].Providers) != 2) {
+	if len(config.Resources) != 1 || len(config.Resources[0].Providers) < 1 || len(config.Resources[0].Providers) > 3 {
 		return "", []string{}, errors.New("unexpected apiserverconfigv1.EncryptionConfiguration: too many items in .resources or .resources[0].providers")
 	}
 
 	providerConfig := &config.Resources[0].Providers[0]
 
 	switch {
+	case providerConfig.KMS != nil:
+		keyName = fmt.Sprintf("%s/%s", encryptionresources.KMSPrefix, providerConfig.KMS.Name)
 	case providerConfig.Secretbox != nil:
 		keyName = fmt.Sprintf("%s/%s", encryptionresources.SecretboxPrefix, providerConfig.Secretbox.Keys[0].Name)
 	case providerConfig.Identity != nil:
@@ -127,6 +130,8 @@ func getConfiguredKey(cluster *kubermaticv1.Cluster) (string, error) {
 	}
 
 	switch {
+	case cluster.Spec.EncryptionConfiguration.KMS != nil:
+		return fmt.Sprintf("%s/%s", encryptionresources.KMSPrefix, cluster.Spec.EncryptionConfiguration.KMS.Name), nil
 	case cluster.Spec.EncryptionConfiguration.Secretbox != nil:
 		return fmt.Sprintf("%s/%s", encryptionresources.SecretboxPrefix, cluster.Spec.EncryptionConfiguration.Secretbox.Keys[0].Name), nil
 	}
@@ -18,6 +18,8 @@ package etcdbackup
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -75,6 +77,10 @@ const (
 
 	// maximum number of simultaneously running backup delete jobs per BackupConfig.
 	maxSimultaneousDeleteJobsPerConfig = 3
+
+	// maximum number of simultaneously running backup verify jobs per BackupConfig. Verifying
+	// a backup means downloading and restoring it, so this is kept low on purpose.
+	maxSimultaneousVerifyJobsPerConfig = 1
 )
 
 // Reconciler stores necessary components that are required to create etcd backups.
@@ -269,6 +275,12 @@ func (r *Reconciler) reconcile(
 
 	totalReconcile = minReconcile(totalReconcile, nextReconcile)
 
+	if nextReconcile, err = r.startPendingBackupVerifyJobs(ctx, data, backupConfig); err != nil {
+		return nil, fmt.Errorf("failed to start pending and update running backup verifications: %w", err)
+	}
+
+	totalReconcile = minReconcile(totalReconcile, nextReconcile)
+
 	if nextReconcile, err = r.startPendingBackupDeleteJobs(ctx, data, backupConfig); err != nil {
 		return nil, fmt.Errorf("failed to start pending backup delete jobs: %w", err)
 	}
@@ -316,6 +328,13 @@ func getBackupDeleteContainer(cfg *kubermaticv1.KubermaticConfiguration) (*corev
 	return container, customContainer != "", err
 }
 
+func getBackupObjectName(cfg *kubermaticv1.KubermaticConfiguration) string {
+	if cfg.Spec.SeedController.BackupObjectName != "" {
+		return cfg.Spec.SeedController.BackupObjectName
+	}
+	return defaulting.DefaultBackupObjectName
+}
+
 func minReconcile(reconciles ...*reconcile.Result) *reconcile.Result {
 	var result *reconcile.Result
 	for _, r := range reconciles {
@@ -544,6 +563,119 @@ func (r *Reconciler) startPendingBackupJobs(ctx context.Context, data *resources
 	return returnReconcile, nil
 }
 
+// create verify jobs for all completed backups that have not been verified yet, if verification
+// is enabled for the backupConfig. Also update the status of verifications whose jobs have completed.
+func (r *Reconciler) startPendingBackupVerifyJobs(ctx context.Context, data *resources.TemplateData, backupConfig *kubermaticv1.EtcdBackupConfig) (*reconcile.Result, error) {
+	var returnReconcile *reconcile.Result
+
+	oldBackupConfig := backupConfig.DeepCopy()
+
+	runningVerifyJobsCount := 0
+	for i := range backupConfig.Status.CurrentBackups {
+		backup := &backupConfig.Status.CurrentBackups[i]
+		if backup.VerifyPhase != kubermaticv1.BackupStatusPhaseRunning {
+			continue
+		}
+
+		job := &batchv1.Job{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: backup.VerifyJobName}, job)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting verify job for backup %s: %w", backup.BackupName, err)
+			}
+			// job not found. Apparently deleted externally.
+			backup.VerifyPhase = kubermaticv1.BackupStatusPhaseFailed
+			backup.VerifyMessage = "verify job deleted externally"
+			backup.VerifyFinishedTime = metav1.NewTime(r.clock.Now())
+		} else {
+			if cond := getJobConditionIfTrue(job, batchv1.JobComplete); cond != nil {
+				verification, err := r.getBackupVerification(ctx, job)
+				if err != nil {
+					backup.VerifyPhase = kubermaticv1.BackupStatusPhaseFailed
+					backup.VerifyMessage = fmt.Sprintf("failed to read verification result: %v", err)
+				} else {
+					backup.VerifyPhase = kubermaticv1.BackupStatusPhaseVerified
+					backup.VerifyMessage = cond.Message
+					backup.Verification = verification
+				}
+				backup.VerifyFinishedTime = cond.LastTransitionTime
+			} else if cond := getJobConditionIfTrue(job, batchv1.JobFailed); cond != nil {
+				backup.VerifyPhase = kubermaticv1.BackupStatusPhaseFailed
+				backup.VerifyMessage = cond.Message
+				backup.VerifyFinishedTime = cond.LastTransitionTime
+			} else {
+				// job still running
+				runningVerifyJobsCount++
+				returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: assumedJobRuntime})
+			}
+		}
+	}
+
+	if backupConfig.Spec.Verify && backupConfig.DeletionTimestamp == nil {
+		for i := range backupConfig.Status.CurrentBackups {
+			if runningVerifyJobsCount >= maxSimultaneousVerifyJobsPerConfig {
+				break
+			}
+
+			backup := &backupConfig.Status.CurrentBackups[i]
+			if backup.BackupPhase != kubermaticv1.BackupStatusPhaseCompleted || backup.VerifyPhase != "" || backup.DeletePhase != "" {
+				continue
+			}
+
+			if backup.VerifyJobName == "" {
+				backup.VerifyJobName = r.limitNameLength(fmt.Sprintf("%s-backup-%s-verify-%s", data.Cluster().Name, backupConfig.Name, r.randStringGenerator()))
+			}
+
+			job := etcdbackup.BackupVerifyJob(data, backupConfig, backup)
+			if err := r.Create(ctx, job); ctrlruntimeclient.IgnoreAlreadyExists(err) != nil {
+				return nil, fmt.Errorf("error creating verify job for backup %s: %w", backup.BackupName, err)
+			}
+
+			backup.VerifyPhase = kubermaticv1.BackupStatusPhaseRunning
+			backup.VerifyStartTime = metav1.NewTime(r.clock.Now())
+			runningVerifyJobsCount++
+			returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: assumedJobRuntime})
+		}
+	}
+
+	if err := r.Status().Patch(ctx, backupConfig, ctrlruntimeclient.MergeFrom(oldBackupConfig)); err != nil {
+		return nil, fmt.Errorf("failed to update backup status: %w", err)
+	}
+
+	return returnReconcile, nil
+}
+
+// getBackupVerification reads the verification result, which the verify job writes into the
+// termination message of its succeeded pod.
+func (r *Reconciler) getBackupVerification(ctx context.Context, job *batchv1.Job) (*kubermaticv1.BackupVerification, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, ctrlruntimeclient.InNamespace(job.Namespace), ctrlruntimeclient.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+
+		for _, status := range pod.Status.ContainerStatuses {
+			terminated := status.State.Terminated
+			if terminated == nil || terminated.ExitCode != 0 || terminated.Message == "" {
+				continue
+			}
+
+			verification := &kubermaticv1.BackupVerification{}
+			if err := json.Unmarshal([]byte(terminated.Message), verification); err != nil {
+				return nil, fmt.Errorf("failed to decode termination message of pod %s: %w", pod.Name, err)
+			}
+
+			return verification, nil
+		}
+	}
+
+	return nil, errors.New("no succeeded pod with a verification result found")
+}
+
 // create any backup delete jobs that can be created, i.e. for all completed backups older than the last backupConfig.GetKeptBackupsCount() ones.
 func (r *Reconciler) startPendingBackupDeleteJobs(ctx context.Context, data *resources.TemplateData, backupConfig *kubermaticv1.EtcdBackupConfig) (*reconcile.Result, error) {
 	// one-shot backups are not deleted until their backupConfig is deleted
@@ -555,6 +687,9 @@ func (r *Reconciler) startPendingBackupDeleteJobs(ctx context.Context, data *res
 	keepCount := backupConfig.GetKeptBackupsCount()
 	if backupConfig.DeletionTimestamp != nil {
 		keepCount = 0
+		if backupConfig.Spec.RetainBackups {
+			keepCount = len(backupConfig.Status.CurrentBackups)
+		}
 	}
 	kept := 0
 	runningDeleteJobsCount := 0
@@ -567,7 +702,8 @@ func (r *Reconciler) startPendingBackupDeleteJobs(ctx context.Context, data *res
 			backupsToDelete = append(backupsToDelete, backup)
 		} else if backup.BackupPhase == kubermaticv1.BackupStatusPhaseCompleted {
 			kept++
-			if kept > keepCount && backup.DeletePhase == "" {
+			// a running verification is not interrupted, the backup will be deleted once it has finished
+			if kept > keepCount && backup.DeletePhase == "" && backup.VerifyPhase != kubermaticv1.BackupStatusPhaseRunning {
 				backupsToDelete = append(backupsToDelete, backup)
 			}
 		}
@@ -734,7 +870,9 @@ func (r *Reconciler) deleteFinishedBackupJobs(ctx context.Context, log *zap.Suga
 			}
 		}
 
-		deleteJobDeleted := false
+		// retained backups are never deleted and so there is no delete job to wait for
+		deleteJobDeleted := backupConfig.DeletionTimestamp != nil && backupConfig.Spec.RetainBackups &&
+			backup.BackupPhase == kubermaticv1.BackupStatusPhaseCompleted && backup.DeletePhase == ""
 		if !backup.DeleteFinishedTime.IsZero() {
 			var retentionTime time.Duration
 			if !backupConfig.DeletionTimestamp.IsZero() {
@@ -768,7 +906,45 @@ func (r *Reconciler) deleteFinishedBackupJobs(ctx context.Context, log *zap.Suga
 			}
 		}
 
-		if backupJobDeleted && deleteJobDeleted {
+		// backups that were never verified have no verify job
+		verifyJobDeleted := backup.VerifyJobName == ""
+		if !backup.VerifyFinishedTime.IsZero() {
+			var retentionTime time.Duration
+			switch {
+			case !backupConfig.DeletionTimestamp.IsZero():
+				retentionTime = 0
+			case backup.VerifyPhase == kubermaticv1.BackupStatusPhaseVerified:
+				retentionTime = succeededJobRetentionTime
+			default:
+				retentionTime = failedJobRetentionTime
+			}
+
+			age := r.clock.Now().Sub(backup.VerifyFinishedTime.Time)
+
+			if age < retentionTime {
+				// don't delete the job yet, but reconcile when the time has come to delete it
+				returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: retentionTime - age})
+			} else {
+				// delete job
+				job := &batchv1.Job{}
+
+				err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: backup.VerifyJobName}, job)
+				switch {
+				case apierrors.IsNotFound(err):
+					verifyJobDeleted = true
+				case err == nil:
+					err := r.Delete(ctx, job, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground))
+					if err != nil && !apierrors.IsNotFound(err) {
+						return nil, fmt.Errorf("backup %s: failed to delete verify job %s: %w", backup.BackupName, backup.VerifyJobName, err)
+					}
+					verifyJobDeleted = true
+				case !apierrors.IsNotFound(err):
+					return nil, fmt.Errorf("backup %s: failed to get verify job %s: %w", backup.BackupName, backup.VerifyJobName, err)
+				}
+			}
+		}
+
+		if backupJobDeleted && deleteJobDeleted && verifyJobDeleted {
 			// don't add backup to newBackups, which ends up deleting it from backupConfig.Status.CurrentBackups below
 			modified = true
 			continue
@@ -812,14 +988,19 @@ func (r *Reconciler) ensureSecrets(ctx context.Context, cluster *kubermaticv1.Cl
 		return resources.GetClusterRootCA(ctx, cluster.Status.NamespaceName, r)
 	}
 
+	getCABundle := func() ([]byte, error) {
+		return resources.GetClusterRootCABundle(ctx, cluster.Status.NamespaceName, r)
+	}
+
 	creators := []reconciling.NamedSecretReconcilerFactory{
-		certificates.GetClientCertificateReconciler(
+		certificates.GetClientCertificateWithCABundleReconciler(
 			secretName,
 			"backup",
 			nil,
 			resources.BackupEtcdClientCertificateCertSecretKey,
 			resources.BackupEtcdClientCertificateKeySecretKey,
 			getCA,
+			getCABundle,
 		),
 	}
 
@@ -911,6 +1092,7 @@ func (r *Reconciler) getClusterTemplateData(ctx context.Context, cluster *kuberm
 		WithEtcdLauncherImage(r.etcdLauncherImage).
 		WithEtcdBackupStoreContainer(storeContainer, customStoreContainer).
 		WithEtcdBackupDeleteContainer(deleteContainer, customBackupContainer).
+		WithEtcdBackupObjectName(getBackupObjectName(config)).
 		WithEtcdBackupDestination(destination).
 		Build(), nil
 }
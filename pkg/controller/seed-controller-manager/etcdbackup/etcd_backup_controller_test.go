@@ -45,6 +45,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	clocktesting "k8s.io/utils/clock/testing"
@@ -163,6 +164,48 @@ func genBackupDeleteJob(data *resources.TemplateData, backupName, jobName string
 	return job
 }
 
+func genBackupVerifyJob(data *resources.TemplateData, backupName, jobName string) *batchv1.Job {
+	// same thing as genBackupJob, but for verify jobs
+	cluster := genTestCluster()
+	backupConfig := genBackupConfig(cluster, "testbackup")
+	backup := &kubermaticv1.BackupStatus{
+		BackupName:    backupName,
+		VerifyJobName: jobName,
+	}
+
+	job := etcdbackup.BackupVerifyJob(data, backupConfig, backup)
+	job.ResourceVersion = "1"
+	// remove all env variables from the job so they're comparable against the
+	// ones we get from fake clusters during tests, where we strip the variables too
+	job.Spec.Template.Spec.Containers[0].Env = nil
+	return job
+}
+
+func genBackupVerifyPod(jobName string, phase corev1.PodPhase, message string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName + "-pod",
+			Namespace: metav1.NamespaceSystem,
+			Labels: map[string]string{
+				batchv1.JobNameLabel: jobName,
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "backup-verifier",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							Message: message,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func jobAddCondition(j *batchv1.Job, jobType batchv1.JobConditionType, status corev1.ConditionStatus, lastTransitionTime time.Time, message string) *batchv1.Job {
 	j.Status.Conditions = append(j.Status.Conditions, batchv1.JobCondition{
 		Type:               jobType,
@@ -658,6 +701,212 @@ func TestStartPendingBackupJobs(t *testing.T) {
 	}
 }
 
+func TestStartPendingBackupVerifyJobs(t *testing.T) {
+	completedBackup := func(name string) kubermaticv1.BackupStatus {
+		return kubermaticv1.BackupStatus{
+			ScheduledTime:      metav1.NewTime(time.Unix(60, 0).UTC()),
+			BackupName:         name,
+			JobName:            "testcluster-backup-testbackup-create-" + name,
+			DeleteJobName:      "testcluster-backup-testbackup-delete-" + name,
+			BackupPhase:        kubermaticv1.BackupStatusPhaseCompleted,
+			BackupFinishedTime: metav1.NewTime(time.Unix(70, 0).UTC()),
+		}
+	}
+
+	verifyingBackup := func(name string) kubermaticv1.BackupStatus {
+		backup := completedBackup(name)
+		backup.VerifyJobName = "testcluster-backup-testbackup-verify-" + name
+		backup.VerifyPhase = kubermaticv1.BackupStatusPhaseRunning
+		backup.VerifyStartTime = metav1.NewTime(time.Unix(80, 0).UTC())
+		return backup
+	}
+
+	testCases := []struct {
+		name              string
+		verify            bool
+		existingBackups   []kubermaticv1.BackupStatus
+		existingJobs      jobFunc
+		existingPods      []corev1.Pod
+		expectedBackups   []kubermaticv1.BackupStatus
+		expectedReconcile *reconcile.Result
+		expectedJobs      jobFunc
+	}{
+		{
+			name:            "no verify job is started if verification is disabled",
+			verify:          false,
+			existingBackups: []kubermaticv1.BackupStatus{completedBackup("aaaa")},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{}
+			},
+			expectedBackups:   []kubermaticv1.BackupStatus{completedBackup("aaaa")},
+			expectedReconcile: nil,
+			expectedJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{}
+			},
+		},
+		{
+			name:            "verify job is started for a single completed backup at a time",
+			verify:          true,
+			existingBackups: []kubermaticv1.BackupStatus{completedBackup("aaaa"), completedBackup("bbbb")},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{}
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					backup := verifyingBackup("aaaa")
+					backup.VerifyJobName = "testcluster-backup-testbackup-verify-xxxx"
+					backup.VerifyStartTime = metav1.NewTime(time.Unix(90, 0).UTC())
+					return backup
+				}(),
+				completedBackup("bbbb"),
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: assumedJobRuntime},
+			expectedJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*genBackupVerifyJob(data, "aaaa", "testcluster-backup-testbackup-verify-xxxx"),
+				}
+			},
+		},
+		{
+			name:            "successful verification is recorded in the backup status",
+			verify:          true,
+			existingBackups: []kubermaticv1.BackupStatus{verifyingBackup("aaaa")},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genBackupVerifyJob(data, "aaaa", "testcluster-backup-testbackup-verify-aaaa"),
+						batchv1.JobComplete, corev1.ConditionTrue, time.Unix(85, 0).UTC(), "job completed"),
+				}
+			},
+			existingPods: []corev1.Pod{
+				*genBackupVerifyPod("testcluster-backup-testbackup-verify-aaaa", corev1.PodSucceeded, `{"revision":42,"keyCount":7,"hash":"deadbeef","size":1024}`),
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					backup := verifyingBackup("aaaa")
+					backup.VerifyPhase = kubermaticv1.BackupStatusPhaseVerified
+					backup.VerifyMessage = "job completed"
+					backup.VerifyFinishedTime = metav1.NewTime(time.Unix(85, 0).UTC())
+					backup.Verification = &kubermaticv1.BackupVerification{
+						Revision: 42,
+						KeyCount: 7,
+						Hash:     "deadbeef",
+						Size:     1024,
+					}
+					return backup
+				}(),
+			},
+			expectedReconcile: nil,
+			expectedJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genBackupVerifyJob(data, "aaaa", "testcluster-backup-testbackup-verify-aaaa"),
+						batchv1.JobComplete, corev1.ConditionTrue, time.Unix(85, 0).UTC(), "job completed"),
+				}
+			},
+		},
+		{
+			name:            "failed verification is recorded in the backup status",
+			verify:          true,
+			existingBackups: []kubermaticv1.BackupStatus{verifyingBackup("aaaa")},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genBackupVerifyJob(data, "aaaa", "testcluster-backup-testbackup-verify-aaaa"),
+						batchv1.JobFailed, corev1.ConditionTrue, time.Unix(85, 0).UTC(), "Job has reached the specified backoff limit"),
+				}
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					backup := verifyingBackup("aaaa")
+					backup.VerifyPhase = kubermaticv1.BackupStatusPhaseFailed
+					backup.VerifyMessage = "Job has reached the specified backoff limit"
+					backup.VerifyFinishedTime = metav1.NewTime(time.Unix(85, 0).UTC())
+					return backup
+				}(),
+			},
+			expectedReconcile: nil,
+			expectedJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genBackupVerifyJob(data, "aaaa", "testcluster-backup-testbackup-verify-aaaa"),
+						batchv1.JobFailed, corev1.ConditionTrue, time.Unix(85, 0).UTC(), "Job has reached the specified backoff limit"),
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			cluster := genTestCluster()
+			backupConfig := genBackupConfig(cluster, "testbackup")
+			backupConfig.Spec.Verify = tc.verify
+
+			clock := clocktesting.NewFakeClock(time.Unix(90, 0).UTC())
+			backupConfig.SetCreationTimestamp(metav1.Time{Time: clock.Now()})
+			backupConfig.Status.CurrentBackups = tc.existingBackups
+
+			td := resources.NewTemplateDataBuilder().
+				WithContext(ctx).
+				WithCluster(cluster).
+				WithVersions(kubermatic.GetFakeVersions()).
+				WithEtcdLauncherImage(defaulting.DefaultEtcdLauncherImage).
+				WithEtcdBackupStoreContainer(genStoreContainer(), false).
+				WithEtcdBackupDeleteContainer(genDeleteContainer(), false).
+				WithEtcdBackupObjectName(defaulting.DefaultBackupObjectName).
+				WithEtcdBackupDestination(genDefaultBackupDestination()).
+				Build()
+
+			initObjs := []ctrlruntimeclient.Object{
+				cluster,
+				backupConfig,
+			}
+			for _, j := range tc.existingJobs(td) {
+				initObjs = append(initObjs, j.DeepCopy())
+			}
+			for _, p := range tc.existingPods {
+				initObjs = append(initObjs, p.DeepCopy())
+			}
+
+			fc := fake.NewClientBuilder().WithObjects(initObjs...).Build()
+
+			reconciler := Reconciler{
+				log:                 kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+				Client:              fc,
+				scheme:              scheme.Scheme,
+				recorder:            record.NewFakeRecorder(10),
+				clock:               clock,
+				randStringGenerator: constRandStringGenerator("xxxx"),
+				seedGetter: func() (*kubermaticv1.Seed, error) {
+					return generator.GenTestSeed(), nil
+				},
+				configGetter: getConfigGetter(t),
+			}
+
+			reconcileAfter, err := reconciler.startPendingBackupVerifyJobs(ctx, td, backupConfig)
+			if err != nil {
+				t.Fatalf("startPendingBackupVerifyJobs returned an error: %v", err)
+			}
+
+			readbackBackupConfig := &kubermaticv1.EtcdBackupConfig{}
+			if err := reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: backupConfig.GetNamespace(), Name: backupConfig.GetName()}, readbackBackupConfig); err != nil {
+				t.Fatalf("Error reading back completed backupConfig: %v", err)
+			}
+
+			if d := diff.ObjectDiff(tc.expectedBackups, readbackBackupConfig.Status.CurrentBackups); d != "" {
+				t.Errorf("backupsConfig status differs from read back one:\n%v", d)
+			}
+
+			if d := diff.ObjectDiff(tc.expectedJobs(td), getSortedJobs(t, reconciler)); d != "" {
+				t.Errorf("jobs differ from expected ones:\n%v", d)
+			}
+
+			if !diff.SemanticallyEqual(reconcileAfter, tc.expectedReconcile) {
+				t.Errorf("reconcile time differs from expected, expected: %v, actual: %v", tc.expectedReconcile, reconcileAfter)
+			}
+		})
+	}
+}
+
 func TestStartPendingBackupDeleteJobs(t *testing.T) {
 	testCases := []struct {
 		name              string
@@ -1612,6 +1861,68 @@ func TestDeleteFinishedBackupJobs(t *testing.T) {
 	}
 }
 
+func TestRetainedBackupsAreNotDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := genTestCluster()
+	backupConfig := genBackupConfig(cluster, "testbackup")
+
+	clock := clocktesting.NewFakeClock(time.Unix(600, 0).UTC())
+	backupConfig.SetCreationTimestamp(metav1.NewTime(time.Unix(0, 0).UTC()))
+	backupConfig.SetDeletionTimestamp(&metav1.Time{Time: clock.Now()})
+	backupConfig.SetFinalizers([]string{DeleteAllBackupsFinalizer})
+	backupConfig.Spec.RetainBackups = true
+	backupConfig.Status.CurrentBackups = []kubermaticv1.BackupStatus{
+		{
+			ScheduledTime:      metav1.NewTime(time.Unix(60, 0).UTC()),
+			BackupName:         "testbackup.db",
+			JobName:            "testcluster-backup-testbackup-create-aaaa",
+			BackupFinishedTime: metav1.NewTime(time.Unix(90, 0).UTC()),
+			BackupPhase:        kubermaticv1.BackupStatusPhaseCompleted,
+			BackupMessage:      "job completed",
+			DeleteJobName:      "testcluster-backup-testbackup-delete-aaaa",
+		},
+	}
+
+	td := resources.NewTemplateDataBuilder().
+		WithContext(ctx).
+		WithCluster(cluster).
+		WithVersions(kubermatic.GetFakeVersions()).
+		WithEtcdLauncherImage(defaulting.DefaultEtcdLauncherImage).
+		WithEtcdBackupStoreContainer(genStoreContainer(), false).
+		WithEtcdBackupDeleteContainer(genDeleteContainer(), false).
+		WithEtcdBackupDestination(genDefaultBackupDestination()).
+		Build()
+
+	reconciler := Reconciler{
+		log:      kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+		Client:   fake.NewClientBuilder().WithObjects(cluster, backupConfig).Build(),
+		scheme:   scheme.Scheme,
+		recorder: record.NewFakeRecorder(10),
+		clock:    clock,
+		seedGetter: func() (*kubermaticv1.Seed, error) {
+			return generator.GenTestSeed(), nil
+		},
+		configGetter: getConfigGetter(t),
+	}
+
+	if _, err := reconciler.startPendingBackupDeleteJobs(ctx, td, backupConfig); err != nil {
+		t.Fatalf("startPendingBackupDeleteJobs returned an error: %v", err)
+	}
+
+	if jobs := getSortedJobs(t, reconciler); len(jobs) > 0 {
+		t.Fatalf("Expected no delete jobs for retained backups, but got %d.", len(jobs))
+	}
+
+	if _, err := reconciler.deleteFinishedBackupJobs(ctx, reconciler.log, backupConfig, cluster); err != nil {
+		t.Fatalf("deleteFinishedBackupJobs returned an error: %v", err)
+	}
+
+	if len(backupConfig.Status.CurrentBackups) > 0 {
+		t.Fatalf("Expected retained backup to be removed from the status, but got %v.", backupConfig.Status.CurrentBackups)
+	}
+}
+
 func getSortedJobs(t *testing.T, reconciler Reconciler) []batchv1.Job {
 	jobList := batchv1.JobList{}
 	if err := reconciler.List(context.Background(), &jobList); err != nil {
@@ -1646,11 +1957,12 @@ func constRandStringGenerator(str string) func() string {
 
 func TestMultipleBackupDestination(t *testing.T) {
 	testCases := []struct {
-		name               string
-		backupConfig       *kubermaticv1.EtcdBackupConfig
-		expectedReconcile  *reconcile.Result
-		expectedJobEnvVars []corev1.EnvVar
-		expectedErr        string
+		name                   string
+		backupConfig           *kubermaticv1.EtcdBackupConfig
+		expectedReconcile      *reconcile.Result
+		expectedJobEnvVars     []corev1.EnvVar
+		expectedCreatorEnvVars []corev1.EnvVar
+		expectedErr            string
 	}{
 		{
 			name: "test reconcile with specified backup destination",
@@ -1672,6 +1984,28 @@ func TestMultipleBackupDestination(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test reconcile with encrypted backup destination",
+			backupConfig: func() *kubermaticv1.EtcdBackupConfig {
+				c := genBackupConfig(genTestCluster(), "testbackup")
+				c.Spec.Destination = "encrypted"
+				return c
+			}(),
+			expectedJobEnvVars: []corev1.EnvVar{
+				etcdbackup.GenSecretEnvVar(etcdbackup.AccessKeyIDEnvVarKey, etcdbackup.AccessKeyIDEnvVarKey, genEncryptedBackupDestination()),
+			},
+			expectedCreatorEnvVars: []corev1.EnvVar{
+				{
+					Name: etcdbackup.EncryptionKeyEnvVarKey,
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "backup-encryption"},
+							Key:                  "key",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "backup should fail if destination has no credentials set",
 			backupConfig: func() *kubermaticv1.EtcdBackupConfig {
@@ -1747,6 +2081,17 @@ func TestMultipleBackupDestination(t *testing.T) {
 					t.Fatalf("expected job env vars %v to contain %v", envVars, expectedEnvVar)
 				}
 			}
+
+			creatorEnvVars := job.Spec.Template.Spec.InitContainers[0].Env
+			if len(creatorEnvVars) != len(tc.expectedCreatorEnvVars) {
+				t.Fatalf("expected backup-creator env vars %v, got %v", tc.expectedCreatorEnvVars, creatorEnvVars)
+			}
+
+			for _, expectedEnvVar := range tc.expectedCreatorEnvVars {
+				if !containsEnvVar(creatorEnvVars, expectedEnvVar) {
+					t.Fatalf("expected backup-creator env vars %v to contain %v", creatorEnvVars, expectedEnvVar)
+				}
+			}
 		})
 	}
 }
@@ -1755,7 +2100,8 @@ func addSeedDestinations(seed *kubermaticv1.Seed) {
 	seed.Spec.EtcdBackupRestore = &kubermaticv1.EtcdBackupRestore{
 		DefaultDestination: "s3",
 		Destinations: map[string]*kubermaticv1.BackupDestination{
-			"s3": genDefaultBackupDestination(),
+			"s3":        genDefaultBackupDestination(),
+			"encrypted": genEncryptedBackupDestination(),
 			"no-credentials": {
 				BucketName: "no-cred",
 				Endpoint:   "no-cred.com",
@@ -1775,6 +2121,18 @@ func genDefaultBackupDestination() *kubermaticv1.BackupDestination {
 	}
 }
 
+func genEncryptedBackupDestination() *kubermaticv1.BackupDestination {
+	destination := genDefaultBackupDestination()
+	destination.Encryption = &kubermaticv1.BackupEncryption{
+		KeySecret: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "backup-encryption"},
+			Key:                  "key",
+		},
+	}
+
+	return destination
+}
+
 func containsEnvVar(envVars []corev1.EnvVar, envVar corev1.EnvVar) bool {
 	for _, e := range envVars {
 		if len(deep.Equal(e, envVar)) == 0 {
@@ -1784,6 +2142,51 @@ func containsEnvVar(envVars []corev1.EnvVar, envVar corev1.EnvVar) bool {
 	return false
 }
 
+func TestBackupVerifyJob(t *testing.T) {
+	cluster := genTestCluster()
+	backupConfig := genBackupConfig(cluster, "testbackup")
+	backup := &kubermaticv1.BackupStatus{
+		BackupName:    "testbackup-2026-01-01t00-00-00",
+		VerifyJobName: "testcluster-backup-testbackup-verify-aaaa",
+	}
+
+	destination := genDefaultBackupDestination()
+	destination.Endpoint = "http://minio.example.com"
+
+	data := resources.NewTemplateDataBuilder().
+		WithContext(context.Background()).
+		WithCluster(cluster).
+		WithVersions(kubermatic.GetFakeVersions()).
+		WithEtcdLauncherImage(defaulting.DefaultEtcdLauncherImage).
+		WithEtcdBackupObjectName("backups/$(CLUSTER)/$(BACKUP_TO_CREATE)").
+		WithEtcdBackupDestination(destination).
+		Build()
+
+	container := etcdbackup.BackupVerifyJob(data, backupConfig, backup).Spec.Template.Spec.Containers[0]
+
+	objectFlag := "--object=backups/$(CLUSTER)/$(BACKUP_TO_CREATE)"
+	if !sets.New(container.Command...).Has(objectFlag) {
+		t.Errorf("Expected command to contain %q, but got %v.", objectFlag, container.Command)
+	}
+
+	env := map[string]string{}
+	for _, envVar := range container.Env {
+		env[envVar.Name] = envVar.Value
+	}
+
+	expected := map[string]string{
+		"CLUSTER":          cluster.Name,
+		"BACKUP_TO_CREATE": backup.BackupName,
+		"INSECURE":         "true",
+	}
+
+	for name, value := range expected {
+		if env[name] != value {
+			t.Errorf("Expected %s to be %q, but got %q.", name, value, env[name])
+		}
+	}
+}
+
 func TestIsInsecure(t *testing.T) {
 	testcases := []struct {
 		url      string
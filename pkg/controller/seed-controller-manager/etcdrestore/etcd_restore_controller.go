@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/util/backupencryption"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -214,6 +216,22 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, rest
 		return nil, fmt.Errorf("could not access backup object %s: %w", objectName, err)
 	}
 
+	// fail early if the backup is encrypted, but etcd-launcher would not be able to decrypt it
+	encrypted, err := isBackupEncrypted(ctx, s3Client, bucketName, objectName)
+	if err != nil {
+		return nil, fmt.Errorf("could not read backup object %s: %w", objectName, err)
+	}
+
+	if encrypted {
+		key, err := resources.GetEtcdRestoreEncryptionKey(ctx, restore, r, cluster)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get encryption key: %w", err)
+		}
+		if key == nil {
+			return nil, fmt.Errorf("backup object %s is encrypted, but no encryption key is configured for the backup destination", objectName)
+		}
+	}
+
 	// before proceeding, ensure restore's namespace/name is stored in the ActiveRestoreAnnotationName cluster annotation
 	// unless some other restore is already stored there
 	thisRestore := fmt.Sprintf("%s/%s", restore.Namespace, restore.Name)
@@ -400,3 +418,24 @@ func (r *Reconciler) updateRestore(ctx context.Context, restore *kubermaticv1.Et
 
 	return nil
 }
+
+// isBackupEncrypted checks whether the given backup object has been encrypted by reading its header.
+func isBackupEncrypted(ctx context.Context, s3Client *minio.Client, bucketName, objectName string) (bool, error) {
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(0, int64(backupencryption.PrefixSize()-1)); err != nil {
+		return false, err
+	}
+
+	object, err := s3Client.GetObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return false, err
+	}
+	defer object.Close()
+
+	header, err := io.ReadAll(object)
+	if err != nil {
+		return false, err
+	}
+
+	return backupencryption.IsEncrypted(header), nil
+}
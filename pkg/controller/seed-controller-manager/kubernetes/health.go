@@ -19,18 +19,23 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/applications"
 	"k8c.io/kubermatic/v2/pkg/controller/util"
 	kyvernocommonseedresources "k8c.io/kubermatic/v2/pkg/ee/kyverno/resources/seed-cluster/common"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (r *Reconciler) clusterHealth(ctx context.Context, cluster *kubermaticv1.Cluster) (*kubermaticv1.ExtendedClusterHealth, error) {
@@ -134,6 +139,13 @@ func (r *Reconciler) clusterHealth(ctx context.Context, cluster *kubermaticv1.Cl
 		extendedHealth.Kyverno = &status
 	}
 
+	certificatesStatus, expiringCertificates, err := r.certificateHealthCheck(ctx, ns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate health: %w", err)
+	}
+	extendedHealth.Certificates = &certificatesStatus
+	extendedHealth.ExpiringCertificates = expiringCertificates
+
 	return extendedHealth, nil
 }
 
@@ -143,9 +155,25 @@ func (r *Reconciler) syncHealth(ctx context.Context, cluster *kubermaticv1.Clust
 		return err
 	}
 
+	resizeStatus, resizeReason, resizeMessage, err := r.etcdResizeProgress(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to determine etcd resize progress: %w", err)
+	}
+
 	return util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 		c.Status.ExtendedHealth = *extendedHealth
 
+		if resizeStatus != "" {
+			util.SetClusterCondition(
+				c,
+				r.versions,
+				kubermaticv1.ClusterConditionEtcdClusterResized,
+				resizeStatus,
+				resizeReason,
+				resizeMessage,
+			)
+		}
+
 		// set ClusterConditionEtcdClusterInitialized, this should be done only once
 		// when etcd becomes healthy for the first time.
 		if extendedHealth.Etcd == kubermaticv1.HealthStatusUp {
@@ -172,6 +200,44 @@ func (r *Reconciler) syncHealth(ctx context.Context, cluster *kubermaticv1.Clust
 	})
 }
 
+// etcdResizeProgress compares the etcd StatefulSet to the configured etcd cluster size. The
+// etcd-launcher only reports new members as ready once they have been promoted from learners
+// to voting members, so the ring has been resized once all configured replicas are ready.
+// An empty status is returned if the cluster size cannot be changed or is not known yet.
+func (r *Reconciler) etcdResizeProgress(ctx context.Context, cluster *kubermaticv1.Cluster) (corev1.ConditionStatus, string, string, error) {
+	if !cluster.Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
+		return "", "", "", nil
+	}
+
+	sts := &appsv1.StatefulSet{}
+	key := types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.EtcdStatefulSetName}
+	if err := r.Get(ctx, key, sts); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", "", "", nil
+		}
+
+		return "", "", "", err
+	}
+
+	if sts.Spec.Replicas == nil {
+		return "", "", "", nil
+	}
+
+	desired := etcd.GetClusterSize(cluster.Spec.ComponentsOverride.Etcd)
+	replicas := *sts.Spec.Replicas
+
+	if replicas == desired && sts.Status.ReadyReplicas == desired {
+		return corev1.ConditionTrue, kubermaticv1.ReasonEtcdClusterResized, fmt.Sprintf("Etcd cluster has %d members", desired), nil
+	}
+
+	message := fmt.Sprintf("Resizing etcd cluster from %d to %d members, %d of %d members are ready", replicas, desired, sts.Status.ReadyReplicas, replicas)
+	if replicas == desired {
+		message = fmt.Sprintf("Waiting for etcd members to become ready, %d of %d members are ready", sts.Status.ReadyReplicas, replicas)
+	}
+
+	return corev1.ConditionFalse, kubermaticv1.ReasonEtcdClusterResizing, message, nil
+}
+
 func (r *Reconciler) machineControllerHealthCheck(ctx context.Context, cluster *kubermaticv1.Cluster, namespace string) (kubermaticv1.HealthStatus, error) {
 	userClient, err := r.userClusterConnProvider.GetClient(ctx, cluster)
 	if err != nil {
@@ -225,6 +291,40 @@ func (r *Reconciler) operatingSystemManagerHealthCheck(ctx context.Context, clus
 	return status, nil
 }
 
+// certificateHealthCheck reports all certificates in the cluster namespace that expire within the
+// certificate renewal threshold. Certificates are down if any of them has already expired or
+// cannot be renewed automatically.
+func (r *Reconciler) certificateHealthCheck(ctx context.Context, namespace string) (kubermaticv1.HealthStatus, []kubermaticv1.ExpiringCertificate, error) {
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		return kubermaticv1.HealthStatusDown, nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	now := time.Now()
+	threshold := resources.CertificateRenewalThreshold()
+	status := kubermaticv1.HealthStatusUp
+
+	var expiring []kubermaticv1.ExpiringCertificate
+	for _, cert := range certificates.Inventory(secrets.Items) {
+		if !cert.ExpiresWithin(now, threshold) {
+			continue
+		}
+
+		renewable := cert.Renewable(now, threshold)
+		if !renewable || cert.Certificate.NotAfter.Before(now) {
+			status = kubermaticv1.HealthStatusDown
+		}
+
+		expiring = append(expiring, kubermaticv1.ExpiringCertificate{
+			Purpose:   cert.Purpose,
+			NotAfter:  metav1.NewTime(cert.Certificate.NotAfter),
+			Renewable: renewable,
+		})
+	}
+
+	return status, expiring, nil
+}
+
 func (r *Reconciler) kubeLBHealthCheck(ctx context.Context, cluster *kubermaticv1.Cluster, namespace string) (kubermaticv1.HealthStatus, error) {
 	// check for the health of kubeLB deployment.
 	key := types.NamespacedName{Namespace: namespace, Name: resources.KubeLBDeploymentName}
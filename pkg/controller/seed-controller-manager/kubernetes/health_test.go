@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestEtcdResizeProgress(t *testing.T) {
+	genStatefulSet := func(replicas, ready int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.EtcdStatefulSetName,
+				Namespace: "cluster-test",
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To(replicas),
+			},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas: ready,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		launcher       bool
+		clusterSize    int32
+		statefulSet    *appsv1.StatefulSet
+		expectedStatus corev1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:        "no condition without etcd-launcher",
+			clusterSize: 5,
+			statefulSet: genStatefulSet(3, 3),
+		},
+		{
+			name:        "no condition without StatefulSet",
+			launcher:    true,
+			clusterSize: 5,
+		},
+		{
+			name:           "cluster has the configured size",
+			launcher:       true,
+			clusterSize:    5,
+			statefulSet:    genStatefulSet(5, 5),
+			expectedStatus: corev1.ConditionTrue,
+			expectedReason: kubermaticv1.ReasonEtcdClusterResized,
+		},
+		{
+			name:           "cluster is being scaled up",
+			launcher:       true,
+			clusterSize:    5,
+			statefulSet:    genStatefulSet(4, 3),
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: kubermaticv1.ReasonEtcdClusterResizing,
+		},
+		{
+			name:           "last new member has not been promoted yet",
+			launcher:       true,
+			clusterSize:    5,
+			statefulSet:    genStatefulSet(5, 4),
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: kubermaticv1.ReasonEtcdClusterResizing,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Features: map[string]bool{kubermaticv1.ClusterFeatureEtcdLauncher: tc.launcher},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-test",
+				},
+			}
+			cluster.Spec.ComponentsOverride.Etcd.ClusterSize = ptr.To(tc.clusterSize)
+
+			objects := []ctrlruntimeclient.Object{}
+			if tc.statefulSet != nil {
+				objects = append(objects, tc.statefulSet)
+			}
+
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithObjects(objects...).Build(),
+			}
+
+			status, reason, _, err := r.etcdResizeProgress(context.Background(), cluster)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if status != tc.expectedStatus {
+				t.Errorf("Expected status %q, got %q.", tc.expectedStatus, status)
+			}
+
+			if reason != tc.expectedReason {
+				t.Errorf("Expected reason %q, got %q.", tc.expectedReason, reason)
+			}
+		})
+	}
+}
+
+func TestCertificateHealthCheck(t *testing.T) {
+	// CAs are valid for 10 years, leaf certificates for 1 year
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	client, err := triple.NewClientKeyPair(ca, "client", nil)
+	if err != nil {
+		t.Fatalf("Failed to create client certificate: %v", err)
+	}
+
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resources.CASecretName,
+					Namespace: "cluster-test",
+				},
+				Data: map[string][]byte{
+					resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "client-cert",
+					Namespace: "cluster-test",
+				},
+				Data: map[string][]byte{
+					"client.crt":              triple.EncodeCertPEM(client.Cert),
+					resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+				},
+			},
+		).Build(),
+	}
+
+	t.Cleanup(func() {
+		resources.SetCertificateRenewalThreshold(0)
+	})
+
+	testCases := []struct {
+		name           string
+		threshold      time.Duration
+		expectedStatus kubermaticv1.HealthStatus
+		expected       map[string]bool
+	}{
+		{
+			name:           "no certificate expires soon",
+			threshold:      resources.DefaultCertificateRenewalThreshold,
+			expectedStatus: kubermaticv1.HealthStatusUp,
+			expected:       map[string]bool{},
+		},
+		{
+			name:           "leaf certificate expires soon and can be renewed",
+			threshold:      2 * 365 * 24 * time.Hour,
+			expectedStatus: kubermaticv1.HealthStatusUp,
+			expected:       map[string]bool{"client-cert": true},
+		},
+		{
+			name:           "CA expires soon",
+			threshold:      20 * 365 * 24 * time.Hour,
+			expectedStatus: kubermaticv1.HealthStatusDown,
+			expected:       map[string]bool{resources.CASecretName: false, "client-cert": false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resources.SetCertificateRenewalThreshold(tc.threshold)
+
+			status, expiring, err := r.certificateHealthCheck(context.Background(), "cluster-test")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if status != tc.expectedStatus {
+				t.Errorf("Expected status %q, got %q.", tc.expectedStatus, status)
+			}
+
+			if len(expiring) != len(tc.expected) {
+				t.Fatalf("Expected %d expiring certificates, got %v.", len(tc.expected), expiring)
+			}
+
+			for _, cert := range expiring {
+				renewable, ok := tc.expected[cert.Purpose]
+				if !ok {
+					t.Errorf("Did not expect %q to be reported.", cert.Purpose)
+					continue
+				}
+
+				if cert.Renewable != renewable {
+					t.Errorf("Expected %q to be renewable=%v.", cert.Purpose, renewable)
+				}
+			}
+		})
+	}
+}
@@ -799,6 +799,7 @@ func GetConfigMapReconcilers(data *resources.TemplateData) []reconciling.NamedCo
 		apiserver.AuditConfigMapReconciler(data),
 		apiserver.AdmissionControlReconciler(data),
 		apiserver.CABundleReconciler(data),
+		etcd.JobOutcomesConfigMapReconciler(),
 	}
 	if !data.Cluster().Spec.DisableCSIDriver {
 		creators = append(creators, csi.ConfigMapsReconcilers(data)...)
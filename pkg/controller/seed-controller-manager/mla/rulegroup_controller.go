@@ -250,9 +250,25 @@ func (r *ruleGroupController) reconcile(ctx context.Context, cluster *kubermatic
 	}
 
 	if err := r.ensureRuleGroup(ctx, ruleGroup, requestURL); err != nil {
+		if statusErr := r.updateSyncedCondition(ctx, ruleGroup, err); statusErr != nil {
+			r.log.Errorw("failed to update rule group status", zap.Error(statusErr), "rulegroup", ruleGroup.Name)
+		}
 		return nil, fmt.Errorf("failed to create rule group: %w", err)
 	}
-	return nil, nil
+	return nil, r.updateSyncedCondition(ctx, ruleGroup, nil)
+}
+
+func (r *ruleGroupController) updateSyncedCondition(ctx context.Context, ruleGroup *kubermaticv1.RuleGroup, syncErr error) error {
+	oldRuleGroup := ruleGroup.DeepCopy()
+	if syncErr != nil {
+		util.SetRuleGroupCondition(ruleGroup, kubermaticv1.RuleGroupConditionSynced, corev1.ConditionFalse, "SyncFailed", syncErr.Error())
+	} else {
+		util.SetRuleGroupCondition(ruleGroup, kubermaticv1.RuleGroupConditionSynced, corev1.ConditionTrue, "Synced", "")
+	}
+	if reflect.DeepEqual(oldRuleGroup.Status, ruleGroup.Status) {
+		return nil
+	}
+	return r.Status().Patch(ctx, ruleGroup, ctrlruntimeclient.MergeFrom(oldRuleGroup))
 }
 
 func (r *ruleGroupController) CleanUp(ctx context.Context) error {
@@ -33,6 +33,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/test/fake"
 	"k8c.io/kubermatic/v2/pkg/test/generator"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -66,6 +67,7 @@ func TestRuleGroupReconcile(t *testing.T) {
 		requests     []request
 		expectedErr  bool
 		hasFinalizer bool
+		isSynced     bool
 	}{
 		{
 			name: "create metrics rule group",
@@ -94,6 +96,7 @@ func TestRuleGroupReconcile(t *testing.T) {
 				},
 			},
 			hasFinalizer: true,
+			isSynced:     true,
 		},
 		{
 			name: "create logs rule group",
@@ -122,6 +125,7 @@ func TestRuleGroupReconcile(t *testing.T) {
 				},
 			},
 			hasFinalizer: true,
+			isSynced:     true,
 		},
 		{
 			name: "create rule group with unknown type",
@@ -197,6 +201,7 @@ func TestRuleGroupReconcile(t *testing.T) {
 				t.Fatalf("unable to get ruleGroup: %v", err)
 			}
 			assert.Equal(t, testcase.hasFinalizer, kubernetes.HasFinalizer(ruleGroup, ruleGroupFinalizer))
+			assert.Equal(t, testcase.isSynced, ruleGroup.Status.Conditions[kubermaticv1.RuleGroupConditionSynced].Status == corev1.ConditionTrue)
 			assertExpectation()
 			server.Close()
 		})
@@ -19,6 +19,7 @@ package mla
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"go.uber.org/zap"
 
@@ -29,13 +30,18 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -76,12 +82,51 @@ func newRuleGroupSyncReconciler(
 		}}}
 	})
 
+	// project-scoped RuleGroups need to be synced again when clusters join or leave
+	// the set of clusters matching them
+	enqueueProjectRuleGroups := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object ctrlruntimeclient.Object) []reconcile.Request {
+		projectID := object.GetLabels()[kubermaticv1.ProjectIDLabelKey]
+		if projectID == "" {
+			return nil
+		}
+
+		ruleGroupList := &kubermaticv1.RuleGroupList{}
+		if err := client.List(ctx, ruleGroupList, ctrlruntimeclient.InNamespace(reconciler.ruleGroupSyncController.mlaNamespace)); err != nil {
+			log.Errorw("failed to list ruleGroups", zap.Error(err))
+			utilruntime.HandleError(fmt.Errorf("failed to list ruleGroups: %w", err))
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, ruleGroup := range ruleGroupList.Items {
+			if ruleGroup.Spec.ProjectID == projectID {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+					Name:      ruleGroup.Name,
+					Namespace: ruleGroup.Namespace,
+				}})
+			}
+		}
+		return requests
+	})
+
+	clusterPredicate := predicate.Funcs{
+		UpdateFunc: func(event event.UpdateEvent) bool {
+			oldCluster := event.ObjectOld.(*kubermaticv1.Cluster)
+			newCluster := event.ObjectNew.(*kubermaticv1.Cluster)
+			return !reflect.DeepEqual(oldCluster.Labels, newCluster.Labels) ||
+				mlaEnabled(*oldCluster) != mlaEnabled(*newCluster) ||
+				oldCluster.Spec.Pause != newCluster.Spec.Pause ||
+				oldCluster.Status.NamespaceName != newCluster.Status.NamespaceName
+		},
+	}
+
 	_, err := builder.ControllerManagedBy(mgr).
 		Named(controllerName(subname)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: numWorkers,
 		}).
 		Watches(&kubermaticv1.RuleGroup{}, enqueueSourceRuleGroup).
+		Watches(&kubermaticv1.Cluster{}, enqueueProjectRuleGroups, builder.WithPredicates(clusterPredicate)).
 		Build(reconciler)
 
 	return err
@@ -107,19 +152,48 @@ func (r *ruleGroupSyncReconciler) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
 	}
 
-	if err := r.ruleGroupSyncController.syncClusterNS(ctx, log, ruleGroup, func(seedClient ctrlruntimeclient.Client, ruleGroup *kubermaticv1.RuleGroup, cluster *kubermaticv1.Cluster) error {
+	clusterStatus := map[string]kubermaticv1.RuleGroupClusterStatus{}
+	syncErr := r.ruleGroupSyncController.syncClusterNS(ctx, log, ruleGroup, func(seedClient ctrlruntimeclient.Client, ruleGroup *kubermaticv1.RuleGroup, cluster *kubermaticv1.Cluster) error {
 		ruleGroupReconcilerFactory := []reconciling.NamedRuleGroupReconcilerFactory{
 			ruleGroupReconcilerFactory(ruleGroup, cluster),
 		}
-		return reconciling.ReconcileRuleGroups(ctx, ruleGroupReconcilerFactory, cluster.Status.NamespaceName, seedClient)
-	}); err != nil {
-		r.recorder.Event(ruleGroup, corev1.EventTypeWarning, "ReconcilingError", err.Error())
-		return reconcile.Result{}, fmt.Errorf("failed to reconcle rulegroup %s: %w", ruleGroup.Name, err)
+		err := reconciling.ReconcileRuleGroups(ctx, ruleGroupReconcilerFactory, cluster.Status.NamespaceName, seedClient)
+		clusterStatus[cluster.Name] = r.ruleGroupSyncController.clusterSyncStatus(ctx, ruleGroup, cluster, err)
+		return err
+	})
+
+	if syncErr == nil && isProjectScoped(ruleGroup) {
+		// remove the ruleGroup from clusters that do not match anymore
+		syncErr = r.ruleGroupSyncController.deleteMaterialisedRuleGroups(ctx, ruleGroup, clusterStatus)
+	}
+
+	if isProjectScoped(ruleGroup) {
+		if err := r.updateClusterStatus(ctx, ruleGroup, clusterStatus); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+		}
+	}
+
+	if syncErr != nil {
+		r.recorder.Event(ruleGroup, corev1.EventTypeWarning, "ReconcilingError", syncErr.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to reconcle rulegroup %s: %w", ruleGroup.Name, syncErr)
 	}
 
 	return reconcile.Result{}, nil
 }
 
+func (r *ruleGroupSyncReconciler) updateClusterStatus(ctx context.Context, ruleGroup *kubermaticv1.RuleGroup, clusterStatus map[string]kubermaticv1.RuleGroupClusterStatus) error {
+	if len(clusterStatus) == 0 {
+		clusterStatus = nil
+	}
+	if reflect.DeepEqual(ruleGroup.Status.Clusters, clusterStatus) {
+		return nil
+	}
+
+	oldRuleGroup := ruleGroup.DeepCopy()
+	ruleGroup.Status.Clusters = clusterStatus
+	return r.Status().Patch(ctx, ruleGroup, ctrlruntimeclient.MergeFrom(oldRuleGroup))
+}
+
 type ruleGroupSyncController struct {
 	ctrlruntimeclient.Client
 	mlaNamespace string
@@ -152,6 +226,13 @@ func (r *ruleGroupSyncController) CleanUp(ctx context.Context) error {
 }
 
 func (r *ruleGroupSyncController) handleDeletion(ctx context.Context, log *zap.SugaredLogger, ruleGroup *kubermaticv1.RuleGroup) error {
+	if isProjectScoped(ruleGroup) {
+		if err := r.deleteMaterialisedRuleGroups(ctx, ruleGroup, nil); err != nil {
+			return err
+		}
+		return kubernetes.TryRemoveFinalizer(ctx, r, ruleGroup, ruleGroupFinalizer)
+	}
+
 	if err := r.syncClusterNS(ctx, log, ruleGroup, func(seedClient ctrlruntimeclient.Client, ruleGroup *kubermaticv1.RuleGroup, cluster *kubermaticv1.Cluster) error {
 		ruleGroup = &kubermaticv1.RuleGroup{
 			ObjectMeta: metav1.ObjectMeta{
@@ -170,16 +251,76 @@ func (r *ruleGroupSyncController) handleDeletion(ctx context.Context, log *zap.S
 	return kubernetes.TryRemoveFinalizer(ctx, r, ruleGroup, ruleGroupFinalizer)
 }
 
+// deleteMaterialisedRuleGroups deletes the RuleGroups materialised from a project-scoped ruleGroup,
+// except for the ones in the clusters to keep.
+func (r *ruleGroupSyncController) deleteMaterialisedRuleGroups(ctx context.Context, ruleGroup *kubermaticv1.RuleGroup, keep map[string]kubermaticv1.RuleGroupClusterStatus) error {
+	ruleGroupList := &kubermaticv1.RuleGroupList{}
+	if err := r.List(ctx, ruleGroupList, ctrlruntimeclient.MatchingLabels{kubermaticv1.RuleGroupSourceLabelKey: ruleGroup.Name}); err != nil {
+		return fmt.Errorf("failed to list materialised ruleGroups: %w", err)
+	}
+	for _, materialised := range ruleGroupList.Items {
+		if _, ok := keep[materialised.Spec.Cluster.Name]; ok || materialised.Namespace == r.mlaNamespace {
+			continue
+		}
+		if err := r.Delete(ctx, &materialised); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete ruleGroup %s/%s: %w", materialised.Namespace, materialised.Name, err)
+		}
+	}
+	return nil
+}
+
+// clusterSyncStatus returns the sync result of the ruleGroup materialised for the cluster.
+func (r *ruleGroupSyncController) clusterSyncStatus(ctx context.Context, ruleGroup *kubermaticv1.RuleGroup, cluster *kubermaticv1.Cluster, reconcileErr error) kubermaticv1.RuleGroupClusterStatus {
+	if reconcileErr != nil {
+		return kubermaticv1.RuleGroupClusterStatus{Message: reconcileErr.Error()}
+	}
+
+	materialised := &kubermaticv1.RuleGroup{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: ruleGroup.Name}, materialised); err != nil {
+		return kubermaticv1.RuleGroupClusterStatus{Message: fmt.Sprintf("failed to get ruleGroup: %v", err)}
+	}
+
+	condition, ok := materialised.Status.Conditions[kubermaticv1.RuleGroupConditionSynced]
+	switch {
+	case !ok:
+		return kubermaticv1.RuleGroupClusterStatus{Message: "waiting for the ruleGroup to be synced"}
+	case condition.Status != corev1.ConditionTrue:
+		return kubermaticv1.RuleGroupClusterStatus{Message: condition.Message}
+	default:
+		return kubermaticv1.RuleGroupClusterStatus{Synced: true}
+	}
+}
+
+// syncClusterNS calls the action for every cluster the ruleGroup should be synced to. A failing
+// cluster does not prevent the ruleGroup from being synced to the other clusters.
 func (r *ruleGroupSyncController) syncClusterNS(
 	ctx context.Context,
 	log *zap.SugaredLogger,
 	ruleGroup *kubermaticv1.RuleGroup,
 	action func(seedClient ctrlruntimeclient.Client, ruleGroup *kubermaticv1.RuleGroup, cluster *kubermaticv1.Cluster) error) error {
+	listOpts := []ctrlruntimeclient.ListOption{}
+	selector := labels.Everything()
+	if isProjectScoped(ruleGroup) {
+		listOpts = append(listOpts, ctrlruntimeclient.MatchingLabels{kubermaticv1.ProjectIDLabelKey: ruleGroup.Spec.ProjectID})
+
+		if ruleGroup.Spec.ClusterSelector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(ruleGroup.Spec.ClusterSelector); err != nil {
+				return fmt.Errorf("invalid cluster selector: %w", err)
+			}
+		}
+	}
+
 	clusterList := &kubermaticv1.ClusterList{}
-	if err := r.List(ctx, clusterList); err != nil {
+	if err := r.List(ctx, clusterList, listOpts...); err != nil {
 		return fmt.Errorf("failed to list clusters: %w", err)
 	}
+	var errs []error
 	for _, cluster := range clusterList.Items {
+		if !selector.Matches(labels.Set(cluster.Labels)) {
+			log.Debugw("cluster does not match the cluster selector, skipping", "cluster", cluster.Name)
+			continue
+		}
 		if cluster.Spec.Pause {
 			log.Debugw("cluster paused, skipping", "cluster", cluster.Name)
 			continue
@@ -197,16 +338,17 @@ func (r *ruleGroupSyncController) syncClusterNS(
 			continue
 		}
 		if err := action(r, ruleGroup, &cluster); err != nil {
-			return fmt.Errorf("failed to sync rulegroup for cluster %s: %w", cluster.Name, err)
+			errs = append(errs, fmt.Errorf("failed to sync rulegroup for cluster %s: %w", cluster.Name, err))
 		}
 	}
-	return nil
+	return kerrors.NewAggregate(errs)
 }
 
 func ruleGroupReconcilerFactory(ruleGroup *kubermaticv1.RuleGroup, cluster *kubermaticv1.Cluster) reconciling.NamedRuleGroupReconcilerFactory {
 	return func() (string, reconciling.RuleGroupReconciler) {
 		return ruleGroup.Name, func(r *kubermaticv1.RuleGroup) (*kubermaticv1.RuleGroup, error) {
 			r.Name = ruleGroup.Name
+			kubernetes.EnsureLabels(r, map[string]string{kubermaticv1.RuleGroupSourceLabelKey: ruleGroup.Name})
 			r.Spec = kubermaticv1.RuleGroupSpec{
 				RuleGroupType: ruleGroup.Spec.RuleGroupType,
 				Cluster: corev1.ObjectReference{
@@ -219,6 +361,11 @@ func ruleGroupReconcilerFactory(ruleGroup *kubermaticv1.RuleGroup, cluster *kube
 	}
 }
 
+// isProjectScoped returns true if the ruleGroup is materialised only for the clusters of a project.
+func isProjectScoped(ruleGroup *kubermaticv1.RuleGroup) bool {
+	return ruleGroup.Spec.ProjectID != ""
+}
+
 func mlaEnabled(cluster kubermaticv1.Cluster) bool {
 	return cluster.Spec.MLA != nil && (cluster.Spec.MLA.LoggingEnabled || cluster.Spec.MLA.MonitoringEnabled)
 }
@@ -28,6 +28,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/test/fake"
 	"k8c.io/kubermatic/v2/pkg/test/generator"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -121,6 +122,92 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcileProjectRuleGroup(t *testing.T) {
+	ctx := context.Background()
+
+	ruleGroup := generateMLARuleGroup("test-rule", mlaNamespace, kubermaticv1.RuleGroupTypeMetrics, false)
+	ruleGroup.Spec.ProjectID = "my-project"
+	ruleGroup.Spec.ClusterSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+
+	// materialised before the cluster selector was changed
+	staleRuleGroup := generateMLARuleGroup("test-rule", "cluster-dev", kubermaticv1.RuleGroupTypeMetrics, false)
+	staleRuleGroup.Labels = map[string]string{kubermaticv1.RuleGroupSourceLabelKey: "test-rule"}
+	staleRuleGroup.Spec.Cluster.Name = "dev"
+
+	reconciler := newTestRuleGroupSyncReconciler([]ctrlruntimeclient.Object{
+		generateProjectCluster("prod", "my-project", "prod"),
+		generateProjectCluster("dev", "my-project", "dev"),
+		generateProjectCluster("other", "other-project", "prod"),
+		ruleGroup,
+		staleRuleGroup,
+	})
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rule", Namespace: mlaNamespace}}
+	if _, err := reconciler.Reconcile(ctx, request); err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	materialised := &kubermaticv1.RuleGroup{}
+	if err := reconciler.Get(ctx, types.NamespacedName{Name: "test-rule", Namespace: "cluster-prod"}, materialised); err != nil {
+		t.Fatalf("Expected rule group to be materialised for matching cluster: %v", err)
+	}
+	assert.Equal(t, "prod", materialised.Spec.Cluster.Name)
+	assert.Equal(t, "test-rule", materialised.Labels[kubermaticv1.RuleGroupSourceLabelKey])
+
+	for _, namespace := range []string{"cluster-dev", "cluster-other"} {
+		err := reconciler.Get(ctx, types.NamespacedName{Name: "test-rule", Namespace: namespace}, &kubermaticv1.RuleGroup{})
+		assert.True(t, apierrors.IsNotFound(err), "expected no rule group in namespace %s, got %v", namespace, err)
+	}
+
+	assertClusterStatus := func(expected map[string]kubermaticv1.RuleGroupClusterStatus) {
+		t.Helper()
+		ruleGroup := &kubermaticv1.RuleGroup{}
+		if err := reconciler.Get(ctx, request.NamespacedName, ruleGroup); err != nil {
+			t.Fatalf("Failed to get rule group: %v", err)
+		}
+		assert.Equal(t, expected, ruleGroup.Status.Clusters)
+	}
+
+	assertClusterStatus(map[string]kubermaticv1.RuleGroupClusterStatus{
+		"prod": {Message: "waiting for the ruleGroup to be synced"},
+	})
+
+	// the sync result of the materialised rule group is reflected in the project-scoped rule group
+	materialised.Status.Conditions = map[kubermaticv1.RuleGroupConditionType]kubermaticv1.RuleGroupCondition{
+		kubermaticv1.RuleGroupConditionSynced: {Status: corev1.ConditionTrue},
+	}
+	if err := reconciler.Status().Update(ctx, materialised); err != nil {
+		t.Fatalf("Failed to update status: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, request); err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	assertClusterStatus(map[string]kubermaticv1.RuleGroupClusterStatus{
+		"prod": {Synced: true},
+	})
+
+	// deleting the project-scoped rule group removes all materialised rule groups
+	if err := reconciler.Delete(ctx, ruleGroup); err != nil {
+		t.Fatalf("Failed to delete rule group: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, request); err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	err := reconciler.Get(ctx, types.NamespacedName{Name: "test-rule", Namespace: "cluster-prod"}, &kubermaticv1.RuleGroup{})
+	assert.True(t, apierrors.IsNotFound(err), "expected materialised rule group to be deleted, got %v", err)
+}
+
+func generateProjectCluster(name, projectID, env string) *kubermaticv1.Cluster {
+	cluster := generateCluster(name, true, false, false)
+	cluster.Labels = map[string]string{
+		kubermaticv1.ProjectIDLabelKey: projectID,
+		"env":                          env,
+	}
+	return cluster
+}
+
 func generateMLARuleGroup(name, namespace string, ruleGroupType kubermaticv1.RuleGroupType, deleted bool) *kubermaticv1.RuleGroup {
 	group := &kubermaticv1.RuleGroup{
 		ObjectMeta: metav1.ObjectMeta{
@@ -107,12 +107,13 @@ func (r *Reconciler) ensureDeployments(ctx context.Context, cluster *kubermaticv
 // GetSecretReconcilerOperations returns all SecretReconcilers that are currently in use.
 func GetSecretReconcilerOperations(data *resources.TemplateData) []reconciling.NamedSecretReconcilerFactory {
 	return []reconciling.NamedSecretReconcilerFactory{
-		certificates.GetClientCertificateReconciler(
+		certificates.GetClientCertificateWithCABundleReconciler(
 			resources.PrometheusApiserverClientCertificateSecretName,
 			resources.PrometheusCertUsername, nil,
 			resources.PrometheusClientCertificateCertSecretKey,
 			resources.PrometheusClientCertificateKeySecretKey,
 			data.GetRootCA,
+			data.GetRootCABundle,
 		),
 	}
 }
@@ -19,12 +19,14 @@ package project
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/controller/util"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -48,15 +50,17 @@ const (
 
 type Reconciler struct {
 	ctrlruntimeclient.Client
-	log      *zap.SugaredLogger
-	recorder record.EventRecorder
+	log        *zap.SugaredLogger
+	recorder   record.EventRecorder
+	seedGetter provider.SeedGetter
 }
 
-func Add(mgr manager.Manager, log *zap.SugaredLogger, workerCount int) error {
+func Add(mgr manager.Manager, log *zap.SugaredLogger, workerCount int, seedGetter provider.SeedGetter) error {
 	reconciler := &Reconciler{
-		Client:   mgr.GetClient(),
-		log:      log,
-		recorder: mgr.GetEventRecorderFor(ControllerName),
+		Client:     mgr.GetClient(),
+		log:        log,
+		recorder:   mgr.GetEventRecorderFor(ControllerName),
+		seedGetter: seedGetter,
 	}
 
 	_, err := builder.ControllerManagedBy(mgr).
@@ -114,14 +118,43 @@ func (r *Reconciler) handleCleanup(ctx context.Context, log *zap.SugaredLogger,
 		return fmt.Errorf("failed to list clusters: %w", err)
 	}
 
+	seed, err := r.seedGetter()
+	if err != nil {
+		return fmt.Errorf("failed to get seed: %w", err)
+	}
+
+	// on seeds with a grace period, clusters cannot be deleted directly and
+	// their deletion has to be requested instead
+	gracePeriod := seed.Spec.ClusterDeletionGracePeriod != nil && seed.Spec.ClusterDeletionGracePeriod.Duration > 0
+
+	var protected []string
 	for _, cluster := range clusters.Items {
-		if cluster.DeletionTimestamp == nil {
-			if err := r.Delete(ctx, &cluster); err != nil {
-				return fmt.Errorf("failed to delete cluster %s: %w", cluster.Name, err)
+		if cluster.DeletionTimestamp != nil {
+			continue
+		}
+
+		// protected clusters would be rejected by the webhook, retrying would not change that
+		if cluster.Spec.DeletionProtection {
+			protected = append(protected, cluster.Name)
+			continue
+		}
+
+		if gracePeriod {
+			if err := r.requestClusterDeletion(ctx, &cluster); err != nil {
+				return fmt.Errorf("failed to request deletion of cluster %s: %w", cluster.Name, err)
 			}
+			continue
+		}
+
+		if err := r.Delete(ctx, &cluster); err != nil {
+			return fmt.Errorf("failed to delete cluster %s: %w", cluster.Name, err)
 		}
 	}
 
+	if len(protected) > 0 {
+		r.recorder.Eventf(project, corev1.EventTypeWarning, "ClustersProtected", "Project cannot be deleted until deletion protection is disabled for clusters: %s", strings.Join(protected, ", "))
+	}
+
 	// we're done!
 	if len(clusters.Items) == 0 {
 		log.Info("All clusters in project have been deleted.")
@@ -133,5 +166,22 @@ func (r *Reconciler) handleCleanup(ctx context.Context, log *zap.SugaredLogger,
 
 	// there are still clusters remaining;
 	// since we watch Cluster objects, we get triggered when they are deleted
+	// or their deletion protection is disabled
 	return nil
 }
+
+// requestClusterDeletion annotates the cluster so that it is deleted by the
+// scheduled deletion controller once the seed's grace period has expired.
+func (r *Reconciler) requestClusterDeletion(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	if _, ok := cluster.Annotations[kubermaticv1.DeletionRequestedAnnotation]; ok {
+		return nil
+	}
+
+	oldCluster := cluster.DeepCopy()
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[kubermaticv1.DeletionRequestedAnnotation] = ""
+
+	return r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster))
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	projectName = "testproject"
+	clusterName = "testcluster"
+)
+
+func genSeed(gracePeriod time.Duration) *kubermaticv1.Seed {
+	return &kubermaticv1.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testseed",
+			Namespace: "kubermatic",
+		},
+		Spec: kubermaticv1.SeedSpec{
+			ClusterDeletionGracePeriod: &metav1.Duration{Duration: gracePeriod},
+		},
+	}
+}
+
+func genDeletedProject() *kubermaticv1.Project {
+	return &kubermaticv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              projectName,
+			DeletionTimestamp: &metav1.Time{Time: time.Now()},
+			Finalizers:        []string{CleanupFinalizer},
+		},
+	}
+}
+
+func genCluster(deletionProtection bool) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: projectName,
+			},
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			DeletionProtection: deletionProtection,
+		},
+	}
+}
+
+func TestHandleCleanup(t *testing.T) {
+	testcases := []struct {
+		name     string
+		seed     *kubermaticv1.Seed
+		objects  []ctrlruntimeclient.Object
+		validate func(t *testing.T, client ctrlruntimeclient.Client, events []string)
+	}{
+		{
+			name:    "project finalizer is removed once all clusters are gone",
+			seed:    genSeed(0),
+			objects: []ctrlruntimeclient.Object{genDeletedProject()},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, events []string) {
+				project := &kubermaticv1.Project{}
+				if err := client.Get(context.Background(), types.NamespacedName{Name: projectName}, project); !apierrors.IsNotFound(err) {
+					t.Errorf("Expected project to be gone, but got %v.", err)
+				}
+			},
+		},
+		{
+			name:    "clusters are deleted directly without a grace period",
+			seed:    genSeed(0),
+			objects: []ctrlruntimeclient.Object{genDeletedProject(), genCluster(false)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, events []string) {
+				cluster := &kubermaticv1.Cluster{}
+				if err := client.Get(context.Background(), types.NamespacedName{Name: clusterName}, cluster); !apierrors.IsNotFound(err) {
+					t.Errorf("Expected cluster to be deleted, but got %v.", err)
+				}
+			},
+		},
+		{
+			name:    "cluster deletion is requested on seeds with a grace period",
+			seed:    genSeed(time.Hour),
+			objects: []ctrlruntimeclient.Object{genDeletedProject(), genCluster(false)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, events []string) {
+				cluster := &kubermaticv1.Cluster{}
+				if err := client.Get(context.Background(), types.NamespacedName{Name: clusterName}, cluster); err != nil {
+					t.Fatalf("Failed to get cluster: %v", err)
+				}
+
+				if _, ok := cluster.Annotations[kubermaticv1.DeletionRequestedAnnotation]; !ok {
+					t.Error("Expected cluster deletion to be requested.")
+				}
+			},
+		},
+		{
+			name:    "protected clusters are reported instead of deleted",
+			seed:    genSeed(0),
+			objects: []ctrlruntimeclient.Object{genDeletedProject(), genCluster(true)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, events []string) {
+				cluster := &kubermaticv1.Cluster{}
+				if err := client.Get(context.Background(), types.NamespacedName{Name: clusterName}, cluster); err != nil {
+					t.Fatalf("Failed to get cluster: %v", err)
+				}
+
+				if len(events) != 1 || !strings.Contains(events[0], "ClustersProtected") || !strings.Contains(events[0], clusterName) {
+					t.Errorf("Expected a ClustersProtected event for %s, but got %v.", clusterName, events)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().
+				WithObjects(tc.objects...).
+				Build()
+
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{
+				Client:     client,
+				log:        zap.NewNop().Sugar(),
+				recorder:   recorder,
+				seedGetter: test.NewSeedGetter(tc.seed),
+			}
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: projectName}})
+			if err != nil {
+				t.Fatalf("Reconciling failed: %v", err)
+			}
+
+			if !result.IsZero() {
+				t.Errorf("Expected no requeue, but got %+v.", result)
+			}
+
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+
+			tc.validate(t, client, events)
+		})
+	}
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduleddeletioncontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "kkp-scheduled-deletion-controller"
+
+	// finalBackupPrefix is the name prefix of the EtcdBackupConfigs created for the final snapshot.
+	finalBackupPrefix = "final-backup"
+
+	// pauseReason is set on clusters that were paused by this controller.
+	pauseReason = "Cluster is scheduled for deletion."
+
+	// requeueInterval is used while waiting for the final etcd snapshot.
+	requeueInterval = 30 * time.Second
+)
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	log        *zap.SugaredLogger
+	workerName string
+	recorder   record.EventRecorder
+	seedGetter provider.SeedGetter
+	now        func() time.Time
+}
+
+func Add(
+	mgr manager.Manager,
+	log *zap.SugaredLogger,
+	numWorkers int,
+	workerName string,
+	seedGetter provider.SeedGetter,
+) error {
+	reconciler := &Reconciler{
+		Client:     mgr.GetClient(),
+		log:        log.Named(ControllerName),
+		workerName: workerName,
+		recorder:   mgr.GetEventRecorderFor(ControllerName),
+		seedGetter: seedGetter,
+		now:        time.Now,
+	}
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		For(&kubermaticv1.Cluster{}, builder.WithPredicates(predicateutil.Factory(func(o ctrlruntimeclient.Object) bool {
+			cluster := o.(*kubermaticv1.Cluster)
+			return deletionRequested(cluster) || cluster.Status.ScheduledDeletion != nil
+		}))).
+		Watches(&kubermaticv1.EtcdBackupConfig{}, controllerutil.EnqueueClusterForNamespacedObject(mgr.GetClient())).
+		Build(reconciler)
+
+	return err
+}
+
+func deletionRequested(cluster *kubermaticv1.Cluster) bool {
+	_, requested := cluster.Annotations[kubermaticv1.DeletionRequestedAnnotation]
+	return requested
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("cluster", request.Name)
+	log.Debug("Reconciling")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Could not find cluster")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	// Paused clusters are not skipped, as this controller pauses clusters itself;
+	// hence controllerutil.ClusterReconcileWrapper cannot be used.
+	if cluster.Labels[kubermaticv1.WorkerNameLabelKey] != r.workerName {
+		return reconcile.Result{}, nil
+	}
+
+	result, err := r.reconcile(ctx, log, cluster)
+	if result == nil || err != nil {
+		result = &reconcile.Result{}
+	}
+
+	if err != nil {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "ReconcilingError", err.Error())
+	}
+
+	return *result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	requested := deletionRequested(cluster)
+	scheduled := cluster.Status.ScheduledDeletion != nil
+
+	switch {
+	case requested && !scheduled:
+		return nil, r.scheduleDeletion(ctx, log, cluster)
+	case !requested && scheduled:
+		return nil, r.cancelDeletion(ctx, log, cluster)
+	case requested && scheduled:
+		return r.proceedDeletion(ctx, log, cluster)
+	default:
+		return nil, nil
+	}
+}
+
+// scheduleDeletion starts the final etcd snapshot and records the time at which the
+// cluster is going to be deleted.
+func (r *Reconciler) scheduleDeletion(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	seed, err := r.seedGetter()
+	if err != nil {
+		return fmt.Errorf("failed to get seed: %w", err)
+	}
+
+	if seed.Spec.ClusterDeletionGracePeriod == nil || seed.Spec.ClusterDeletionGracePeriod.Duration <= 0 {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "DeletionNotScheduled", "Ignoring deletion request, because the seed has no cluster deletion grace period configured; delete the cluster directly instead.")
+		return nil
+	}
+
+	if cluster.Spec.DeletionProtection {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "DeletionProtected", "Ignoring deletion request, because the cluster has deletion protection enabled.")
+		return nil
+	}
+
+	if cluster.Status.NamespaceName == "" {
+		return errors.New("cluster has no namespace yet")
+	}
+
+	now := r.now().UTC()
+	backupConfig := ""
+
+	switch {
+	case cluster.Spec.Pause:
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "NoFinalBackup", "No final etcd backup is taken, because the cluster is paused.")
+	case !seed.IsDefaultEtcdAutomaticBackupEnabled():
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "NoFinalBackup", "No final etcd backup is taken, because the seed has no default etcd backup destination.")
+	default:
+		backupConfig = fmt.Sprintf("%s-%d", finalBackupPrefix, now.Unix())
+
+		if err := r.createFinalBackupConfig(ctx, cluster, seed, backupConfig); err != nil {
+			return fmt.Errorf("failed to create final etcd backup: %w", err)
+		}
+	}
+
+	gracePeriod := seed.Spec.ClusterDeletionGracePeriod.Duration
+	deleteAt := now.Add(gracePeriod)
+	wasPaused := cluster.Spec.Pause
+
+	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.ScheduledDeletion = &kubermaticv1.ClusterScheduledDeletionStatus{
+			RequestedAt:       metav1.NewTime(now),
+			DeleteAt:          metav1.NewTime(deleteAt),
+			FinalBackupConfig: backupConfig,
+			WasPaused:         wasPaused,
+		}
+	}); err != nil {
+		return fmt.Errorf("failed to update cluster status: %w", err)
+	}
+
+	log.Infow("Scheduled cluster deletion", "deleteAt", deleteAt)
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, "DeletionScheduled", "The cluster will be deleted at %s.", deleteAt.Format(time.RFC3339))
+
+	return nil
+}
+
+func (r *Reconciler) createFinalBackupConfig(ctx context.Context, cluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed, name string) error {
+	config := &kubermaticv1.EtcdBackupConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.Status.NamespaceName,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: cluster.Labels[kubermaticv1.ProjectIDLabelKey],
+			},
+		},
+		Spec: kubermaticv1.EtcdBackupConfigSpec{
+			Name: name,
+			Cluster: corev1.ObjectReference{
+				Kind:       kubermaticv1.ClusterKindName,
+				Name:       cluster.Name,
+				UID:        cluster.UID,
+				APIVersion: "kubermatic.k8c.io/v1",
+			},
+			Destination:   seed.Spec.EtcdBackupRestore.DefaultDestination,
+			RetainBackups: true,
+		},
+	}
+
+	if err := r.Create(ctx, config); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// proceedDeletion waits for the final snapshot, pauses the cluster and deletes it once
+// the grace period has expired.
+func (r *Reconciler) proceedDeletion(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	status := cluster.Status.ScheduledDeletion
+
+	// a failed final backup is only reported once, the deletion has to be requested again
+	if status.FinalBackupFailed {
+		log.Debug("Final etcd backup has failed, waiting for the deletion to be cancelled")
+		return nil, nil
+	}
+
+	completed, failure, err := r.finalBackupCompleted(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if failure != "" {
+		if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+			c.Status.ScheduledDeletion.FinalBackupFailed = true
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update cluster status: %w", err)
+		}
+
+		log.Infow("Final etcd backup has failed, holding the deletion", "reason", failure)
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "FinalBackupFailed", "%s; the deletion can be cancelled and requested again to take a new backup.", failure)
+
+		return nil, nil
+	}
+
+	if !completed {
+		log.Debug("Waiting for final etcd backup")
+		return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+	}
+
+	if now := r.now(); now.Before(status.DeleteAt.Time) {
+		if !cluster.Spec.Pause {
+			if err := r.updateCluster(ctx, cluster, func(c *kubermaticv1.Cluster) {
+				c.Spec.Pause = true
+				c.Spec.PauseReason = pauseReason
+			}); err != nil {
+				return nil, fmt.Errorf("failed to pause cluster: %w", err)
+			}
+
+			r.recorder.Event(cluster, corev1.EventTypeNormal, "Paused", "The cluster has been paused until it is deleted.")
+		}
+
+		return &reconcile.Result{RequeueAfter: status.DeleteAt.Sub(now)}, nil
+	}
+
+	if cluster.Spec.DeletionProtection {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "DeletionProtected", "Not deleting the cluster, because it has deletion protection enabled.")
+		return nil, nil
+	}
+
+	// the cleanup of a cluster is only performed if it is not paused
+	if cluster.Spec.Pause {
+		if err := r.updateCluster(ctx, cluster, func(c *kubermaticv1.Cluster) {
+			c.Spec.Pause = false
+			c.Spec.PauseReason = ""
+		}); err != nil {
+			return nil, fmt.Errorf("failed to unpause cluster: %w", err)
+		}
+	}
+
+	if err := r.Delete(ctx, cluster); err != nil {
+		return nil, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	log.Info("Deleted cluster after its deletion grace period expired")
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "Deleting", "The deletion grace period has expired, the cluster is being deleted.")
+
+	return nil, nil
+}
+
+// finalBackupCompleted returns true if the final etcd snapshot has been taken or if
+// no final snapshot is taken for the cluster. If the snapshot cannot complete anymore,
+// the reason is returned.
+func (r *Reconciler) finalBackupCompleted(ctx context.Context, cluster *kubermaticv1.Cluster) (bool, string, error) {
+	name := cluster.Status.ScheduledDeletion.FinalBackupConfig
+	if name == "" {
+		return true, "", nil
+	}
+
+	config := &kubermaticv1.EtcdBackupConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: name}, config); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, fmt.Sprintf("The final etcd backup %s does not exist", name), nil
+		}
+
+		return false, "", fmt.Errorf("failed to get final etcd backup: %w", err)
+	}
+
+	for _, backup := range config.Status.CurrentBackups {
+		switch backup.BackupPhase {
+		case kubermaticv1.BackupStatusPhaseCompleted:
+			return true, "", nil
+		case kubermaticv1.BackupStatusPhaseFailed:
+			return false, fmt.Sprintf("The final etcd backup %s has failed", name), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+// cancelDeletion restores the cluster to the state before its deletion was requested. The
+// final snapshot is kept.
+func (r *Reconciler) cancelDeletion(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	if !cluster.Status.ScheduledDeletion.WasPaused && cluster.Spec.Pause {
+		if err := r.updateCluster(ctx, cluster, func(c *kubermaticv1.Cluster) {
+			c.Spec.Pause = false
+			c.Spec.PauseReason = ""
+		}); err != nil {
+			return fmt.Errorf("failed to unpause cluster: %w", err)
+		}
+	}
+
+	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.ScheduledDeletion = nil
+	}); err != nil {
+		return fmt.Errorf("failed to update cluster status: %w", err)
+	}
+
+	log.Info("Cancelled scheduled cluster deletion")
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "DeletionCancelled", "The scheduled deletion has been cancelled.")
+
+	return nil
+}
+
+func (r *Reconciler) updateCluster(ctx context.Context, cluster *kubermaticv1.Cluster, modify func(*kubermaticv1.Cluster)) error {
+	oldCluster := cluster.DeepCopy()
+	modify(cluster)
+	if reflect.DeepEqual(oldCluster, cluster) {
+		return nil
+	}
+
+	return r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster))
+}
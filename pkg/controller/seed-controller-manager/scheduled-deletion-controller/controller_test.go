@@ -0,0 +1,401 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduleddeletioncontroller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	clusterName      = "testcluster"
+	clusterNamespace = "cluster-testcluster"
+	backupName       = "final-backup-1000"
+)
+
+var (
+	testScheme = fake.NewScheme()
+
+	now = time.Unix(1000, 0).UTC()
+)
+
+func genSeed(gracePeriod time.Duration, defaultDestination bool) *kubermaticv1.Seed {
+	seed := &kubermaticv1.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testseed",
+			Namespace: "kubermatic",
+		},
+		Spec: kubermaticv1.SeedSpec{
+			ClusterDeletionGracePeriod: &metav1.Duration{Duration: gracePeriod},
+		},
+	}
+
+	if defaultDestination {
+		seed.Spec.EtcdBackupRestore = &kubermaticv1.EtcdBackupRestore{
+			Destinations: map[string]*kubermaticv1.BackupDestination{
+				"s3": {},
+			},
+			DefaultDestination: "s3",
+		}
+	}
+
+	return seed
+}
+
+func genCluster(modify func(*kubermaticv1.Cluster)) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Annotations: map[string]string{
+				kubermaticv1.DeletionRequestedAnnotation: "",
+			},
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: "testproject",
+			},
+			// keep the cluster around after it has been deleted
+			Finalizers: []string{"test"},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: clusterNamespace,
+		},
+	}
+
+	if modify != nil {
+		modify(cluster)
+	}
+
+	return cluster
+}
+
+func scheduled(deleteAt time.Time, backup string, wasPaused bool) func(*kubermaticv1.Cluster) {
+	return func(c *kubermaticv1.Cluster) {
+		c.Status.ScheduledDeletion = &kubermaticv1.ClusterScheduledDeletionStatus{
+			RequestedAt:       metav1.NewTime(now.Add(-time.Hour)),
+			DeleteAt:          metav1.NewTime(deleteAt),
+			FinalBackupConfig: backup,
+			WasPaused:         wasPaused,
+		}
+	}
+}
+
+func genBackupConfig(phase kubermaticv1.BackupStatusPhase) *kubermaticv1.EtcdBackupConfig {
+	config := &kubermaticv1.EtcdBackupConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupName,
+			Namespace: clusterNamespace,
+		},
+	}
+
+	if phase != "" {
+		config.Status.CurrentBackups = []kubermaticv1.BackupStatus{{
+			BackupName:  fmt.Sprintf("%s-%s", clusterName, backupName),
+			BackupPhase: phase,
+		}}
+	}
+
+	return config
+}
+
+func TestReconcile(t *testing.T) {
+	testcases := []struct {
+		name     string
+		seed     *kubermaticv1.Seed
+		cluster  *kubermaticv1.Cluster
+		objects  []ctrlruntimeclient.Object
+		validate func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, result reconcile.Result)
+	}{
+		{
+			name:    "deletion request is ignored without grace period",
+			seed:    genSeed(0, true),
+			cluster: genCluster(nil),
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, _ reconcile.Result) {
+				if cluster.Status.ScheduledDeletion != nil {
+					t.Error("Expected deletion not to be scheduled.")
+				}
+			},
+		},
+		{
+			name: "deletion request is ignored for protected cluster",
+			seed: genSeed(time.Hour, true),
+			cluster: genCluster(func(c *kubermaticv1.Cluster) {
+				c.Spec.DeletionProtection = true
+			}),
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, _ reconcile.Result) {
+				if cluster.Status.ScheduledDeletion != nil {
+					t.Error("Expected deletion not to be scheduled.")
+				}
+			},
+		},
+		{
+			name:    "deletion is scheduled with final backup",
+			seed:    genSeed(time.Hour, true),
+			cluster: genCluster(nil),
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, _ reconcile.Result) {
+				status := cluster.Status.ScheduledDeletion
+				if status == nil {
+					t.Fatal("Expected deletion to be scheduled.")
+				}
+
+				if !status.DeleteAt.Time.Equal(now.Add(time.Hour)) {
+					t.Errorf("Expected deletion at %v, but got %v.", now.Add(time.Hour), status.DeleteAt.Time)
+				}
+
+				if status.FinalBackupConfig != backupName {
+					t.Fatalf("Expected final backup %q, but got %q.", backupName, status.FinalBackupConfig)
+				}
+
+				config := &kubermaticv1.EtcdBackupConfig{}
+				if err := client.Get(context.Background(), types.NamespacedName{Namespace: clusterNamespace, Name: backupName}, config); err != nil {
+					t.Fatalf("Failed to get final backup: %v", err)
+				}
+
+				if config.Spec.Schedule != "" || !config.Spec.RetainBackups || config.Spec.Destination != "s3" {
+					t.Errorf("Final backup is not a retained one-shot backup to the default destination: %+v", config.Spec)
+				}
+
+				if cluster.Spec.Pause {
+					t.Error("Expected cluster not to be paused before the final backup has completed.")
+				}
+			},
+		},
+		{
+			name:    "deletion is scheduled without default backup destination",
+			seed:    genSeed(time.Hour, false),
+			cluster: genCluster(nil),
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, _ reconcile.Result) {
+				status := cluster.Status.ScheduledDeletion
+				if status == nil {
+					t.Fatal("Expected deletion to be scheduled.")
+				}
+
+				if status.FinalBackupConfig != "" {
+					t.Errorf("Expected no final backup, but got %q.", status.FinalBackupConfig)
+				}
+			},
+		},
+		{
+			name:    "cluster is not paused while the final backup is running",
+			seed:    genSeed(time.Hour, true),
+			cluster: genCluster(scheduled(now.Add(time.Hour), backupName, false)),
+			objects: []ctrlruntimeclient.Object{genBackupConfig(kubermaticv1.BackupStatusPhaseRunning)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, result reconcile.Result) {
+				if cluster.Spec.Pause {
+					t.Error("Expected cluster not to be paused.")
+				}
+
+				if result.RequeueAfter != requeueInterval {
+					t.Errorf("Expected requeue after %v, but got %v.", requeueInterval, result.RequeueAfter)
+				}
+			},
+		},
+		{
+			name:    "failed final backup holds the deletion",
+			seed:    genSeed(time.Hour, true),
+			cluster: genCluster(scheduled(now.Add(-time.Minute), backupName, false)),
+			objects: []ctrlruntimeclient.Object{genBackupConfig(kubermaticv1.BackupStatusPhaseFailed)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, result reconcile.Result) {
+				if !cluster.Status.ScheduledDeletion.FinalBackupFailed {
+					t.Error("Expected failed final backup to be recorded.")
+				}
+
+				if cluster.DeletionTimestamp != nil || cluster.Spec.Pause {
+					t.Error("Expected cluster to be neither deleted nor paused.")
+				}
+
+				if !result.IsZero() {
+					t.Errorf("Expected no requeue, but got %+v.", result)
+				}
+			},
+		},
+		{
+			name:    "missing final backup holds the deletion",
+			seed:    genSeed(time.Hour, true),
+			cluster: genCluster(scheduled(now.Add(-time.Minute), backupName, false)),
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, result reconcile.Result) {
+				if !cluster.Status.ScheduledDeletion.FinalBackupFailed {
+					t.Error("Expected missing final backup to be recorded.")
+				}
+
+				if !result.IsZero() {
+					t.Errorf("Expected no requeue, but got %+v.", result)
+				}
+			},
+		},
+		{
+			name: "recorded final backup failure is not reconciled again",
+			seed: genSeed(time.Hour, true),
+			cluster: genCluster(func(c *kubermaticv1.Cluster) {
+				scheduled(now.Add(-time.Minute), backupName, false)(c)
+				c.Status.ScheduledDeletion.FinalBackupFailed = true
+			}),
+			objects: []ctrlruntimeclient.Object{genBackupConfig(kubermaticv1.BackupStatusPhaseCompleted)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, result reconcile.Result) {
+				if cluster.DeletionTimestamp != nil {
+					t.Error("Expected cluster not to be deleted.")
+				}
+
+				if !result.IsZero() {
+					t.Errorf("Expected no requeue, but got %+v.", result)
+				}
+			},
+		},
+		{
+			name:    "cluster is paused after the final backup has completed",
+			seed:    genSeed(time.Hour, true),
+			cluster: genCluster(scheduled(now.Add(time.Hour), backupName, false)),
+			objects: []ctrlruntimeclient.Object{genBackupConfig(kubermaticv1.BackupStatusPhaseCompleted)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, result reconcile.Result) {
+				if !cluster.Spec.Pause {
+					t.Error("Expected cluster to be paused.")
+				}
+
+				if cluster.DeletionTimestamp != nil {
+					t.Error("Expected cluster not to be deleted before the grace period has expired.")
+				}
+
+				if result.RequeueAfter != time.Hour {
+					t.Errorf("Expected requeue after %v, but got %v.", time.Hour, result.RequeueAfter)
+				}
+			},
+		},
+		{
+			name: "cluster is deleted after the grace period",
+			seed: genSeed(time.Hour, true),
+			cluster: genCluster(func(c *kubermaticv1.Cluster) {
+				scheduled(now.Add(-time.Minute), backupName, false)(c)
+				c.Spec.Pause = true
+				c.Spec.PauseReason = pauseReason
+			}),
+			objects: []ctrlruntimeclient.Object{genBackupConfig(kubermaticv1.BackupStatusPhaseCompleted)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, _ reconcile.Result) {
+				if cluster.DeletionTimestamp == nil {
+					t.Error("Expected cluster to be deleted.")
+				}
+
+				if cluster.Spec.Pause {
+					t.Error("Expected cluster to be unpaused for its cleanup.")
+				}
+			},
+		},
+		{
+			name: "protected cluster is not deleted after the grace period",
+			seed: genSeed(time.Hour, true),
+			cluster: genCluster(func(c *kubermaticv1.Cluster) {
+				scheduled(now.Add(-time.Minute), "", false)(c)
+				c.Spec.Pause = true
+				c.Spec.DeletionProtection = true
+			}),
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, _ reconcile.Result) {
+				if cluster.DeletionTimestamp != nil {
+					t.Error("Expected cluster not to be deleted.")
+				}
+			},
+		},
+		{
+			name: "cancelled deletion unpauses cluster and keeps the final backup",
+			seed: genSeed(time.Hour, true),
+			cluster: genCluster(func(c *kubermaticv1.Cluster) {
+				scheduled(now.Add(time.Hour), backupName, false)(c)
+				delete(c.Annotations, kubermaticv1.DeletionRequestedAnnotation)
+				c.Spec.Pause = true
+				c.Spec.PauseReason = pauseReason
+			}),
+			objects: []ctrlruntimeclient.Object{genBackupConfig(kubermaticv1.BackupStatusPhaseCompleted)},
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, _ reconcile.Result) {
+				if cluster.Status.ScheduledDeletion != nil {
+					t.Error("Expected scheduled deletion to be cleared.")
+				}
+
+				if cluster.Spec.Pause || cluster.Spec.PauseReason != "" {
+					t.Error("Expected cluster to be unpaused.")
+				}
+
+				config := &kubermaticv1.EtcdBackupConfig{}
+				if err := client.Get(context.Background(), types.NamespacedName{Namespace: clusterNamespace, Name: backupName}, config); err != nil {
+					t.Errorf("Expected final backup to be kept: %v", err)
+				}
+			},
+		},
+		{
+			name: "cancelled deletion keeps previously paused cluster paused",
+			seed: genSeed(time.Hour, true),
+			cluster: genCluster(func(c *kubermaticv1.Cluster) {
+				scheduled(now.Add(time.Hour), "", true)(c)
+				delete(c.Annotations, kubermaticv1.DeletionRequestedAnnotation)
+				c.Spec.Pause = true
+			}),
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, _ reconcile.Result) {
+				if cluster.Status.ScheduledDeletion != nil {
+					t.Error("Expected scheduled deletion to be cleared.")
+				}
+
+				if !cluster.Spec.Pause {
+					t.Error("Expected cluster to stay paused.")
+				}
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			client := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(append(tc.objects, tc.cluster)...).
+				Build()
+
+			r := &Reconciler{
+				Client:     client,
+				log:        zap.NewNop().Sugar(),
+				recorder:   &record.FakeRecorder{},
+				seedGetter: test.NewSeedGetter(tc.seed),
+				now:        func() time.Time { return now },
+			}
+
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterName}})
+			if err != nil {
+				t.Fatalf("Reconciling failed: %v", err)
+			}
+
+			cluster := &kubermaticv1.Cluster{}
+			if err := client.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+				if apierrors.IsNotFound(err) {
+					t.Fatal("Cluster was removed.")
+				}
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			tc.validate(t, client, cluster, result)
+		})
+	}
+}
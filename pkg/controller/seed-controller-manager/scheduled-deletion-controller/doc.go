@@ -0,0 +1,31 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package scheduleddeletioncontroller contains a controller that deletes user clusters
+after a grace period, on seeds that configure `clusterDeletionGracePeriod`.
+
+On such seeds, clusters cannot be deleted directly. Instead, the deletion is requested
+by annotating the cluster with `kubermatic.k8c.io/deletion-requested`. The controller
+then takes a final etcd snapshot to the seed's default backup destination, pauses the
+cluster once the snapshot has completed and deletes it after the grace period has
+expired. Removing the annotation before that cancels the deletion and unpauses the
+cluster again; the final snapshot is kept. If the final snapshot fails, the deletion is
+held until it is cancelled and requested again, which takes a new snapshot.
+
+Clusters with `spec.deletionProtection` enabled are never deleted.
+*/
+package scheduleddeletioncontroller
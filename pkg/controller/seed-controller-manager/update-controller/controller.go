@@ -19,6 +19,7 @@ package updatecontroller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -47,9 +48,10 @@ import (
 const (
 	ControllerName = "kkp-update-controller"
 
-	ClusterConditionUpToDate    = "UpToDate"
-	ClusterConditionProgressing = "Progressing"
-	ClusterConditionOldNodes    = "OldNodes"
+	ClusterConditionUpToDate               = "UpToDate"
+	ClusterConditionProgressing            = "Progressing"
+	ClusterConditionOldNodes               = "OldNodes"
+	ClusterConditionWaitingForUpdateWindow = "WaitingForUpdateWindow"
 )
 
 type controlPlaneChecker func(context.Context, ctrlruntimeclient.Client, *zap.SugaredLogger, *kubermaticv1.Cluster) (*controlPlaneStatus, error)
@@ -65,6 +67,8 @@ type Reconciler struct {
 
 	// cpChecker is here to make unit testing easier
 	cpChecker controlPlaneChecker
+	// now is here to make unit testing the update window easier
+	now func() time.Time
 }
 
 // Add creates a new update controller.
@@ -77,6 +81,7 @@ func Add(mgr manager.Manager, numWorkers int, workerName string, configGetter pr
 		log:          log,
 		versions:     versions,
 		cpChecker:    getCurrentControlPlaneVersions,
+		now:          time.Now,
 	}
 
 	_, err := builder.ControllerManagedBy(mgr).
@@ -124,7 +129,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		r.versions,
 		kubermaticv1.ClusterConditionUpdateControllerReconcilingSuccess,
 		func() (*reconcile.Result, error) {
-			return nil, r.reconcile(ctx, log, cluster)
+			return r.reconcile(ctx, log, cluster)
 		},
 	)
 
@@ -152,23 +157,23 @@ func (r *Reconciler) setClusterCondition(ctx context.Context, cluster *kubermati
 	})
 }
 
-func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
 	// if the cluster status has no version information yet, set the initial status
 	if cluster.Status.Versions.ControlPlane == "" || cluster.Status.Versions.Apiserver == "" || cluster.Status.Versions.ControllerManager == "" || cluster.Status.Versions.Scheduler == "" {
 		if err := setInitialClusterVersions(ctx, r, cluster); err != nil {
-			return fmt.Errorf("failed to set initial cluster status: %w", err)
+			return nil, fmt.Errorf("failed to set initial cluster status: %w", err)
 		}
 
 		log.Info("Set initial cluster version")
 
 		// setting the status above will trigger a reconciliation anyway
-		return nil
+		return nil, nil
 	}
 
 	// Before making any further decisions, find out how the control plane is currently running.
 	cpStatus, err := r.cpChecker(ctx, r, log, cluster)
 	if err != nil {
-		return fmt.Errorf("failed to determine version status for control plane: %w", err)
+		return nil, fmt.Errorf("failed to determine version status for control plane: %w", err)
 	}
 
 	spec := normalize(&cluster.Spec.Version)
@@ -190,7 +195,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 			c.Status.Versions.ControlPlane = *cpStatus.apiserver
 		}); err != nil {
-			return fmt.Errorf("failed to update controller-manager version status: %w", err)
+			return nil, fmt.Errorf("failed to update controller-manager version status: %w", err)
 		}
 
 		log.Infow("Cluster apiserver has been updated", "version", *cpStatus.apiserver)
@@ -204,7 +209,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		// or in need of reconciling, but for this controller there is no further work to be done.
 		log.Debugw("Cluster control plane has reached the spec'ed version.", "spec", spec)
 
-		return r.setClusterCondition(ctx, cluster, ClusterConditionUpToDate, "No update in progress, cluster has reached its desired version.")
+		return nil, r.setClusterCondition(ctx, cluster, ClusterConditionUpToDate, "No update in progress, cluster has reached its desired version.")
 	}
 
 	// We have not yet reached the desired state; before taking actions towards that goal,
@@ -215,7 +220,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		// Cluster not healthy yet. Nothing to do. Changes to the health will trigger another reconciliation.
 		log.Debug("Cluster control plane has not reached the spec'ed version, but is also not yet healthy.")
 
-		return r.setClusterCondition(ctx, cluster, ClusterConditionProgressing, "Update in progress, control plane is not yet healthy.")
+		return nil, r.setClusterCondition(ctx, cluster, ClusterConditionProgressing, "Update in progress, control plane is not yet healthy.")
 	}
 
 	// Cluster is healthy but has not yet reached the spec'ed version. However maybe it didn't
@@ -225,17 +230,17 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	// Do this as 3 distinct checks to provide nice looking log messages.
 	if !cpStatus.apiserver.Equal(&cluster.Status.Versions.Apiserver) {
 		log.Debugw("Cluster control plane is healthy but apiserver is out-of-sync.", "running", cpStatus.apiserver, "desired", cluster.Status.Versions.Apiserver)
-		return r.setClusterCondition(ctx, cluster, ClusterConditionProgressing, "Update in progress, control plane is healthy but apiserver is out-of-sync.")
+		return nil, r.setClusterCondition(ctx, cluster, ClusterConditionProgressing, "Update in progress, control plane is healthy but apiserver is out-of-sync.")
 	}
 
 	if !cpStatus.controllerManager.Equal(&cluster.Status.Versions.ControllerManager) {
 		log.Debugw("Cluster control plane is healthy but controller-manager is out-of-sync.", "running", cpStatus.controllerManager, "desired", cluster.Status.Versions.ControllerManager)
-		return r.setClusterCondition(ctx, cluster, ClusterConditionProgressing, "Update in progress, control plane is healthy but controller-manager is out-of-sync.")
+		return nil, r.setClusterCondition(ctx, cluster, ClusterConditionProgressing, "Update in progress, control plane is healthy but controller-manager is out-of-sync.")
 	}
 
 	if !cpStatus.scheduler.Equal(&cluster.Status.Versions.Scheduler) {
 		log.Debugw("Cluster control plane is healthy but scheduler is out-of-sync.", "running", cpStatus.scheduler, "desired", cluster.Status.Versions.Scheduler)
-		return r.setClusterCondition(ctx, cluster, ClusterConditionProgressing, "Update in progress, control plane is healthy but scheduler is out-of-sync.")
+		return nil, r.setClusterCondition(ctx, cluster, ClusterConditionProgressing, "Update in progress, control plane is healthy but scheduler is out-of-sync.")
 	}
 
 	// Cluster is healthy, all Pods match what we intend to deploy as per the cluster status and
@@ -253,7 +258,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 			c.Status.Versions.ControllerManager = versions.Apiserver
 		}); err != nil {
-			return fmt.Errorf("failed to update controller-manager version status: %w", err)
+			return nil, fmt.Errorf("failed to update controller-manager version status: %w", err)
 		}
 
 		log.Infow("Updating controller-manager to match apiserver", "apiserver", versions.Apiserver, "controllerManager", versions.ControllerManager)
@@ -265,7 +270,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 			c.Status.Versions.Scheduler = versions.Apiserver
 		}); err != nil {
-			return fmt.Errorf("failed to update scheduler version status: %w", err)
+			return nil, fmt.Errorf("failed to update scheduler version status: %w", err)
 		}
 
 		log.Infow("Updating scheduler to match apiserver", "apiserver", versions.Apiserver, "scheduler", versions.Scheduler)
@@ -275,7 +280,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 
 	// updating the status above will trigger a reconciliation, which will update the cluster condition
 	if updated {
-		return nil
+		return nil, nil
 	}
 
 	// This controller does not update nodes, as nodes can and will be updated independently (for example, the
@@ -294,7 +299,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 
 		if distance >= 2 {
 			log.Debugw("Cluster control plane is healthy but cluster still has old nodes.", "controlPlane", cluster.Status.Versions.ControlPlane, "oldestNode", cpStatus.nodes)
-			return r.setClusterCondition(ctx, cluster, ClusterConditionOldNodes, fmt.Sprintf("Update in progress, control plane (v%s) is healthy but cluster still has old nodes (v%s).", cluster.Status.Versions.ControlPlane.String(), cpStatus.nodes.String()))
+			return nil, r.setClusterCondition(ctx, cluster, ClusterConditionOldNodes, fmt.Sprintf("Update in progress, control plane (v%s) is healthy but cluster still has old nodes (v%s).", cluster.Status.Versions.ControlPlane.String(), cpStatus.nodes.String()))
 		}
 
 		// Distance is at most 1 release, so the control plane is free to be updated at any time.
@@ -306,12 +311,35 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	// that is configured for the minor and is not newer than the spec'ed version.
 	config, err := r.configGetter(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to load KubermaticConfiguration: %w", err)
+		return nil, fmt.Errorf("failed to load KubermaticConfiguration: %w", err)
 	}
 
 	newVersion, err := getNextApiserverVersion(ctx, config, cluster)
 	if err != nil {
-		return fmt.Errorf("failed to determine update path: %w", err)
+		return nil, fmt.Errorf("failed to determine update path: %w", err)
+	}
+
+	// Starting the next update step rolls the apiserver (and potentially etcd), so this must only
+	// happen during the cluster's maintenance window. Steps that have already begun (i.e. the
+	// controller-manager and scheduler following the apiserver) are allowed to complete above.
+	open, wait, err := controllerutil.UpdateWindowOpen(cluster.Spec.UpdateWindow, r.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate update window: %w", err)
+	}
+
+	if !open {
+		log.Debugw("Cluster control plane is ready to be updated, but the update window is closed.", "target", newVersion, "opensIn", wait)
+
+		if cond := cluster.Status.Conditions[kubermaticv1.ClusterConditionUpdateProgress]; cond.Reason != ClusterConditionWaitingForUpdateWindow {
+			r.recorder.Eventf(cluster, corev1.EventTypeNormal, "UpdateDeferred", "Update of the Kubernetes apiserver to version %s is deferred until the update window opens.", newVersion.String())
+		}
+
+		message := fmt.Sprintf("Update to v%s pending, waiting for the update window to open in %s.", newVersion.String(), wait.Round(time.Minute))
+		if err := r.setClusterCondition(ctx, cluster, ClusterConditionWaitingForUpdateWindow, message); err != nil {
+			return nil, err
+		}
+
+		return &reconcile.Result{RequeueAfter: wait}, nil
 	}
 
 	// Set this new target version as the next step on our upgrading journey. This will trigger a
@@ -319,13 +347,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 		c.Status.Versions.Apiserver = *newVersion
 	}); err != nil {
-		return fmt.Errorf("failed to update apiserver version: %w", err)
+		return nil, fmt.Errorf("failed to update apiserver version: %w", err)
 	}
 
 	log.Infow("Updating apiserver", "from", versions.Apiserver, "to", newVersion.String(), "spec", spec)
 	r.recorder.Eventf(cluster, corev1.EventTypeNormal, "ApiserverUpdated", "Kubernetes apiserver was updated to version %s.", newVersion.String())
 
-	return nil
+	return nil, nil
 }
 
 // setInitialClusterVersions assumes that the cluster was never up and running and sets
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -388,6 +389,7 @@ func TestReconcile(t *testing.T) {
 		clusterStatus  kubermaticv1.ClusterVersionsStatus
 		currentStatus  controlPlaneStatus
 		healthy        bool
+		updateWindow   *kubermaticv1.UpdateWindow
 		expectedStatus kubermaticv1.ClusterVersionsStatus
 		expectedErr    bool
 	}{
@@ -607,6 +609,80 @@ func TestReconcile(t *testing.T) {
 			},
 		},
 
+		// ///////////////////////////////////////////////////////
+		// the following tests demonstrate how the update window
+		// (evaluated at Wednesday 12:00 UTC) defers updates
+
+		{
+			name:         "cluster was told to be updated, but the update window is closed",
+			specVersion:  *semver.NewSemverOrDie("1.21.0"),
+			healthy:      true,
+			updateWindow: &kubermaticv1.UpdateWindow{Start: "Sat 02:00", Length: "4h"},
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"), // deferred until Saturday
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+		},
+		{
+			name:         "cluster was told to be updated and the update window is open",
+			specVersion:  *semver.NewSemverOrDie("1.21.0"),
+			healthy:      true,
+			updateWindow: &kubermaticv1.UpdateWindow{Start: "11:00", Length: "2h"},
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+		},
+		{
+			name:         "apiserver was updated before the update window closed, rest of the control plane should follow",
+			specVersion:  *semver.NewSemverOrDie("1.21.0"),
+			healthy:      true,
+			updateWindow: &kubermaticv1.UpdateWindow{Start: "Sat 02:00", Length: "4h"},
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.21.0"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.21.0"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.21.0"),
+				Scheduler:         *semver.NewSemverOrDie("1.21.0"),
+			},
+		},
+
 		// ///////////////////////////////////////////////////////
 		// the following tests demonstrate how we must wait for nodes
 		// before proceeding with the control plane
@@ -768,6 +844,7 @@ func TestReconcile(t *testing.T) {
 					Cloud: kubermaticv1.CloudSpec{
 						ProviderName: string(kubermaticv1.AWSCloudProvider),
 					},
+					UpdateWindow: tt.updateWindow,
 				},
 				Status: kubermaticv1.ClusterStatus{
 					Versions: tt.clusterStatus,
@@ -808,9 +885,12 @@ func TestReconcile(t *testing.T) {
 				cpChecker: func(_ context.Context, _ ctrlruntimeclient.Client, _ *zap.SugaredLogger, _ *kubermaticv1.Cluster) (*controlPlaneStatus, error) {
 					return &tt.currentStatus, nil
 				},
+				now: func() time.Time {
+					return time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC)
+				},
 			}
 
-			err = rec.reconcile(context.Background(), rec.log, cluster)
+			_, err = rec.reconcile(context.Background(), rec.log, cluster)
 			if err != nil {
 				if !tt.expectedErr {
 					t.Fatalf("Got unexpected error: %v", err)
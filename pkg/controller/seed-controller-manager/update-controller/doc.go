@@ -21,5 +21,6 @@ initiate the controlled update of first the apiserver, then the rest
 of the control plane and finally nodes. It does so by manipulating
 the ClusterStatus, letting other controller take care of reconciling
 the cluster namespace or updating/watching the nodes in the user cluster.
+New update steps are only started while the cluster's update window is open.
 */
 package updatecontroller
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	semverlib "github.com/Masterminds/semver/v3"
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/util"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Event raised when the version of an applicationInstallation has been changed because of its version constraint.
+const applicationAutomaticallyUpgradedEvent = "ApplicationAutomaticallyUpgraded"
+
+// reconcileVersionConstraint updates the version of the appInstallation to the newest version of its ApplicationDefinition
+// that satisfies the version constraint. Installed applications are only upgraded while the update window of the cluster
+// is open, otherwise the duration until the window opens is returned. Returns true if the version has been changed.
+func (r *reconciler) reconcileVersionConstraint(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation) (bool, time.Duration, error) {
+	if appInstallation.Spec.ApplicationRef.VersionConstraint == "" {
+		return false, 0, nil
+	}
+
+	constraint, err := semverlib.NewConstraint(appInstallation.Spec.ApplicationRef.VersionConstraint)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid version constraint %q: %w", appInstallation.Spec.ApplicationRef.VersionConstraint, err)
+	}
+
+	// a missing ApplicationDefinition is handled during the regular reconciliation
+	applicationDef := &appskubermaticv1.ApplicationDefinition{}
+	if err := r.seedClient.Get(ctx, types.NamespacedName{Name: appInstallation.Spec.ApplicationRef.Name}, applicationDef); err != nil {
+		return false, 0, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	currentVersion := appInstallation.Spec.ApplicationRef.Version
+	targetVersion := newestMatchingVersion(applicationDef, constraint)
+
+	if targetVersion == nil {
+		log.Debugw("No version of the ApplicationDefinition satisfies the version constraint", "constraint", appInstallation.Spec.ApplicationRef.VersionConstraint)
+		return false, 0, nil
+	}
+
+	if !needsVersionChange(applicationDef, constraint, currentVersion, targetVersion) {
+		return false, 0, nil
+	}
+
+	// the initial installation is not bound to the update window
+	if appInstallation.Status.ApplicationVersion != nil {
+		cluster := &kubermaticv1.Cluster{}
+		if err := r.seedClient.Get(ctx, types.NamespacedName{Name: r.clusterName}, cluster); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, 0, nil
+			}
+			return false, 0, fmt.Errorf("failed to get cluster: %w", err)
+		}
+
+		open, wait, err := util.UpdateWindowOpen(cluster.Spec.UpdateWindow, r.now())
+		if err != nil {
+			return false, 0, fmt.Errorf("failed to evaluate update window: %w", err)
+		}
+
+		if !open {
+			log.Debugw("Deferring automatic upgrade until the update window opens", "from", currentVersion, "to", targetVersion.Original(), "wait", wait)
+			return false, wait, nil
+		}
+	}
+
+	oldAppInstallation := appInstallation.DeepCopy()
+	appInstallation.Spec.ApplicationRef.Version = targetVersion.Original()
+	if err := r.userClient.Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return false, 0, fmt.Errorf("failed to update version: %w", err)
+	}
+
+	oldAppInstallation = appInstallation.DeepCopy()
+	appInstallation.Status.AutomaticUpgrade = &appskubermaticv1.ApplicationAutomaticUpgrade{
+		PreviousVersion: currentVersion,
+		TargetVersion:   targetVersion.Original(),
+		Time:            metav1.NewTime(r.now()),
+	}
+	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return false, 0, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	message := fmt.Sprintf("Upgrading application from %s to %s to satisfy version constraint %q", currentVersion, targetVersion.Original(), appInstallation.Spec.ApplicationRef.VersionConstraint)
+	log.Info(message)
+	r.userRecorder.Event(appInstallation, corev1.EventTypeNormal, applicationAutomaticallyUpgradedEvent, message)
+
+	return true, 0, nil
+}
+
+// newestMatchingVersion returns the newest version of the applicationDef that satisfies the constraint or nil if there is none.
+func newestMatchingVersion(applicationDef *appskubermaticv1.ApplicationDefinition, constraint *semverlib.Constraints) *semverlib.Version {
+	var newest *semverlib.Version
+
+	for _, appVersion := range applicationDef.Spec.Versions {
+		version, err := semverlib.NewVersion(appVersion.Version)
+		if err != nil {
+			continue
+		}
+
+		if constraint.Check(version) && (newest == nil || version.GreaterThan(newest)) {
+			newest = version
+		}
+	}
+
+	return newest
+}
+
+// needsVersionChange returns true if the current version must be replaced by the target version, i.e. if the target version
+// is newer or if the current version does not satisfy the constraint or does not exist anymore.
+func needsVersionChange(applicationDef *appskubermaticv1.ApplicationDefinition, constraint *semverlib.Constraints, currentVersion string, targetVersion *semverlib.Version) bool {
+	if currentVersion == targetVersion.Original() {
+		return false
+	}
+
+	current, err := semverlib.NewVersion(currentVersion)
+	if err != nil || !constraint.Check(current) {
+		return true
+	}
+
+	exists := false
+	for _, appVersion := range applicationDef.Spec.Versions {
+		if appVersion.Version == currentVersion {
+			exists = true
+			break
+		}
+	}
+
+	return !exists || targetVersion.GreaterThan(current)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	kubermaticfake "k8c.io/kubermatic/v2/pkg/test/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestReconcileVersionConstraint(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC)
+
+	applicationDef := genApplicationDefinition("app-def-1", nil)
+	applicationDef.Spec.Versions = nil
+	for _, version := range []string{"1.0.0", "1.1.0", "1.2.0", "2.0.0", "not-semver"} {
+		applicationDef.Spec.Versions = append(applicationDef.Spec.Versions, appskubermaticv1.ApplicationVersion{Version: version})
+	}
+
+	testCases := []struct {
+		name             string
+		version          string
+		constraint       string
+		installed        bool
+		updateWindow     *kubermaticv1.UpdateWindow
+		expectedVersion  string
+		expectedUpgraded bool
+		expectedWait     time.Duration
+	}{
+		{
+			name:            "scenario 1: version is not changed without constraint",
+			version:         "1.0.0",
+			expectedVersion: "1.0.0",
+		},
+		{
+			name:             "scenario 2: initial installation uses the newest matching version",
+			version:          "1.0.0",
+			constraint:       "~1",
+			updateWindow:     &kubermaticv1.UpdateWindow{Start: "Mon 04:00", Length: "1h"},
+			expectedVersion:  "1.2.0",
+			expectedUpgraded: true,
+		},
+		{
+			name:             "scenario 3: installed application is upgraded while the update window is open",
+			version:          "1.0.0",
+			constraint:       "~1",
+			installed:        true,
+			updateWindow:     &kubermaticv1.UpdateWindow{Start: "11:00", Length: "2h"},
+			expectedVersion:  "1.2.0",
+			expectedUpgraded: true,
+		},
+		{
+			name:            "scenario 4: upgrade of installed application is deferred until the update window opens",
+			version:         "1.0.0",
+			constraint:      "~1",
+			installed:       true,
+			updateWindow:    &kubermaticv1.UpdateWindow{Start: "Thu 04:00", Length: "1h"},
+			expectedVersion: "1.0.0",
+			expectedWait:    16 * time.Hour,
+		},
+		{
+			name:            "scenario 5: version is not changed if it is the newest matching version",
+			version:         "1.2.0",
+			constraint:      ">=1.1, <2",
+			installed:       true,
+			expectedVersion: "1.2.0",
+		},
+		{
+			name:             "scenario 6: version is changed if it does not satisfy the constraint anymore",
+			version:          "2.0.0",
+			constraint:       "~1.1.0",
+			installed:        true,
+			expectedVersion:  "1.1.0",
+			expectedUpgraded: true,
+		},
+		{
+			name:            "scenario 7: version is not changed if no version satisfies the constraint",
+			version:         "1.0.0",
+			constraint:      "^3",
+			installed:       true,
+			expectedVersion: "1.0.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			appInstall := genApplicationInstallation("app", &applicationNamespace, applicationDef.Name, tc.version, 0, 1, 1)
+			appInstall.Spec.ApplicationRef.VersionConstraint = tc.constraint
+			if tc.installed {
+				appInstall.Status.ApplicationVersion = &appskubermaticv1.ApplicationVersion{Version: tc.version}
+			}
+
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Spec:       kubermaticv1.ClusterSpec{UpdateWindow: tc.updateWindow},
+			}
+
+			userClient := kubermaticfake.NewClientBuilder().WithObjects(appInstall).Build()
+			seedClient := kubermaticfake.NewClientBuilder().WithObjects(applicationDef, cluster).Build()
+
+			r := reconciler{
+				log:          kubermaticlog.Logger,
+				seedClient:   seedClient,
+				userClient:   userClient,
+				userRecorder: record.NewFakeRecorder(10),
+				clusterName:  cluster.Name,
+				now:          func() time.Time { return now },
+			}
+
+			upgraded, wait, err := r.reconcileVersionConstraint(ctx, kubermaticlog.Logger, appInstall)
+			if err != nil {
+				t.Fatalf("reconciling version constraint failed: %v", err)
+			}
+
+			if upgraded != tc.expectedUpgraded {
+				t.Errorf("expected upgraded to be %v, but got %v", tc.expectedUpgraded, upgraded)
+			}
+
+			if wait != tc.expectedWait {
+				t.Errorf("expected to wait for %v, but got %v", tc.expectedWait, wait)
+			}
+
+			current := &appskubermaticv1.ApplicationInstallation{}
+			if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(appInstall), current); err != nil {
+				t.Fatalf("failed to get application installation: %v", err)
+			}
+
+			if current.Spec.ApplicationRef.Version != tc.expectedVersion {
+				t.Errorf("expected version %q, but got %q", tc.expectedVersion, current.Spec.ApplicationRef.Version)
+			}
+
+			if !tc.expectedUpgraded {
+				if current.Status.AutomaticUpgrade != nil {
+					t.Errorf("expected no automatic upgrade in status, but got %+v", current.Status.AutomaticUpgrade)
+				}
+				return
+			}
+
+			upgrade := current.Status.AutomaticUpgrade
+			if upgrade == nil || upgrade.PreviousVersion != tc.version || upgrade.TargetVersion != tc.expectedVersion {
+				t.Errorf("expected automatic upgrade from %q to %q in status, but got %+v", tc.version, tc.expectedVersion, upgrade)
+			}
+		})
+	}
+}
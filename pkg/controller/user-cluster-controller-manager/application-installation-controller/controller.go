@@ -18,6 +18,7 @@ package applicationinstallationcontroller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	"k8c.io/kubermatic/sdk/v2/apis/equality"
 	"k8c.io/kubermatic/v2/pkg/applications"
+	"k8c.io/kubermatic/v2/pkg/applications/helmclient"
 	applicationtemplates "k8c.io/kubermatic/v2/pkg/applications/providers/template"
 	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
 	"k8c.io/kubermatic/v2/pkg/controller/util"
@@ -80,10 +82,12 @@ type reconciler struct {
 	clusterIsPaused      userclustercontrollermanager.IsPausedChecker
 	appInstaller         applications.ApplicationInstaller
 	seedClusterNamespace string
+	clusterName          string
 	overwriteRegistry    string
+	now                  func() time.Time
 }
 
-func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.Manager, clusterIsPaused userclustercontrollermanager.IsPausedChecker, seedClusterNamespace, overwriteRegistry string, appInstaller applications.ApplicationInstaller) error {
+func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.Manager, clusterIsPaused userclustercontrollermanager.IsPausedChecker, seedClusterNamespace, clusterName, overwriteRegistry string, appInstaller applications.ApplicationInstaller) error {
 	log = log.Named(controllerName)
 
 	r := &reconciler{
@@ -94,7 +98,9 @@ func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.M
 		clusterIsPaused:      clusterIsPaused,
 		appInstaller:         appInstaller,
 		seedClusterNamespace: seedClusterNamespace,
+		clusterName:          clusterName,
 		overwriteRegistry:    overwriteRegistry,
+		now:                  time.Now,
 	}
 
 	_, err := builder.ControllerManagedBy(userMgr).
@@ -110,9 +116,42 @@ func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.M
 			&appskubermaticv1.ApplicationDefinition{},
 			handler.TypedEnqueueRequestsFromMapFunc(enqueueAppInstallationForAppDef(r.userClient)),
 		)).
+		// ApplicationInstallations are installed after and uninstalled before their dependencies, so changes to an
+		// ApplicationInstallation can unblock the ApplicationInstallations it depends on or that depend on it.
+		WatchesRawSource(source.Kind(
+			userMgr.GetCache(),
+			&appskubermaticv1.ApplicationInstallation{},
+			handler.TypedEnqueueRequestsFromMapFunc(enqueueRelatedAppInstallations(r.userClient)),
+			dependencyStateChangedPredicate(),
+		)).
+		// Values can be read from Secrets and ConfigMaps in the user cluster or in the cluster namespace, so changes to
+		// them must be rolled out.
+		WatchesRawSource(source.Kind(
+			userMgr.GetCache(),
+			&corev1.Secret{},
+			handler.TypedEnqueueRequestsFromMapFunc(enqueueAppInstallationForValuesReference[*corev1.Secret](r.userClient, appskubermaticv1.UserClusterValuesReferenceLocation, appskubermaticv1.SecretValuesReferenceKind, seedClusterNamespace)),
+		)).
+		WatchesRawSource(source.Kind(
+			userMgr.GetCache(),
+			&corev1.ConfigMap{},
+			handler.TypedEnqueueRequestsFromMapFunc(enqueueAppInstallationForValuesReference[*corev1.ConfigMap](r.userClient, appskubermaticv1.UserClusterValuesReferenceLocation, appskubermaticv1.ConfigMapValuesReferenceKind, seedClusterNamespace)),
+		)).
+		WatchesRawSource(source.Kind(
+			seedMgr.GetCache(),
+			&corev1.Secret{},
+			handler.TypedEnqueueRequestsFromMapFunc(enqueueAppInstallationForValuesReference[*corev1.Secret](r.userClient, appskubermaticv1.ClusterNamespaceValuesReferenceLocation, appskubermaticv1.SecretValuesReferenceKind, seedClusterNamespace)),
+		)).
+		WatchesRawSource(source.Kind(
+			seedMgr.GetCache(),
+			&corev1.ConfigMap{},
+			handler.TypedEnqueueRequestsFromMapFunc(enqueueAppInstallationForValuesReference[*corev1.ConfigMap](r.userClient, appskubermaticv1.ClusterNamespaceValuesReferenceLocation, appskubermaticv1.ConfigMapValuesReferenceKind, seedClusterNamespace)),
+		)).
 		Build(r)
+	if err != nil {
+		return err
+	}
 
-	return err
+	return addDriftController(log, userMgr, r)
 }
 
 // Reconcile ApplicationInstallation (i.e. install / update or uninstall application into the user-cluster).
@@ -147,14 +186,35 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to get applicationInstallation: %w", err)
 	}
 
+	// follow the version constraint before installing the application
+	var upgradeWait time.Duration
+	if appInstallation.DeletionTimestamp.IsZero() {
+		var upgraded bool
+		upgraded, upgradeWait, err = r.reconcileVersionConstraint(ctx, log, appInstallation)
+		if err != nil {
+			r.userRecorder.Event(appInstallation, corev1.EventTypeWarning, applicationInstallationReconcileFailedEvent, err.Error())
+			return reconcile.Result{}, err
+		}
+		// the version change triggers a new reconciliation
+		if upgraded {
+			return reconcile.Result{}, nil
+		}
+	}
+
 	err = r.reconcile(ctx, log, appInstallation)
 	if err != nil {
 		r.userRecorder.Event(appInstallation, corev1.EventTypeWarning, applicationInstallationReconcileFailedEvent, err.Error())
 		return reconcile.Result{}, err
 	}
 
+	// pending automatic upgrades are performed once the update window opens
+	requeueAfter := appInstallation.Spec.ReconciliationInterval.Duration
+	if upgradeWait > 0 && (requeueAfter == 0 || upgradeWait < requeueAfter) {
+		requeueAfter = upgradeWait
+	}
+
 	log.Debug("Processed")
-	return reconcile.Result{RequeueAfter: appInstallation.Spec.ReconciliationInterval.Duration}, nil
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation) error {
@@ -216,6 +276,19 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, appI
 		}
 	}
 
+	// hold the initial installation until all dependencies are met; upgrades are not blocked, as dependencies
+	// are usually upgraded at the same time and become unready while they are upgraded
+	if !installationAttempted(appInstallation) {
+		unmet, err := r.unmetDependencies(ctx, appInstallation)
+		if err != nil {
+			return err
+		}
+		if len(unmet) > 0 {
+			log.Debugw("Waiting for dependencies", "dependencies", unmet)
+			return r.setWaitingCondition(ctx, appInstallation, waitingForDependenciesReason, unmet)
+		}
+	}
+
 	// for addons migrated to ee default-application-catalog we need to purge resources before re-installing them via helm
 	if err := handleAddonCleanup(ctx, appInstallation.Name, r.seedClusterNamespace, r.seedClient, r.log); err != nil {
 		return err
@@ -308,7 +381,12 @@ func (r *reconciler) handleInstallation(ctx context.Context, log *zap.SugaredLog
 	oldAppInstallation := appInstallation.DeepCopy()
 	appSourcePath, downloadErr := r.appInstaller.DownloadSource(ctx, log, r.seedClient, appInstallation, downloadDest)
 	if downloadErr != nil {
-		appInstallation.SetCondition(appskubermaticv1.ManifestsRetrieved, corev1.ConditionFalse, "DownloadSourceFailed", downloadErr.Error())
+		reason := "DownloadSourceFailed"
+		var verificationErr *helmclient.ChartVerificationError
+		if errors.As(downloadErr, &verificationErr) {
+			reason = "ChartVerificationFailed"
+		}
+		appInstallation.SetCondition(appskubermaticv1.ManifestsRetrieved, corev1.ConditionFalse, reason, downloadErr.Error())
 		if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
 			return fmt.Errorf("failed to update status: %w", err)
 		}
@@ -364,6 +442,16 @@ func (r reconciler) resetFailuresIfSpecHasChanged(ctx context.Context, appInstal
 // handleDeletion uninstalls the application in the user cluster.
 func (r *reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation) error {
 	if kuberneteshelper.HasFinalizer(appInstallation, appskubermaticv1.ApplicationInstallationCleanupFinalizer) {
+		// applications are uninstalled in reverse order of their dependencies
+		dependents, err := r.remainingDependents(ctx, appInstallation)
+		if err != nil {
+			return err
+		}
+		if len(dependents) > 0 {
+			log.Debugw("Waiting for dependent applications to be uninstalled", "dependents", dependents)
+			return r.setWaitingCondition(ctx, appInstallation, waitingForDependentsReason, dependents)
+		}
+
 		statusUpdater, uninstallErr := r.appInstaller.Delete(ctx, log, r.seedClient, r.userClient, appInstallation)
 		oldAppInstallation := appInstallation.DeepCopy()
 		if uninstallErr != nil {
@@ -410,6 +498,31 @@ func enqueueAppInstallationForAppDef(userClient ctrlruntimeclient.Client) func(c
 	}
 }
 
+// enqueueAppInstallationForValuesReference fan-out updates from Secrets or ConfigMaps to the ApplicationInstallations that
+// read their values from them.
+func enqueueAppInstallationForValuesReference[T ctrlruntimeclient.Object](userClient ctrlruntimeclient.Client, location appskubermaticv1.ValuesReferenceLocation, kind appskubermaticv1.ValuesReferenceKind, seedClusterNamespace string) func(context.Context, T) []reconcile.Request {
+	return func(ctx context.Context, obj T) []reconcile.Request {
+		appList := &appskubermaticv1.ApplicationInstallationList{}
+		if err := userClient.List(ctx, appList); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to list applicationInstallation: %w", err))
+			return []reconcile.Request{}
+		}
+
+		key := ctrlruntimeclient.ObjectKeyFromObject(obj)
+
+		var res []reconcile.Request
+		for _, appInstallation := range appList.Items {
+			for _, ref := range appInstallation.Spec.ValuesFrom {
+				if ref.Kind == kind && ref.GetLocation() == location && applicationtemplates.ValuesReferenceKey(seedClusterNamespace, &appInstallation, ref) == key {
+					res = append(res, reconcile.Request{NamespacedName: types.NamespacedName{Name: appInstallation.Name, Namespace: appInstallation.Namespace}})
+					break
+				}
+			}
+		}
+		return res
+	}
+}
+
 func handleAddonCleanup(ctx context.Context, applicationName string, seedClusterNamespace string, seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
 	return applicationtemplates.HandleAddonCleanup(ctx, applicationName, seedClusterNamespace, seedClient, log)
 }
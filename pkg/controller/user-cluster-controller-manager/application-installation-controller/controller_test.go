@@ -117,6 +117,64 @@ func TestEnqueueApplicationInstallation(t *testing.T) {
 	}
 }
 
+func TestEnqueueApplicationInstallationForValuesReference(t *testing.T) {
+	withValuesFrom := func(name string, refs ...appskubermaticv1.ValuesReference) *appskubermaticv1.ApplicationInstallation {
+		appInstall := genApplicationInstallation(name, &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0)
+		appInstall.Spec.ValuesFrom = refs
+		return appInstall
+	}
+
+	userClient := kubermaticfake.
+		NewClientBuilder().
+		WithObjects(
+			withValuesFrom("appInstallation-1", appskubermaticv1.ValuesReference{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "values"}),
+			withValuesFrom("appInstallation-2", appskubermaticv1.ValuesReference{Kind: appskubermaticv1.ConfigMapValuesReferenceKind, Name: "values"}),
+			withValuesFrom("appInstallation-3", appskubermaticv1.ValuesReference{Kind: appskubermaticv1.SecretValuesReferenceKind, Name: "values", Location: appskubermaticv1.ClusterNamespaceValuesReferenceLocation}),
+			withValuesFrom("appInstallation-4")).
+		Build()
+
+	testCases := []struct {
+		name                      string
+		location                  appskubermaticv1.ValuesReferenceLocation
+		secret                    *corev1.Secret
+		expectedReconcileRequests []reconcile.Request
+	}{
+		{
+			name:     "scenario 1: only applications that reference the Secret in the user cluster are enqueued",
+			location: appskubermaticv1.UserClusterValuesReferenceLocation,
+			secret:   &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: applicationNamespaceName}},
+			expectedReconcileRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "appInstallation-1", Namespace: applicationNamespaceName}},
+			},
+		},
+		{
+			name:                      "scenario 2: Secrets in other namespaces of the user cluster are ignored",
+			location:                  appskubermaticv1.UserClusterValuesReferenceLocation,
+			secret:                    &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "default"}},
+			expectedReconcileRequests: []reconcile.Request{},
+		},
+		{
+			name:     "scenario 3: only applications that reference the Secret in the cluster namespace are enqueued",
+			location: appskubermaticv1.ClusterNamespaceValuesReferenceLocation,
+			secret:   &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "cluster-abc"}},
+			expectedReconcileRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "appInstallation-3", Namespace: applicationNamespaceName}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			enqueueApplicationInstallationFunc := enqueueAppInstallationForValuesReference[*corev1.Secret](userClient, tc.location, appskubermaticv1.SecretValuesReferenceKind, "cluster-abc")
+			actual := enqueueApplicationInstallationFunc(context.Background(), tc.secret)
+
+			g.Expect(actual).Should(gomega.ConsistOf(tc.expectedReconcileRequests))
+		})
+	}
+}
+
 func TestMaxRetriesOnInstallation(t *testing.T) {
 	installError := fmt.Errorf("an install error")
 
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// waitingForDependenciesReason is the Ready condition reason while the installation is held back by its dependencies.
+	waitingForDependenciesReason = "WaitingForDependencies"
+
+	// waitingForDependentsReason is the Ready condition reason while the uninstallation is held back by dependent applications.
+	waitingForDependentsReason = "WaitingForDependents"
+)
+
+// unmetDependencies returns the dependencies of the appInstallation that do not fulfill their readiness requirement yet.
+func (r *reconciler) unmetDependencies(ctx context.Context, appInstallation *appskubermaticv1.ApplicationInstallation) ([]string, error) {
+	var unmet []string
+
+	for _, dependency := range appInstallation.Spec.DependsOn {
+		key := appInstallation.DependencyKey(dependency)
+
+		dependencyInstallation := &appskubermaticv1.ApplicationInstallation{}
+		if err := r.userClient.Get(ctx, key, dependencyInstallation); err != nil {
+			if apierrors.IsNotFound(err) {
+				unmet = append(unmet, fmt.Sprintf("%s (not found)", key))
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get dependency %s: %w", key, err)
+		}
+
+		switch {
+		case !dependencyInstallation.DeletionTimestamp.IsZero():
+			unmet = append(unmet, fmt.Sprintf("%s (being deleted)", key))
+		case dependency.RequireReady != nil && !*dependency.RequireReady:
+			continue
+		case dependencyInstallation.Status.Conditions[appskubermaticv1.Ready].Status != corev1.ConditionTrue:
+			unmet = append(unmet, fmt.Sprintf("%s (not ready)", key))
+		}
+	}
+
+	return unmet, nil
+}
+
+// installationAttempted returns true if the application has passed the dependency check before, i.e. its sources have
+// been downloaded to install it. The ApplicationVersion in the status cannot be used, as it is set before the check.
+func installationAttempted(appInstallation *appskubermaticv1.ApplicationInstallation) bool {
+	_, ok := appInstallation.Status.Conditions[appskubermaticv1.ManifestsRetrieved]
+	return ok
+}
+
+// remainingDependents returns all other ApplicationInstallations that depend on the appInstallation.
+func (r *reconciler) remainingDependents(ctx context.Context, appInstallation *appskubermaticv1.ApplicationInstallation) ([]string, error) {
+	appList := &appskubermaticv1.ApplicationInstallationList{}
+	if err := r.userClient.List(ctx, appList); err != nil {
+		return nil, fmt.Errorf("failed to list applicationInstallations: %w", err)
+	}
+
+	key := ctrlruntimeclient.ObjectKeyFromObject(appInstallation)
+
+	var dependents []string
+	for _, other := range appList.Items {
+		if dependsOn(&other, key) {
+			dependents = append(dependents, ctrlruntimeclient.ObjectKeyFromObject(&other).String())
+		}
+	}
+
+	return dependents, nil
+}
+
+// setWaitingCondition sets the Ready condition to false with the given reason. The status is only
+// updated if the condition has changed, as the installation can be held back for a long time.
+func (r *reconciler) setWaitingCondition(ctx context.Context, appInstallation *appskubermaticv1.ApplicationInstallation, reason string, waitingFor []string) error {
+	message := fmt.Sprintf("waiting for %s", strings.Join(waitingFor, ", "))
+
+	condition := appInstallation.Status.Conditions[appskubermaticv1.Ready]
+	if condition.Status == corev1.ConditionFalse && condition.Reason == reason && condition.Message == message && condition.ObservedGeneration == appInstallation.Generation {
+		return nil
+	}
+
+	oldAppInstallation := appInstallation.DeepCopy()
+	appInstallation.SetCondition(appskubermaticv1.Ready, corev1.ConditionFalse, reason, message)
+
+	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+func dependsOn(appInstallation *appskubermaticv1.ApplicationInstallation, key types.NamespacedName) bool {
+	for _, dependency := range appInstallation.Spec.DependsOn {
+		if appInstallation.DependencyKey(dependency) == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// enqueueRelatedAppInstallations fan-out changes of an ApplicationInstallation to the ApplicationInstallations that depend
+// on it, which might be waiting to be installed, and to its own dependencies, which might be waiting to be uninstalled.
+func enqueueRelatedAppInstallations(userClient ctrlruntimeclient.Client) func(context.Context, *appskubermaticv1.ApplicationInstallation) []reconcile.Request {
+	return func(ctx context.Context, appInstallation *appskubermaticv1.ApplicationInstallation) []reconcile.Request {
+		var res []reconcile.Request
+
+		for _, dependency := range appInstallation.Spec.DependsOn {
+			res = append(res, reconcile.Request{NamespacedName: appInstallation.DependencyKey(dependency)})
+		}
+
+		appList := &appskubermaticv1.ApplicationInstallationList{}
+		if err := userClient.List(ctx, appList); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to list applicationInstallation: %w", err))
+			return res
+		}
+
+		key := ctrlruntimeclient.ObjectKeyFromObject(appInstallation)
+		for _, other := range appList.Items {
+			if dependsOn(&other, key) {
+				res = append(res, reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(&other)})
+			}
+		}
+
+		return res
+	}
+}
+
+// dependencyStateChangedPredicate only lets events pass that can unblock dependent or dependency ApplicationInstallations,
+// i.e. creation, deletion, spec changes and becoming ready. Other status updates are filtered, as they happen on every
+// reconciliation. In particular, the Ready condition is reset while an application is upgraded, which must not cause
+// all dependent applications to be reconciled.
+func dependencyStateChangedPredicate() predicate.TypedPredicate[*appskubermaticv1.ApplicationInstallation] {
+	return predicate.TypedFuncs[*appskubermaticv1.ApplicationInstallation]{
+		UpdateFunc: func(e event.TypedUpdateEvent[*appskubermaticv1.ApplicationInstallation]) bool {
+			becameReady := e.ObjectOld.Status.Conditions[appskubermaticv1.Ready].Status != corev1.ConditionTrue &&
+				e.ObjectNew.Status.Conditions[appskubermaticv1.Ready].Status == corev1.ConditionTrue
+
+			return becameReady ||
+				e.ObjectOld.Generation != e.ObjectNew.Generation ||
+				e.ObjectOld.DeletionTimestamp.IsZero() != e.ObjectNew.DeletionTimestamp.IsZero()
+		},
+		GenericFunc: func(e event.TypedGenericEvent[*appskubermaticv1.ApplicationInstallation]) bool {
+			return false
+		},
+	}
+}
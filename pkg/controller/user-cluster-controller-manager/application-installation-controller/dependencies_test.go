@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications/fake"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	kubermaticfake "k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func genDependentApplicationInstallation(name string, ready bool, dependencies ...appskubermaticv1.ApplicationInstallationDependency) *appskubermaticv1.ApplicationInstallation {
+	appInstall := genApplicationInstallation(name, &applicationNamespace, "app-def-1", "1.0.0", 0, 1, 1)
+	appInstall.Spec.DependsOn = dependencies
+
+	if ready {
+		appInstall.Status.Conditions[appskubermaticv1.Ready] = appskubermaticv1.ApplicationInstallationCondition{Status: corev1.ConditionTrue}
+	}
+
+	return appInstall
+}
+
+func TestReconcileWaitsForDependencies(t *testing.T) {
+	testCases := []struct {
+		name            string
+		dependencies    []ctrlruntimeclient.Object
+		dependsOn       appskubermaticv1.ApplicationInstallationDependency
+		installed       bool
+		expectInstalled bool
+		expectedMessage string
+	}{
+		{
+			name:            "scenario 1: installation is held back while the dependency does not exist",
+			dependsOn:       appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager"},
+			expectedMessage: "waiting for apps/cert-manager (not found)",
+		},
+		{
+			name:            "scenario 2: installation is held back while the dependency is not ready",
+			dependencies:    []ctrlruntimeclient.Object{genDependentApplicationInstallation("cert-manager", false)},
+			dependsOn:       appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager"},
+			expectedMessage: "waiting for apps/cert-manager (not ready)",
+		},
+		{
+			name:            "scenario 3: application is installed once the dependency is ready",
+			dependencies:    []ctrlruntimeclient.Object{genDependentApplicationInstallation("cert-manager", true)},
+			dependsOn:       appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager"},
+			expectInstalled: true,
+		},
+		{
+			name:            "scenario 4: application is installed if the dependency exists and readiness is not required",
+			dependencies:    []ctrlruntimeclient.Object{genDependentApplicationInstallation("cert-manager", false)},
+			dependsOn:       appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager", RequireReady: ptr.To(false)},
+			expectInstalled: true,
+		},
+		{
+			name: "scenario 5: dependencies in other namespaces are supported",
+			dependencies: []ctrlruntimeclient.Object{func() ctrlruntimeclient.Object {
+				appInstall := genDependentApplicationInstallation("cert-manager", true)
+				appInstall.Namespace = "other"
+				return appInstall
+			}()},
+			dependsOn:       appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager", Namespace: "other"},
+			expectInstalled: true,
+		},
+		{
+			name:            "scenario 6: upgrades of an installed application are not held back by its dependencies",
+			dependencies:    []ctrlruntimeclient.Object{genDependentApplicationInstallation("cert-manager", false)},
+			dependsOn:       appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager"},
+			installed:       true,
+			expectInstalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			appInstall := genDependentApplicationInstallation("app", false, tc.dependsOn)
+			if tc.installed {
+				appInstall.Status.Conditions[appskubermaticv1.ManifestsRetrieved] = appskubermaticv1.ApplicationInstallationCondition{Status: corev1.ConditionTrue}
+			}
+
+			userClient := kubermaticfake.NewClientBuilder().WithObjects(append(tc.dependencies, appInstall)...).Build()
+			seedClient := kubermaticfake.NewClientBuilder().WithObjects(genApplicationDefinition("app-def-1", nil)).Build()
+			appInstaller := &fake.ApplicationInstallerRecorder{}
+
+			r := reconciler{log: kubermaticlog.Logger, seedClient: seedClient, userClient: userClient, appInstaller: appInstaller}
+
+			if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(appInstall), appInstall); err != nil {
+				t.Fatalf("failed to get application installation: %v", err)
+			}
+
+			if err := r.reconcile(ctx, kubermaticlog.Logger, appInstall); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			if _, installed := appInstaller.ApplyEvents.Load("app"); installed != tc.expectInstalled {
+				t.Fatalf("expected application to be installed: %v, but got %v", tc.expectInstalled, installed)
+			}
+
+			if tc.expectInstalled {
+				return
+			}
+
+			if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(appInstall), appInstall); err != nil {
+				t.Fatalf("failed to get application installation: %v", err)
+			}
+
+			condition := appInstall.Status.Conditions[appskubermaticv1.Ready]
+			if condition.Status != corev1.ConditionFalse || condition.Reason != waitingForDependenciesReason || condition.Message != tc.expectedMessage {
+				t.Errorf("expected ready condition to be False/%s/%q, but got %s/%s/%q", waitingForDependenciesReason, tc.expectedMessage, condition.Status, condition.Reason, condition.Message)
+			}
+		})
+	}
+}
+
+func TestHandleDeletionWaitsForDependents(t *testing.T) {
+	ctx := context.Background()
+
+	dependency := genDependentApplicationInstallation("cert-manager", true)
+	dependency.Finalizers = []string{appskubermaticv1.ApplicationInstallationCleanupFinalizer}
+	dependency.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+
+	dependent := genDependentApplicationInstallation("app", true, appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager"})
+
+	userClient := kubermaticfake.NewClientBuilder().WithObjects(dependency, dependent).Build()
+	appInstaller := &fake.ApplicationInstallerRecorder{}
+
+	r := reconciler{log: kubermaticlog.Logger, userClient: userClient, appInstaller: appInstaller}
+
+	if err := r.handleDeletion(ctx, kubermaticlog.Logger, dependency); err != nil {
+		t.Fatalf("handling deletion failed: %v", err)
+	}
+
+	if _, deleted := appInstaller.DeleteEvents.Load("cert-manager"); deleted {
+		t.Fatal("expected application not to be uninstalled before its dependents")
+	}
+
+	if reason := dependency.Status.Conditions[appskubermaticv1.Ready].Reason; reason != waitingForDependentsReason {
+		t.Errorf("expected ready condition reason %q, but got %q", waitingForDependentsReason, reason)
+	}
+
+	// once the dependent is gone, the application is uninstalled
+	if err := userClient.Delete(ctx, dependent); err != nil {
+		t.Fatalf("failed to delete dependent: %v", err)
+	}
+
+	if err := r.handleDeletion(ctx, kubermaticlog.Logger, dependency); err != nil {
+		t.Fatalf("handling deletion failed: %v", err)
+	}
+
+	if _, deleted := appInstaller.DeleteEvents.Load("cert-manager"); !deleted {
+		t.Fatal("expected application to be uninstalled")
+	}
+}
+
+func TestEnqueueRelatedAppInstallations(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	ingress := genDependentApplicationInstallation("ingress", true, appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager"})
+
+	userClient := kubermaticfake.
+		NewClientBuilder().
+		WithObjects(
+			genDependentApplicationInstallation("cert-manager", true),
+			ingress,
+			genDependentApplicationInstallation("app", true, appskubermaticv1.ApplicationInstallationDependency{Name: "ingress"}),
+			genDependentApplicationInstallation("unrelated", true, appskubermaticv1.ApplicationInstallationDependency{Name: "cert-manager"}),
+		).
+		Build()
+
+	actual := enqueueRelatedAppInstallations(userClient)(context.Background(), ingress)
+
+	g.Expect(actual).Should(gomega.ConsistOf(
+		reconcile.Request{NamespacedName: types.NamespacedName{Name: "cert-manager", Namespace: applicationNamespaceName}},
+		reconcile.Request{NamespacedName: types.NamespacedName{Name: "app", Namespace: applicationNamespaceName}},
+	))
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	applicationtemplates "k8c.io/kubermatic/v2/pkg/applications/providers/template"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const driftControllerName = "kkp-app-drift-controller"
+
+// driftReconciler periodically compares the deployed Helm releases to the objects in the user cluster, as changes to
+// these objects do not trigger a reconciliation. Unlike the installation, the check only reads the stored release and
+// only updates the Drifted condition, so it neither downloads the chart nor affects the readiness of the application.
+type driftReconciler struct {
+	*reconciler
+}
+
+func addDriftController(log *zap.SugaredLogger, userMgr manager.Manager, r *reconciler) error {
+	dr := &driftReconciler{reconciler: r}
+
+	_, err := builder.ControllerManagedBy(userMgr).
+		Named(driftControllerName).
+		// status updates must not trigger a check, only changes to the spec can change the drift check interval
+		For(&appskubermaticv1.ApplicationInstallation{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Build(dr)
+
+	return err
+}
+
+func (r *driftReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.Named(driftControllerName).With("applicationinstallation", request)
+	log.Debug("Processing")
+
+	paused, err := r.clusterIsPaused(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check cluster pause status: %w", err)
+	}
+	if paused {
+		return reconcile.Result{}, nil
+	}
+
+	appInstallation := &appskubermaticv1.ApplicationInstallation{}
+	if err := r.userClient.Get(ctx, request.NamespacedName, appInstallation); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	// only releases that have been deployed with Helm can be checked for drift
+	if !appInstallation.DeletionTimestamp.IsZero() ||
+		appInstallation.Status.ApplicationVersion == nil ||
+		appInstallation.Status.Method != appskubermaticv1.HelmTemplateMethod {
+		return reconcile.Result{}, nil
+	}
+
+	appDefinition := &appskubermaticv1.ApplicationDefinition{}
+	if err := r.seedClient.Get(ctx, types.NamespacedName{Name: appInstallation.Spec.ApplicationRef.Name}, appDefinition); err != nil {
+		// a removed ApplicationDefinition is handled by the installation controller
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	interval := applicationtemplates.DriftCheckInterval(appDefinition, appInstallation)
+	if interval <= 0 {
+		return reconcile.Result{}, nil
+	}
+
+	// the installation controller checks for drift as well, so only check if no check happened within the interval
+	if wait := driftCheckWait(appInstallation, interval, r.now()); wait > 0 {
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	statusUpdater, err := r.appInstaller.CheckDrift(ctx, log, r.seedClient, r.userClient, appDefinition, appInstallation)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check application for drift: %w", err)
+	}
+
+	oldAppInstallation := appInstallation.DeepCopy()
+	statusUpdater(&appInstallation.Status)
+
+	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	log.Debug("Processed")
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// driftCheckWait returns how long to wait until the application is due for the next drift check, based on the time
+// the Drifted condition was last updated.
+func driftCheckWait(appInstallation *appskubermaticv1.ApplicationInstallation, interval time.Duration, now time.Time) time.Duration {
+	condition, ok := appInstallation.Status.Conditions[appskubermaticv1.Drifted]
+	if !ok || condition.LastHeartbeatTime.IsZero() {
+		return 0
+	}
+
+	return condition.LastHeartbeatTime.Add(interval).Sub(now)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"testing"
+	"time"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestDriftCheckWait(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		lastHeartbeat *time.Time
+		want          time.Duration
+	}{
+		{
+			name: "application that has never been checked is due",
+			want: 0,
+		},
+		{
+			name:          "application that has been checked recently is due after the remaining interval",
+			lastHeartbeat: ptr.To(now.Add(-5 * time.Minute)),
+			want:          10 * time.Minute,
+		},
+		{
+			name:          "application that has been checked before the interval is overdue",
+			lastHeartbeat: ptr.To(now.Add(-20 * time.Minute)),
+			want:          -5 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appInstallation := &appskubermaticv1.ApplicationInstallation{}
+			if tt.lastHeartbeat != nil {
+				appInstallation.Status.Conditions = map[appskubermaticv1.ApplicationInstallationConditionType]appskubermaticv1.ApplicationInstallationCondition{
+					appskubermaticv1.Drifted: {LastHeartbeatTime: metav1.NewTime(*tt.lastHeartbeat)},
+				}
+			}
+
+			if got := driftCheckWait(appInstallation, 15*time.Minute, now); got != tt.want {
+				t.Errorf("driftCheckWait() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
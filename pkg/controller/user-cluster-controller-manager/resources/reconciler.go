@@ -79,6 +79,11 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 		return fmt.Errorf("failed to get caCert: %w", err)
 	}
 
+	caBundle, err := r.rootCABundle(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get caBundle: %w", err)
+	}
+
 	userSSHKeys, err := r.userSSHKeys(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get userSSHKeys: %w", err)
@@ -91,6 +96,7 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 
 	data := reconcileData{
 		caCert:       caCert,
+		caBundle:     caBundle,
 		userSSHKeys:  userSSHKeys,
 		ccmMigration: r.ccmMigration || r.ccmMigrationCompleted,
 		cluster:      cluster,
@@ -304,9 +310,8 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 }
 
 func (r *reconciler) ensureAPIServices(ctx context.Context, data reconcileData) error {
-	caCert := triple.EncodeCertPEM(data.caCert.Cert)
 	creators := []kkpreconciling.NamedAPIServiceReconcilerFactory{
-		metricsserver.APIServiceReconciler(caCert),
+		metricsserver.APIServiceReconciler(data.caBundle),
 	}
 
 	if err := kkpreconciling.ReconcileAPIServices(ctx, creators, metav1.NamespaceNone, r); err != nil {
@@ -651,12 +656,12 @@ func (r *reconciler) reconcileCRDs(ctx context.Context, data reconcileData) erro
 
 func (r *reconciler) reconcileMutatingWebhookConfigurations(ctx context.Context, data reconcileData) error {
 	creators := []reconciling.NamedMutatingWebhookConfigurationReconcilerFactory{
-		applications.ApplicationInstallationMutatingWebhookConfigurationReconciler(data.caCert.Cert, r.namespace),
-		operatingsystemmanager.MutatingwebhookConfigurationReconciler(data.caCert.Cert, r.namespace),
+		applications.ApplicationInstallationMutatingWebhookConfigurationReconciler(data.caBundle, r.namespace),
+		operatingsystemmanager.MutatingwebhookConfigurationReconciler(data.caBundle, r.namespace),
 	}
 
 	if data.cloudProviderName != string(kubermaticv1.EdgeCloudProvider) {
-		creators = append(creators, machinecontroller.MutatingwebhookConfigurationReconciler(data.caCert.Cert, r.namespace))
+		creators = append(creators, machinecontroller.MutatingwebhookConfigurationReconciler(data.caBundle, r.namespace))
 	}
 
 	if r.opaIntegration && r.opaEnableMutation {
@@ -671,12 +676,14 @@ func (r *reconciler) reconcileMutatingWebhookConfigurations(ctx context.Context,
 
 func (r *reconciler) reconcileValidatingWebhookConfigurations(ctx context.Context, data reconcileData) error {
 	creators := []reconciling.NamedValidatingWebhookConfigurationReconcilerFactory{
-		applications.ApplicationInstallationValidatingWebhookConfigurationReconciler(data.caCert.Cert, r.namespace),
-		operatingsystemmanager.ValidatingWebhookConfigurationReconciler(data.caCert.Cert, r.namespace),
+		applications.ApplicationInstallationValidatingWebhookConfigurationReconciler(data.caBundle, r.namespace),
+		operatingsystemmanager.ValidatingWebhookConfigurationReconciler(data.caBundle, r.namespace),
 	}
 
+	creators = append(creators, resourceQuotaValidatingWebhookConfigurationReconcilers(data.caBundle, r.namespace)...)
+
 	if data.cloudProviderName != string(kubermaticv1.EdgeCloudProvider) {
-		creators = append(creators, machine.ValidatingWebhookConfigurationReconciler(data.caCert.Cert, r.namespace))
+		creators = append(creators, machine.ValidatingWebhookConfigurationReconciler(data.caBundle, r.namespace))
 	}
 
 	if r.opaIntegration {
@@ -684,13 +691,13 @@ func (r *reconciler) reconcileValidatingWebhookConfigurations(ctx context.Contex
 	}
 
 	if data.ccmMigration && data.csiCloudConfig != nil {
-		creators = append(creators, csimigration.ValidatingwebhookConfigurationReconciler(data.caCert.Cert, metav1.NamespaceSystem, resources.VsphereCSIMigrationWebhookConfigurationWebhookName))
+		creators = append(creators, csimigration.ValidatingwebhookConfigurationReconciler(data.caBundle, metav1.NamespaceSystem, resources.VsphereCSIMigrationWebhookConfigurationWebhookName))
 	}
 
 	if !data.cluster.Spec.DisableCSIDriver {
 		if r.cloudProvider == kubermaticv1.VSphereCloudProvider || r.cloudProvider == kubermaticv1.NutanixCloudProvider || r.cloudProvider == kubermaticv1.OpenstackCloudProvider ||
 			r.cloudProvider == kubermaticv1.DigitaloceanCloudProvider {
-			creators = append(creators, csisnapshotter.ValidatingSnapshotWebhookConfigurationReconciler(data.caCert.Cert, metav1.NamespaceSystem, resources.CSISnapshotValidationWebhookConfigurationName))
+			creators = append(creators, csisnapshotter.ValidatingSnapshotWebhookConfigurationReconciler(data.caBundle, metav1.NamespaceSystem, resources.CSISnapshotValidationWebhookConfigurationName))
 		}
 	}
 
@@ -760,7 +767,7 @@ func (r *reconciler) reconcileEndpoints(ctx context.Context, data reconcileData)
 
 func (r *reconciler) reconcileConfigMaps(ctx context.Context, data reconcileData) error {
 	creators := []reconciling.NamedConfigMapReconcilerFactory{
-		machinecontroller.ClusterInfoConfigMapReconciler(r.clusterURL.String(), data.caCert.Cert),
+		machinecontroller.ClusterInfoConfigMapReconciler(r.clusterURL.String(), data.caBundle),
 	}
 
 	if err := reconciling.ReconcileConfigMaps(ctx, creators, metav1.NamespacePublic, r); err != nil {
@@ -1124,7 +1131,10 @@ func (r *reconciler) reconcilePodDisruptionBudgets(ctx context.Context) error {
 }
 
 type reconcileData struct {
-	caCert            *triple.KeyPair
+	caCert *triple.KeyPair
+	// caBundle contains all CAs trusted by the cluster; during a CA rotation it
+	// holds both the old and the new root CA.
+	caBundle          []byte
 	openVPNCACert     *resources.ECDSAKeyPair
 	mlaGatewayCACert  *resources.ECDSAKeyPair
 	userSSHKeys       map[string][]byte
@@ -17,12 +17,10 @@ limitations under the License.
 package applications
 
 import (
-	"crypto/x509"
 	"fmt"
 
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
-	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
 	"k8c.io/reconciler/pkg/reconciling"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -33,7 +31,7 @@ import (
 
 const ApplicationInstallationAdmissionWebhookName = "kubermatic-application-installations"
 
-func ApplicationInstallationValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespace string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
+func ApplicationInstallationValidatingWebhookConfigurationReconciler(caBundle []byte, namespace string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
 	return func() (string, reconciling.ValidatingWebhookConfigurationReconciler) {
 		return ApplicationInstallationAdmissionWebhookName, func(hook *admissionregistrationv1.ValidatingWebhookConfiguration) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
 			matchPolicy := admissionregistrationv1.Exact
@@ -52,7 +50,7 @@ func ApplicationInstallationValidatingWebhookConfigurationReconciler(caCert *x50
 					SideEffects:             &sideEffects,
 					TimeoutSeconds:          ptr.To[int32](30),
 					ClientConfig: admissionregistrationv1.WebhookClientConfig{
-						CABundle: triple.EncodeCertPEM(caCert),
+						CABundle: caBundle,
 						URL:      &url,
 					},
 					ObjectSelector:    &metav1.LabelSelector{},
@@ -80,7 +78,7 @@ func ApplicationInstallationValidatingWebhookConfigurationReconciler(caCert *x50
 	}
 }
 
-func ApplicationInstallationMutatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespace string) reconciling.NamedMutatingWebhookConfigurationReconcilerFactory {
+func ApplicationInstallationMutatingWebhookConfigurationReconciler(caBundle []byte, namespace string) reconciling.NamedMutatingWebhookConfigurationReconcilerFactory {
 	return func() (string, reconciling.MutatingWebhookConfigurationReconciler) {
 		return ApplicationInstallationAdmissionWebhookName, func(hook *admissionregistrationv1.MutatingWebhookConfiguration) (*admissionregistrationv1.MutatingWebhookConfiguration, error) {
 			matchPolicy := admissionregistrationv1.Exact
@@ -101,7 +99,7 @@ func ApplicationInstallationMutatingWebhookConfigurationReconciler(caCert *x509.
 					TimeoutSeconds:          ptr.To[int32](30),
 					ReinvocationPolicy:      &reinvocationPolicy,
 					ClientConfig: admissionregistrationv1.WebhookClientConfig{
-						CABundle: triple.EncodeCertPEM(caCert),
+						CABundle: caBundle,
 						URL:      &url,
 					},
 					Rules: []admissionregistrationv1.RuleWithOperations{
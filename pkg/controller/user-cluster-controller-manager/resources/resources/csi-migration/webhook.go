@@ -17,10 +17,7 @@ limitations under the License.
 package csimigration
 
 import (
-	"crypto/x509"
-
 	"k8c.io/kubermatic/v2/pkg/resources"
-	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
 	"k8c.io/reconciler/pkg/reconciling"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -29,7 +26,7 @@ import (
 )
 
 // ValidatingwebhookConfigurationReconciler returns the ValidatingwebhookConfiguration for the machine controller.
-func ValidatingwebhookConfigurationReconciler(caCert *x509.Certificate, namespace, name string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
+func ValidatingwebhookConfigurationReconciler(caBundle []byte, namespace, name string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
 	return func() (string, reconciling.ValidatingWebhookConfigurationReconciler) {
 		return name, func(validatingWebhookConfiguration *admissionregistrationv1.ValidatingWebhookConfiguration) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
 			sideEffect := admissionregistrationv1.SideEffectClassNone
@@ -52,7 +49,7 @@ func ValidatingwebhookConfigurationReconciler(caCert *x509.Certificate, namespac
 							Path:      ptr.To("/validate"),
 							Port:      ptr.To[int32](443),
 						},
-						CABundle: triple.EncodeCertPEM(caCert),
+						CABundle: caBundle,
 					},
 					NamespaceSelector: &metav1.LabelSelector{},
 					ObjectSelector:    &metav1.LabelSelector{},
@@ -17,10 +17,7 @@ limitations under the License.
 package csisnapshotter
 
 import (
-	"crypto/x509"
-
 	"k8c.io/kubermatic/v2/pkg/resources"
-	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
 	"k8c.io/reconciler/pkg/reconciling"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -30,7 +27,7 @@ import (
 
 // ValidatingSnapshotWebhookConfigurationReconciler returns the ValidatingWebhookConfiguration for the CSI external snapshotter.
 // Sourced from: https://github.com/kubernetes-csi/external-snapshotter/blob/v6.2.2/deploy/kubernetes/webhook-example/admission-configuration-template
-func ValidatingSnapshotWebhookConfigurationReconciler(caCert *x509.Certificate, namespace, name string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
+func ValidatingSnapshotWebhookConfigurationReconciler(caBundle []byte, namespace, name string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
 	return func() (string, reconciling.ValidatingWebhookConfigurationReconciler) {
 		return name, func(validatingWebhookConfiguration *admissionregistrationv1.ValidatingWebhookConfiguration) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
 			sideEffect := admissionregistrationv1.SideEffectClassNone
@@ -53,7 +50,7 @@ func ValidatingSnapshotWebhookConfigurationReconciler(caCert *x509.Certificate,
 							Path:      ptr.To("/volumesnapshot"),
 							Port:      ptr.To[int32](443),
 						},
-						CABundle: triple.EncodeCertPEM(caCert),
+						CABundle: caBundle,
 					},
 					NamespaceSelector: &metav1.LabelSelector{},
 					ObjectSelector:    &metav1.LabelSelector{},
@@ -17,11 +17,9 @@ limitations under the License.
 package machinecontroller
 
 import (
-	"crypto/x509"
 	"fmt"
 
 	"k8c.io/kubermatic/v2/pkg/resources"
-	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
 	"k8c.io/reconciler/pkg/reconciling"
 
 	corev1 "k8s.io/api/core/v1"
@@ -30,7 +28,7 @@ import (
 )
 
 // ClusterInfoConfigMapReconciler returns the func to create/update the ConfigMap.
-func ClusterInfoConfigMapReconciler(url string, caCert *x509.Certificate) reconciling.NamedConfigMapReconcilerFactory {
+func ClusterInfoConfigMapReconciler(url string, caBundle []byte) reconciling.NamedConfigMapReconcilerFactory {
 	return func() (string, reconciling.ConfigMapReconciler) {
 		return resources.ClusterInfoConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
 			if cm.Data == nil {
@@ -43,7 +41,7 @@ func ClusterInfoConfigMapReconciler(url string, caCert *x509.Certificate) reconc
 			kubeconfig.Clusters = map[string]*clientcmdapi.Cluster{
 				"": {
 					Server:                   url,
-					CertificateAuthorityData: triple.EncodeCertPEM(caCert),
+					CertificateAuthorityData: caBundle,
 				},
 			}
 
@@ -17,11 +17,9 @@ limitations under the License.
 package machinecontroller
 
 import (
-	"crypto/x509"
 	"fmt"
 
 	"k8c.io/kubermatic/v2/pkg/resources"
-	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
 	"k8c.io/reconciler/pkg/reconciling"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -29,7 +27,7 @@ import (
 )
 
 // MutatingwebhookConfigurationReconciler returns the MutatingwebhookConfiguration for the machine controller.
-func MutatingwebhookConfigurationReconciler(caCert *x509.Certificate, namespace string) reconciling.NamedMutatingWebhookConfigurationReconcilerFactory {
+func MutatingwebhookConfigurationReconciler(caBundle []byte, namespace string) reconciling.NamedMutatingWebhookConfigurationReconcilerFactory {
 	return func() (string, reconciling.MutatingWebhookConfigurationReconciler) {
 		return resources.MachineControllerMutatingWebhookConfigurationName, func(mutatingWebhookConfiguration *admissionregistrationv1.MutatingWebhookConfiguration) (*admissionregistrationv1.MutatingWebhookConfiguration, error) {
 			failurePolicy := admissionregistrationv1.Fail
@@ -62,7 +60,7 @@ func MutatingwebhookConfigurationReconciler(caCert *x509.Certificate, namespace
 			}}
 			mutatingWebhookConfiguration.Webhooks[0].ClientConfig = admissionregistrationv1.WebhookClientConfig{
 				URL:      &mdURL,
-				CABundle: triple.EncodeCertPEM(caCert),
+				CABundle: caBundle,
 			}
 
 			mutatingWebhookConfiguration.Webhooks[1].Name = fmt.Sprintf("%s-machines", resources.MachineControllerMutatingWebhookConfigurationName)
@@ -81,7 +79,7 @@ func MutatingwebhookConfigurationReconciler(caCert *x509.Certificate, namespace
 			}}
 			mutatingWebhookConfiguration.Webhooks[1].ClientConfig = admissionregistrationv1.WebhookClientConfig{
 				URL:      &mURL,
-				CABundle: triple.EncodeCertPEM(caCert),
+				CABundle: caBundle,
 			}
 
 			return mutatingWebhookConfiguration, nil
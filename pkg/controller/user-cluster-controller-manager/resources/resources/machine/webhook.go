@@ -17,11 +17,9 @@ limitations under the License.
 package machine
 
 import (
-	"crypto/x509"
 	"fmt"
 
 	"k8c.io/kubermatic/v2/pkg/resources"
-	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 	"k8c.io/reconciler/pkg/reconciling"
 
@@ -36,7 +34,7 @@ const (
 )
 
 // ValidatingWebhookConfigurationReconciler returns the ValidatingWebhookConfiguration for the machine CRD.
-func ValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespace string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
+func ValidatingWebhookConfigurationReconciler(caBundle []byte, namespace string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
 	return func() (string, reconciling.ValidatingWebhookConfigurationReconciler) {
 		return machineValidatingWebhookConfigurationName, func(hook *admissionregistrationv1.ValidatingWebhookConfiguration) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
 			matchPolicy := admissionregistrationv1.Exact
@@ -50,6 +48,15 @@ func ValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespac
 				resources.UserClusterWebhookUserListenPort,
 			)
 
+			mdURL := fmt.Sprintf("https://%s.%s.svc.cluster.local.:%d/validate-cluster-k8s-io-v1alpha1-machinedeployment",
+				resources.UserClusterWebhookServiceName,
+				namespace,
+				resources.UserClusterWebhookUserListenPort,
+			)
+
+			// MachineDeployments are never rejected, the webhook only returns warnings for resource quotas
+			mdFailurePolicy := admissionregistrationv1.Ignore
+
 			hook.Webhooks = []admissionregistrationv1.ValidatingWebhook{
 				{
 					Name:                    "machines.cluster.k8c.io", // this should be a FQDN
@@ -59,7 +66,7 @@ func ValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespac
 					SideEffects:             &sideEffects,
 					TimeoutSeconds:          ptr.To[int32](3),
 					ClientConfig: admissionregistrationv1.WebhookClientConfig{
-						CABundle: triple.EncodeCertPEM(caCert),
+						CABundle: caBundle,
 						URL:      &url,
 					},
 					ObjectSelector:    &metav1.LabelSelector{},
@@ -78,6 +85,34 @@ func ValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespac
 						},
 					},
 				},
+				{
+					Name:                    "machinedeployments.cluster.k8c.io", // this should be a FQDN
+					AdmissionReviewVersions: []string{admissionregistrationv1.SchemeGroupVersion.Version, admissionregistrationv1beta1.SchemeGroupVersion.Version},
+					MatchPolicy:             &matchPolicy,
+					FailurePolicy:           &mdFailurePolicy,
+					SideEffects:             &sideEffects,
+					TimeoutSeconds:          ptr.To[int32](3),
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						CABundle: caBundle,
+						URL:      &mdURL,
+					},
+					ObjectSelector:    &metav1.LabelSelector{},
+					NamespaceSelector: &metav1.LabelSelector{},
+					Rules: []admissionregistrationv1.RuleWithOperations{
+						{
+							Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{clusterv1alpha1.SchemeGroupVersion.Group},
+								APIVersions: []string{clusterv1alpha1.SchemeGroupVersion.Version},
+								Resources:   []string{"machinedeployments"},
+								Scope:       &scope,
+							},
+							Operations: []admissionregistrationv1.OperationType{
+								admissionregistrationv1.Create,
+								admissionregistrationv1.Update,
+							},
+						},
+					},
+				},
 			}
 			return hook, nil
 		}
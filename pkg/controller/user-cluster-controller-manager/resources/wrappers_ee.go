@@ -0,0 +1,30 @@
+//go:build ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/service"
+	"k8c.io/reconciler/pkg/reconciling"
+)
+
+func resourceQuotaValidatingWebhookConfigurationReconcilers(caBundle []byte, namespace string) []reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
+	return []reconciling.NamedValidatingWebhookConfigurationReconcilerFactory{
+		service.ValidatingWebhookConfigurationReconciler(caBundle, namespace),
+	}
+}
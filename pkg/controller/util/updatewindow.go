@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+var shortWeekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// UpdateWindowOpen checks whether the given update window is open at the given point
+// in time. If the window is closed, the duration until it opens the next time is
+// returned as well. A nil or incomplete update window is treated as always open.
+// The window is evaluated in UTC, as seed controllers have no notion of the user's
+// or the nodes' timezone.
+func UpdateWindowOpen(window *kubermaticv1.UpdateWindow, now time.Time) (bool, time.Duration, error) {
+	if window == nil || window.Start == "" || window.Length == "" {
+		return true, 0, nil
+	}
+
+	length, err := time.ParseDuration(window.Length)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid update window length %q: %w", window.Length, err)
+	}
+
+	now = now.UTC()
+
+	// a daily window by default, a weekly window if a weekday is given
+	period := 24 * time.Hour
+	clock := window.Start
+	weekday := now.Weekday()
+
+	if day, timeOfDay, found := strings.Cut(window.Start, " "); found {
+		wd, ok := shortWeekdays[day]
+		if !ok {
+			return false, 0, fmt.Errorf("invalid weekday %q in update window start", day)
+		}
+
+		period = 7 * 24 * time.Hour
+		clock = timeOfDay
+		weekday = wd
+	}
+
+	startOfDay, err := time.Parse("15:04", clock)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid update window start %q: %w", window.Start, err)
+	}
+
+	if length >= period {
+		return true, 0, nil
+	}
+
+	// find the most recent start of the window that is not in the future
+	dayOffset := int(weekday) - int(now.Weekday())
+	start := time.Date(now.Year(), now.Month(), now.Day()+dayOffset, startOfDay.Hour(), startOfDay.Minute(), 0, 0, time.UTC)
+	for start.After(now) {
+		start = start.Add(-period)
+	}
+
+	if now.Before(start.Add(length)) {
+		return true, 0, nil
+	}
+
+	return false, start.Add(period).Sub(now), nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+func TestUpdateWindowOpen(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		window       *kubermaticv1.UpdateWindow
+		now          time.Time
+		expectedOpen bool
+		expectedWait time.Duration
+		expectedErr  bool
+	}{
+		{
+			name:         "no window configured",
+			window:       nil,
+			expectedOpen: true,
+		},
+		{
+			name:         "incomplete window",
+			window:       &kubermaticv1.UpdateWindow{Start: "22:00"},
+			expectedOpen: true,
+		},
+		{
+			name:         "daily window currently open",
+			window:       &kubermaticv1.UpdateWindow{Start: "11:30", Length: "1h"},
+			expectedOpen: true,
+		},
+		{
+			name:         "daily window opens later today",
+			window:       &kubermaticv1.UpdateWindow{Start: "22:00", Length: "2h"},
+			expectedOpen: false,
+			expectedWait: 10 * time.Hour,
+		},
+		{
+			name:         "daily window has closed earlier today",
+			window:       &kubermaticv1.UpdateWindow{Start: "08:00", Length: "2h"},
+			expectedOpen: false,
+			expectedWait: 20 * time.Hour,
+		},
+		{
+			name:         "daily window that started yesterday is still open",
+			window:       &kubermaticv1.UpdateWindow{Start: "22:00", Length: "16h"},
+			expectedOpen: true,
+		},
+		{
+			name:         "weekly window currently open",
+			window:       &kubermaticv1.UpdateWindow{Start: "Tue 20:00", Length: "24h"},
+			expectedOpen: true,
+		},
+		{
+			name:         "weekly window opens later this week",
+			window:       &kubermaticv1.UpdateWindow{Start: "Fri 12:00", Length: "2h"},
+			expectedOpen: false,
+			expectedWait: 2 * 24 * time.Hour,
+		},
+		{
+			name:         "weekly window has closed earlier this week",
+			window:       &kubermaticv1.UpdateWindow{Start: "Mon 12:00", Length: "2h"},
+			expectedOpen: false,
+			expectedWait: 5 * 24 * time.Hour,
+		},
+		{
+			name:         "non-UTC reference times are converted",
+			window:       &kubermaticv1.UpdateWindow{Start: "11:30", Length: "1h"},
+			now:          now.In(time.FixedZone("UTC+2", 2*60*60)),
+			expectedOpen: true,
+		},
+		{
+			name:        "invalid length",
+			window:      &kubermaticv1.UpdateWindow{Start: "11:30", Length: "forever"},
+			expectedErr: true,
+		},
+		{
+			name:        "invalid weekday",
+			window:      &kubermaticv1.UpdateWindow{Start: "Someday 11:30", Length: "1h"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reference := tc.now
+			if reference.IsZero() {
+				reference = now
+			}
+
+			open, wait, err := UpdateWindowOpen(tc.window, reference)
+			if err != nil {
+				if !tc.expectedErr {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+
+			if tc.expectedErr {
+				t.Fatal("Expected error, but got none.")
+			}
+
+			if open != tc.expectedOpen {
+				t.Fatalf("Expected window open to be %v, but got %v.", tc.expectedOpen, open)
+			}
+
+			if wait != tc.expectedWait {
+				t.Fatalf("Expected window to open in %v, but got %v.", tc.expectedWait, wait)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -401,6 +402,11 @@ func DefaultConfiguration(config *kubermaticv1.KubermaticConfiguration, logger *
 		logger.Debugw("Defaulting field", "field", "userCluster.apiserverReplicas", "value", *configCopy.Spec.UserCluster.APIServerReplicas)
 	}
 
+	if configCopy.Spec.UserCluster.CertificateRenewalThreshold == nil {
+		configCopy.Spec.UserCluster.CertificateRenewalThreshold = &metav1.Duration{Duration: resources.DefaultCertificateRenewalThreshold}
+		logger.Debugw("Defaulting field", "field", "userCluster.certificateRenewalThreshold", "value", configCopy.Spec.UserCluster.CertificateRenewalThreshold.Duration)
+	}
+
 	// only default the accessible addons if they are not configured at all (nil)
 	if configCopy.Spec.API.AccessibleAddons == nil {
 		configCopy.Spec.API.AccessibleAddons = DefaultAccessibleAddons
@@ -702,6 +708,9 @@ func defaultExternalClusterVersioning(settings *kubermaticv1.KubermaticVersionin
 	return nil
 }
 
+// DefaultBackupObjectName matches the object name used by the DefaultBackupStoreContainer.
+const DefaultBackupObjectName = "$(CLUSTER)-$(BACKUP_TO_CREATE)"
+
 const DefaultBackupStoreContainer = `
 name: store-container
 image: d3fk/s3cmd@sha256:fb4c4dcf3b842c3d0ead58bda26d05d045b77546e11ac2143d90abca02cbe823
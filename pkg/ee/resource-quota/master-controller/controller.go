@@ -27,6 +27,7 @@ package mastercontroller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -132,23 +133,38 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 			}
 			return fmt.Errorf("error getting seed %q resource quota: %w", seed, err)
 		}
-		localUsage := seedResourceQuota.Status.LocalUsage
-		if localUsage.CPU != nil {
-			globalUsage.CPU.Add(*localUsage.CPU)
-		}
-		if localUsage.Memory != nil {
-			globalUsage.Memory.Add(*localUsage.Memory)
-		}
-		if localUsage.Storage != nil {
-			globalUsage.Storage.Add(*localUsage.Storage)
-		}
+		globalUsage.Add(seedResourceQuota.Status.LocalUsage)
 	}
 
 	if err := r.ensureGlobalUsage(ctx, log, resourceQuota, globalUsage); err != nil {
 		return err
 	}
 
-	return nil
+	return r.ensureSoftThresholdCondition(ctx, resourceQuota)
+}
+
+// ensureSoftThresholdCondition sets the SoftThresholdExceeded condition according to the global usage
+// and emits an event when a soft threshold gets exceeded.
+func (r *reconciler) ensureSoftThresholdCondition(ctx context.Context, resourceQuota *kubermaticv1.ResourceQuota) error {
+	exceeded := resourceQuota.Spec.ExceededSoftThresholds(resourceQuota.Status.GlobalUsage)
+
+	status := corev1.ConditionFalse
+	reason := "WithinSoftThresholds"
+	message := "Usage of all resources is below their soft thresholds."
+	if len(exceeded) > 0 {
+		status = corev1.ConditionTrue
+		reason = "SoftThresholdExceeded"
+		message = fmt.Sprintf("Usage of %s reached the soft threshold of the quota.", strings.Join(exceeded, ", "))
+	}
+
+	oldCondition := resourceQuota.Status.Conditions[kubermaticv1.ResourceQuotaConditionSoftThresholdExceeded]
+	if status == corev1.ConditionTrue && (oldCondition.Status != status || oldCondition.Message != message) {
+		r.recorder.Event(resourceQuota, corev1.EventTypeWarning, reason, message)
+	}
+
+	return util.UpdateResourceQuotaStatus(ctx, r.masterClient, resourceQuota, func(rq *kubermaticv1.ResourceQuota) {
+		util.SetResourceQuotaCondition(rq, kubermaticv1.ResourceQuotaConditionSoftThresholdExceeded, status, reason, message)
+	})
 }
 
 func (r *reconciler) ensureGlobalUsage(ctx context.Context, log *zap.SugaredLogger, resourceQuota *kubermaticv1.ResourceQuota,
@@ -34,9 +34,11 @@ import (
 	"k8c.io/kubermatic/v2/pkg/test/fake"
 	"k8c.io/kubermatic/v2/pkg/test/generator"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -48,13 +50,17 @@ func TestReconcile(t *testing.T) {
 		name          string
 		requestName   string
 		expectedUsage kubermaticv1.ResourceDetails
-		masterClient  ctrlruntimeclient.Client
-		seedClients   map[string]ctrlruntimeclient.Client
+		// expectedSoftThresholdStatus is the expected status of the SoftThresholdExceeded condition
+		expectedSoftThresholdStatus corev1.ConditionStatus
+		expectedEvents              int
+		masterClient                ctrlruntimeclient.Client
+		seedClients                 map[string]ctrlruntimeclient.Client
 	}{
 		{
-			name:          "scenario 1: calculate rq global usage",
-			requestName:   rqName,
-			expectedUsage: *genResourceDetails("7", "7G", "18G"),
+			name:                        "scenario 1: calculate rq global usage",
+			requestName:                 rqName,
+			expectedUsage:               *genResourceDetails("7", "7G", "18G"),
+			expectedSoftThresholdStatus: corev1.ConditionFalse,
 			masterClient: fake.
 				NewClientBuilder().
 				WithObjects(genResourceQuota(rqName, kubermaticv1.ResourceDetails{}), generator.GenTestSeed()).
@@ -70,14 +76,44 @@ func TestReconcile(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:                        "scenario 2: soft threshold exceeded",
+			requestName:                 rqName,
+			expectedUsage:               *genResourceDetails("7", "7G", "18G"),
+			expectedSoftThresholdStatus: corev1.ConditionTrue,
+			expectedEvents:              1,
+			masterClient: fake.
+				NewClientBuilder().
+				WithObjects(func() *kubermaticv1.ResourceQuota {
+					rq := genResourceQuota(rqName, kubermaticv1.ResourceDetails{})
+					rq.Spec.Quota = *genResourceDetails("8", "100G", "100G")
+					rq.Spec.SoftThresholds = &kubermaticv1.ResourceThresholds{
+						CPU:    ptr.To[int32](80),
+						Memory: ptr.To[int32](80),
+					}
+					return rq
+				}(), generator.GenTestSeed()).
+				Build(),
+			seedClients: map[string]ctrlruntimeclient.Client{
+				"first": fake.
+					NewClientBuilder().
+					WithObjects(genResourceQuota(rqName, *genResourceDetails("2", "5G", "10G"))).
+					Build(),
+				"second": fake.
+					NewClientBuilder().
+					WithObjects(genResourceQuota(rqName, *genResourceDetails("5", "2G", "8G"))).
+					Build(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
+			recorder := record.NewFakeRecorder(10)
 			r := &reconciler{
 				log:          kubermaticlog.Logger,
-				recorder:     &record.FakeRecorder{},
+				recorder:     recorder,
 				masterClient: tc.masterClient,
 				seedClients:  tc.seedClients,
 			}
@@ -97,6 +133,15 @@ func TestReconcile(t *testing.T) {
 			if !diff.SemanticallyEqual(tc.expectedUsage, rq.Status.GlobalUsage) {
 				t.Fatalf("Objects differ:\n%v", diff.ObjectDiff(tc.expectedUsage, rq.Status.GlobalUsage))
 			}
+
+			condition := rq.Status.Conditions[kubermaticv1.ResourceQuotaConditionSoftThresholdExceeded]
+			if condition.Status != tc.expectedSoftThresholdStatus {
+				t.Errorf("Expected SoftThresholdExceeded condition to be %q, got %q.", tc.expectedSoftThresholdStatus, condition.Status)
+			}
+
+			if len(recorder.Events) != tc.expectedEvents {
+				t.Errorf("Expected %d events, got %d.", tc.expectedEvents, len(recorder.Events))
+			}
 		})
 	}
 }
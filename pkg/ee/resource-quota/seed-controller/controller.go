@@ -34,16 +34,16 @@ import (
 	k8cequality "k8c.io/kubermatic/sdk/v2/apis/equality"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/controller/util"
+	"k8c.io/kubermatic/v2/pkg/ee/resource-quota/subject"
 	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -83,6 +83,7 @@ func Add(
 		}).
 		For(&kubermaticv1.ResourceQuota{}).
 		Watches(&kubermaticv1.Cluster{}, enqueueResourceQuota(reconciler.seedClient, reconciler.log, workerName), builder.WithPredicates(workerlabel.Predicate(workerName), withClusterEventFilter())).
+		Watches(&kubermaticv1.GroupProjectBinding{}, enqueueGroupResourceQuotas(reconciler.seedClient)).
 		Build(reconciler)
 
 	return err
@@ -114,8 +115,8 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 	// If the controller is in worker-name mode, ignore all non-Cluster-RQ's
 	// (i.e. all RQ's that span multiple clusters), as it makes no sense to
 	// update an RQ's status with data that spans only a subset of subjects.
-	// As of now, only project RQ's exist and so there is no single-cluster-RQ.
-	if r.workerName != "" /* resourceQuota.Spec.Subject.Kind != "cluster" */ {
+	// As of now, there is no single-cluster-RQ.
+	if r.workerName != "" {
 		log.Debug("Ignoring request because worker-name is set.")
 		return nil
 	}
@@ -126,30 +127,20 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 		return nil
 	}
 
-	projectIDReq, err := labels.NewRequirement(kubermaticv1.ProjectIDLabelKey, selection.Equals, []string{resourceQuota.Spec.Subject.Name})
+	clusters, err := subject.ClustersForSubject(ctx, r.seedClient, resourceQuota.Spec.Subject)
 	if err != nil {
-		return fmt.Errorf("error creating project id req: %w", err)
-	}
-
-	clusterList := &kubermaticv1.ClusterList{}
-	if err := r.seedClient.List(ctx, clusterList,
-		&ctrlruntimeclient.ListOptions{LabelSelector: labels.NewSelector().Add(*projectIDReq)}); err != nil {
-		return fmt.Errorf("failed listing clusters: %w", err)
+		return err
 	}
 
 	localUsage := kubermaticv1.NewResourceDetails(resource.Quantity{}, resource.Quantity{}, resource.Quantity{})
-	for _, cluster := range clusterList.Items {
+	localUsage.Clusters = ptr.To(int64(len(clusters)))
+	localUsage.Machines = ptr.To[int64](0)
+	localUsage.LoadBalancers = ptr.To[int64](0)
+	localUsage.PublicIPs = ptr.To[int64](0)
+
+	for _, cluster := range clusters {
 		if cluster.Status.ResourceUsage != nil {
-			clusterUsage := cluster.Status.ResourceUsage
-			if clusterUsage.CPU != nil {
-				localUsage.CPU.Add(*clusterUsage.CPU)
-			}
-			if clusterUsage.Memory != nil {
-				localUsage.Memory.Add(*clusterUsage.Memory)
-			}
-			if clusterUsage.Storage != nil {
-				localUsage.Storage.Add(*clusterUsage.Storage)
-			}
+			localUsage.Add(*cluster.Status.ResourceUsage)
 		}
 	}
 
@@ -166,13 +157,15 @@ func (r *reconciler) ensureLocalUsage(ctx context.Context, log *zap.SugaredLogge
 		log.Debugw("local usage for resource quota is the same, not updating",
 			"cpu", localUsage.CPU.String(),
 			"memory", localUsage.Memory.String(),
-			"storage", localUsage.Storage.String())
+			"storage", localUsage.Storage.String(),
+			"clusters", localUsage.Clusters)
 		return nil
 	}
 	log.Debugw("local usage for resource quota needs update",
 		"cpu", localUsage.CPU.String(),
 		"memory", localUsage.Memory.String(),
-		"storage", localUsage.Storage.String())
+		"storage", localUsage.Storage.String(),
+		"clusters", localUsage.Clusters)
 
 	return util.UpdateResourceQuotaStatus(ctx, r.seedClient, resourceQuota, func(rq *kubermaticv1.ResourceQuota) {
 		rq.Status.LocalUsage = *localUsage
@@ -181,9 +174,9 @@ func (r *reconciler) ensureLocalUsage(ctx context.Context, log *zap.SugaredLogge
 
 func withClusterEventFilter() predicate.Predicate {
 	return predicate.Funcs{
-		// when cluster is created, no point to calculate yet as the machines are not created
+		// when cluster is created, the machines are not created yet, but the number of clusters changes
 		CreateFunc: func(e event.CreateEvent) bool {
-			return false
+			return true
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oldCluster, ok := e.ObjectOld.(*kubermaticv1.Cluster)
@@ -207,51 +200,71 @@ func withClusterEventFilter() predicate.Predicate {
 
 func enqueueResourceQuota(client ctrlruntimeclient.Client, log *zap.SugaredLogger, workerName string) handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
-		var requests []reconcile.Request
+		cluster, ok := a.(*kubermaticv1.Cluster)
+		if !ok {
+			return nil
+		}
+
+		// If a worker-name is given, we want to only reconcile clusters that have that label;
+		// this means for multi-cluster resources (e.g. project quotas for projects), we should
+		// skip them, as they will contain data for both worker-named and unnamed clusters;
+		// otherwise this controller (with a worker-name) would fight another controller (without
+		// a worker-name) about the current status of the resource quota.
+		// As of now, all quota subjects span multiple clusters.
+		if workerName != "" {
+			return nil
+		}
 
-		clusterLabels := a.GetLabels()
-		projectID, ok := clusterLabels[kubermaticv1.ProjectIDLabelKey]
+		projectID, ok := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
 		if !ok {
-			log.Debugw("cluster does not have `project-id` label, skipping", "cluster", a.GetName())
-			return requests
+			log.Debugw("cluster does not have `project-id` label, skipping", "cluster", cluster.Name)
+			return nil
 		}
 
-		subjectNameReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectNameLabelKey, selection.Equals, []string{projectID})
+		quotas, err := subject.ResourceQuotasForCluster(ctx, client, projectID, cluster.Spec.Cloud.DatacenterName)
 		if err != nil {
-			utilruntime.HandleError(fmt.Errorf("error creating subject name req: %w", err))
-			return requests
+			utilruntime.HandleError(fmt.Errorf("failed to get resource quotas for cluster: %w", err))
+			return nil
 		}
 
-		subjectKindReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectKindLabelKey, selection.Equals, []string{kubermaticv1.ProjectSubjectKind})
-		if err != nil {
-			utilruntime.HandleError(fmt.Errorf("error creating subject name req: %w", err))
-			return requests
+		return quotaRequests(quotas)
+	})
+}
+
+// enqueueGroupResourceQuotas enqueues the resource quotas of the group of a GroupProjectBinding,
+// as the binding changes the clusters the quota applies to.
+func enqueueGroupResourceQuotas(client ctrlruntimeclient.Client) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
+		binding, ok := a.(*kubermaticv1.GroupProjectBinding)
+		if !ok {
+			return nil
 		}
 
 		resourceQuotaList := &kubermaticv1.ResourceQuotaList{}
-
-		if err := client.List(ctx, resourceQuotaList,
-			&ctrlruntimeclient.ListOptions{LabelSelector: labels.NewSelector().Add(*subjectKindReq, *subjectNameReq)},
-		); err != nil {
+		if err := client.List(ctx, resourceQuotaList); err != nil {
 			utilruntime.HandleError(fmt.Errorf("failed to list resourceQuotas: %w", err))
-			return requests
+			return nil
 		}
 
+		var quotas []kubermaticv1.ResourceQuota
 		for _, rq := range resourceQuotaList.Items {
-			// If a worker-name is given, we want to only reconcile clusters that have that label;
-			// this means for multi-cluster resources (e.g. project quotas for projects), we should
-			// skip them, as they will contain data for both worker-named and unnamed clusters;
-			// otherwise this controller (with a worker-name) would fight another controller (without
-			// a worker-name) about the current status of the resource quota.
-			// As of now, only project quotas exist though.
-			if workerName == "" || rq.Spec.Subject.Kind != kubermaticv1.ProjectSubjectKind {
-				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
-					Name:      rq.Name,
-					Namespace: rq.Namespace,
-				}})
+			if rq.Spec.Subject.Kind == kubermaticv1.GroupSubjectKind && rq.Spec.Subject.Name == binding.Spec.Group {
+				quotas = append(quotas, rq)
 			}
 		}
 
-		return requests
+		return quotaRequests(quotas)
 	})
 }
+
+func quotaRequests(quotas []kubermaticv1.ResourceQuota) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, rq := range quotas {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      rq.Name,
+			Namespace: rq.Namespace,
+		}})
+	}
+
+	return requests
+}
@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -54,15 +55,44 @@ func TestReconcile(t *testing.T) {
 		{
 			name:          "scenario 1: calculate rq local usage",
 			requestName:   rqName,
-			resourceQuota: genResourceQuota(rqName),
+			resourceQuota: genResourceQuota(rqName, kubermaticv1.ProjectSubjectKind, projectID),
 			seedClient: fake.
 				NewClientBuilder().
-				WithObjects(genResourceQuota(rqName),
-					genCluster("c1", projectID, "2", "5G", "10G"),
-					genCluster("c2", projectID, "5", "2G", "8G"),
-					genCluster("notSameProjectCluster", "impostor", "3", "3G", "3G")).
+				WithObjects(genResourceQuota(rqName, kubermaticv1.ProjectSubjectKind, projectID),
+					genCluster("c1", projectID, "dc1", "2", "5G", "10G"),
+					genCluster("c2", projectID, "dc1", "5", "2G", "8G"),
+					genCluster("notSameProjectCluster", "impostor", "dc1", "3", "3G", "3G")).
 				Build(),
-			expectedUsage: *genResourceDetails("7", "7G", "18G"),
+			expectedUsage: *genUsage(genResourceDetails("7", "7G", "18G"), 2, 2),
+		},
+		{
+			name:          "scenario 2: calculate rq local usage for group",
+			requestName:   rqName,
+			resourceQuota: genResourceQuota(rqName, kubermaticv1.GroupSubjectKind, "developers"),
+			seedClient: fake.
+				NewClientBuilder().
+				WithObjects(genResourceQuota(rqName, kubermaticv1.GroupSubjectKind, "developers"),
+					genGroupProjectBinding("developers", projectID),
+					genGroupProjectBinding("developers", "project2"),
+					genGroupProjectBinding("admins", "project3"),
+					genCluster("c1", projectID, "dc1", "2", "5G", "10G"),
+					genCluster("c2", "project2", "dc2", "5", "2G", "8G"),
+					genCluster("c3", "project3", "dc1", "3", "3G", "3G")).
+				Build(),
+			expectedUsage: *genUsage(genResourceDetails("7", "7G", "18G"), 2, 2),
+		},
+		{
+			name:          "scenario 3: calculate rq local usage for datacenter",
+			requestName:   rqName,
+			resourceQuota: genResourceQuota(rqName, kubermaticv1.DatacenterSubjectKind, "dc1"),
+			seedClient: fake.
+				NewClientBuilder().
+				WithObjects(genResourceQuota(rqName, kubermaticv1.DatacenterSubjectKind, "dc1"),
+					genCluster("c1", projectID, "dc1", "2", "5G", "10G"),
+					genCluster("c2", "project2", "dc2", "5", "2G", "8G"),
+					genCluster("c3", "project3", "dc1", "3", "3G", "3G")).
+				Build(),
+			expectedUsage: *genUsage(genResourceDetails("5", "8G", "13G"), 2, 2),
 		},
 	}
 
@@ -94,13 +124,13 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
-func genResourceQuota(name string) *kubermaticv1.ResourceQuota {
+func genResourceQuota(name, kind, subjectName string) *kubermaticv1.ResourceQuota {
 	rq := &kubermaticv1.ResourceQuota{}
 	rq.Name = name
 	rq.Spec = kubermaticv1.ResourceQuotaSpec{
 		Subject: kubermaticv1.Subject{
-			Name: projectID,
-			Kind: kubermaticv1.ProjectSubjectKind,
+			Name: subjectName,
+			Kind: kind,
 		},
 	}
 
@@ -111,11 +141,31 @@ func genResourceDetails(cpu, mem, storage string) *kubermaticv1.ResourceDetails
 	return kubermaticv1.NewResourceDetails(resource.MustParse(cpu), resource.MustParse(mem), resource.MustParse(storage))
 }
 
-func genCluster(name, projectID, cpu, mem, storage string) *kubermaticv1.Cluster {
+func genUsage(details *kubermaticv1.ResourceDetails, clusters, machines int64) *kubermaticv1.ResourceDetails {
+	details.Clusters = ptr.To(clusters)
+	details.Machines = ptr.To(machines)
+	details.LoadBalancers = ptr.To[int64](0)
+	details.PublicIPs = ptr.To[int64](0)
+
+	return details
+}
+
+func genCluster(name, projectID, datacenter, cpu, mem, storage string) *kubermaticv1.Cluster {
 	cluster := &kubermaticv1.Cluster{}
 	cluster.Name = name
 	cluster.Labels = map[string]string{kubermaticv1.ProjectIDLabelKey: projectID}
+	cluster.Spec.Cloud.DatacenterName = datacenter
 	cluster.Status.ResourceUsage = genResourceDetails(cpu, mem, storage)
+	cluster.Status.ResourceUsage.Machines = ptr.To[int64](1)
 
 	return cluster
 }
+
+func genGroupProjectBinding(group, projectID string) *kubermaticv1.GroupProjectBinding {
+	binding := &kubermaticv1.GroupProjectBinding{}
+	binding.Name = group + "-" + projectID
+	binding.Spec.Group = group
+	binding.Spec.ProjectID = projectID
+
+	return binding
+}
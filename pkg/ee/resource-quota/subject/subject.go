@@ -0,0 +1,154 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package subject
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GroupsForProject returns the names of the groups bound to the project.
+func GroupsForProject(ctx context.Context, client ctrlruntimeclient.Client, projectID string) (sets.Set[string], error) {
+	bindings := &kubermaticv1.GroupProjectBindingList{}
+	if err := client.List(ctx, bindings); err != nil {
+		return nil, fmt.Errorf("failed to list group project bindings: %w", err)
+	}
+
+	groups := sets.New[string]()
+	for _, binding := range bindings.Items {
+		if binding.Spec.ProjectID == projectID {
+			groups.Insert(binding.Spec.Group)
+		}
+	}
+
+	return groups, nil
+}
+
+// ProjectsForGroup returns the IDs of the projects the group is bound to.
+func ProjectsForGroup(ctx context.Context, client ctrlruntimeclient.Client, group string) (sets.Set[string], error) {
+	bindings := &kubermaticv1.GroupProjectBindingList{}
+	if err := client.List(ctx, bindings); err != nil {
+		return nil, fmt.Errorf("failed to list group project bindings: %w", err)
+	}
+
+	projects := sets.New[string]()
+	for _, binding := range bindings.Items {
+		if binding.Spec.Group == group {
+			projects.Insert(binding.Spec.ProjectID)
+		}
+	}
+
+	return projects, nil
+}
+
+// ClustersForSubject returns the clusters the resource quota subject applies to.
+func ClustersForSubject(ctx context.Context, client ctrlruntimeclient.Client, subject kubermaticv1.Subject) ([]kubermaticv1.Cluster, error) {
+	clusterList := &kubermaticv1.ClusterList{}
+
+	switch subject.Kind {
+	case kubermaticv1.ProjectSubjectKind:
+		if err := client.List(ctx, clusterList, ctrlruntimeclient.MatchingLabels{kubermaticv1.ProjectIDLabelKey: subject.Name}); err != nil {
+			return nil, fmt.Errorf("failed listing clusters: %w", err)
+		}
+
+		return clusterList.Items, nil
+
+	case kubermaticv1.GroupSubjectKind:
+		projects, err := ProjectsForGroup(ctx, client, subject.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if projects.Len() == 0 {
+			return nil, nil
+		}
+
+		projectIDReq, err := labels.NewRequirement(kubermaticv1.ProjectIDLabelKey, selection.In, sets.List(projects))
+		if err != nil {
+			return nil, fmt.Errorf("error creating project id req: %w", err)
+		}
+
+		if err := client.List(ctx, clusterList, &ctrlruntimeclient.ListOptions{LabelSelector: labels.NewSelector().Add(*projectIDReq)}); err != nil {
+			return nil, fmt.Errorf("failed listing clusters: %w", err)
+		}
+
+		return clusterList.Items, nil
+
+	case kubermaticv1.DatacenterSubjectKind:
+		if err := client.List(ctx, clusterList); err != nil {
+			return nil, fmt.Errorf("failed listing clusters: %w", err)
+		}
+
+		var clusters []kubermaticv1.Cluster
+		for _, cluster := range clusterList.Items {
+			if cluster.Spec.Cloud.DatacenterName == subject.Name {
+				clusters = append(clusters, cluster)
+			}
+		}
+
+		return clusters, nil
+
+	default:
+		return nil, fmt.Errorf("unknown resource quota subject kind %q", subject.Kind)
+	}
+}
+
+// ResourceQuotasForCluster returns the resource quotas that apply to a cluster of the project in the datacenter,
+// i.e. the quota of the project, the quotas of the groups bound to the project and the quota of the datacenter.
+func ResourceQuotasForCluster(ctx context.Context, client ctrlruntimeclient.Client, projectID string, datacenter string) ([]kubermaticv1.ResourceQuota, error) {
+	groups, err := GroupsForProject(ctx, client, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	subjects := sets.New(kubermaticv1.Subject{Kind: kubermaticv1.ProjectSubjectKind, Name: projectID})
+	for group := range groups {
+		subjects.Insert(kubermaticv1.Subject{Kind: kubermaticv1.GroupSubjectKind, Name: group})
+	}
+	if datacenter != "" {
+		subjects.Insert(kubermaticv1.Subject{Kind: kubermaticv1.DatacenterSubjectKind, Name: datacenter})
+	}
+
+	quotaList := &kubermaticv1.ResourceQuotaList{}
+	if err := client.List(ctx, quotaList); err != nil {
+		return nil, fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+
+	var quotas []kubermaticv1.ResourceQuota
+	for _, quota := range quotaList.Items {
+		if subjects.Has(quota.Spec.Subject) {
+			quotas = append(quotas, quota)
+		}
+	}
+
+	return quotas, nil
+}
@@ -27,6 +27,7 @@ package resourceusagecontroller
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"go.uber.org/zap"
 
@@ -43,8 +44,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -78,6 +81,7 @@ func Add(log *zap.SugaredLogger, seedMgr, userMgr manager.Manager, clusterName s
 	_, err := builder.ControllerManagedBy(userMgr).
 		Named(controllerName).
 		For(&clusterv1alpha1.Machine{}, builder.WithPredicates(predicate.ByNamespace(metav1.NamespaceSystem))).
+		Watches(&corev1.Service{}, enqueueCluster(clusterName), builder.WithPredicates(predicate.Factory(isLoadBalancerService))).
 		Build(r)
 
 	return err
@@ -107,7 +111,12 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to get cluster: %w", err)
 	}
 
-	err = r.reconcile(ctx, cluster, machines)
+	services := &corev1.ServiceList{}
+	if err := r.userClient.List(ctx, services); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get services: %w", err)
+	}
+
+	err = r.reconcile(ctx, cluster, machines, services)
 	if err != nil {
 		r.recorder.Event(cluster, corev1.EventTypeWarning, "ClusterResourceUsageReconcileFailed", err.Error())
 	}
@@ -115,9 +124,18 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{}, err
 }
 
-func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluster, machines *clusterv1alpha1.MachineList) error {
+func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluster, machines *clusterv1alpha1.MachineList, services *corev1.ServiceList) error {
 	resourceUsage := kubermaticv1.NewResourceDetails(resource.Quantity{}, resource.Quantity{}, resource.Quantity{})
+	resourceUsage.Machines = ptr.To(int64(len(machines.Items)))
+
+	var loadBalancers, publicIPs int64
 	for _, machine := range machines.Items {
+		for _, address := range machine.Status.Addresses {
+			if address.Type == corev1.NodeExternalIP && isPublicIP(address.Address) {
+				publicIPs++
+			}
+		}
+
 		resourceDetails, err := machinevalidation.GetMachineResourceUsage(ctx, r.userClient, &machine, r.caBundle)
 		if err != nil {
 			return fmt.Errorf("error getting machine resource usage for machine %q: %w", machine.Name, err)
@@ -128,9 +146,49 @@ func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 		resourceUsage.Storage.Add(*resourceDetails.Storage())
 	}
 
+	for _, service := range services.Items {
+		if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			continue
+		}
+
+		loadBalancers++
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if isPublicIP(ingress.IP) {
+				publicIPs++
+			}
+		}
+	}
+
+	resourceUsage.LoadBalancers = ptr.To(loadBalancers)
+	resourceUsage.PublicIPs = ptr.To(publicIPs)
+
 	cluster.Status.ResourceUsage = resourceUsage
 
 	return util.UpdateClusterStatus(ctx, r.seedClient, cluster, func(c *kubermaticv1.Cluster) {
 		c.Status.ResourceUsage = resourceUsage
 	})
 }
+
+// isPublicIP returns true if the given IP is neither private, nor loopback or link-local.
+func isPublicIP(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	return !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsUnspecified()
+}
+
+// isLoadBalancerService is used as filter, as a service could have been changed from or to type LoadBalancer.
+func isLoadBalancerService(obj ctrlruntimeclient.Object) bool {
+	service, ok := obj.(*corev1.Service)
+	return ok && service.Spec.Type == corev1.ServiceTypeLoadBalancer
+}
+
+// enqueueCluster enqueues a request for the cluster, as the controller always reconciles all
+// machines and services of the user cluster.
+func enqueueCluster(clusterName string) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(_ context.Context, _ ctrlruntimeclient.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: clusterName}}}
+	})
+}
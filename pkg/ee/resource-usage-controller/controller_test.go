@@ -36,10 +36,13 @@ import (
 	"k8c.io/kubermatic/v2/pkg/test/generator"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -48,6 +51,7 @@ func TestReconcile(t *testing.T) {
 		name                  string
 		cluster               *kubermaticv1.Cluster
 		machines              []*clusterv1alpha1.Machine
+		services              []*corev1.Service
 		expectedResourceUsage *kubermaticv1.ResourceDetails
 	}{
 		{
@@ -55,9 +59,12 @@ func TestReconcile(t *testing.T) {
 			cluster:  generator.GenDefaultCluster(),
 			machines: []*clusterv1alpha1.Machine{genFakeMachine("m1", "5", "5G", "10G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("5"),
-				Memory:  getQuantity("5G"),
-				Storage: getQuantity("10G"),
+				CPU:           getQuantity("5"),
+				Memory:        getQuantity("5G"),
+				Storage:       getQuantity("10G"),
+				Machines:      ptr.To[int64](1),
+				LoadBalancers: ptr.To[int64](0),
+				PublicIPs:     ptr.To[int64](0),
 			},
 		},
 		{
@@ -69,9 +76,12 @@ func TestReconcile(t *testing.T) {
 			}(),
 			machines: []*clusterv1alpha1.Machine{genFakeMachine("m1", "5", "5G", "10G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("5"),
-				Memory:  getQuantity("5G"),
-				Storage: getQuantity("10G"),
+				CPU:           getQuantity("5"),
+				Memory:        getQuantity("5G"),
+				Storage:       getQuantity("10G"),
+				Machines:      ptr.To[int64](1),
+				LoadBalancers: ptr.To[int64](0),
+				PublicIPs:     ptr.To[int64](0),
 			},
 		},
 		{
@@ -81,9 +91,12 @@ func TestReconcile(t *testing.T) {
 				genFakeMachine("m1", "5", "5G", "10G"),
 				genFakeMachine("m2", "2", "3G", "5G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("7"),
-				Memory:  getQuantity("8G"),
-				Storage: getQuantity("15G"),
+				CPU:           getQuantity("7"),
+				Memory:        getQuantity("8G"),
+				Storage:       getQuantity("15G"),
+				Machines:      ptr.To[int64](2),
+				LoadBalancers: ptr.To[int64](0),
+				PublicIPs:     ptr.To[int64](0),
 			},
 		},
 		{
@@ -94,9 +107,41 @@ func TestReconcile(t *testing.T) {
 				return c
 			}(),
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("0"),
-				Memory:  getQuantity("0"),
-				Storage: getQuantity("0"),
+				CPU:           getQuantity("0"),
+				Memory:        getQuantity("0"),
+				Storage:       getQuantity("0"),
+				Machines:      ptr.To[int64](0),
+				LoadBalancers: ptr.To[int64](0),
+				PublicIPs:     ptr.To[int64](0),
+			},
+		},
+		{
+			name:    "scenario 5: count load balancers and public IPs",
+			cluster: generator.GenDefaultCluster(),
+			machines: []*clusterv1alpha1.Machine{
+				func() *clusterv1alpha1.Machine {
+					m := genFakeMachine("m1", "1", "1G", "1G")
+					m.Status.Addresses = []corev1.NodeAddress{
+						{Type: corev1.NodeExternalIP, Address: "203.0.113.10"},
+						{Type: corev1.NodeExternalIP, Address: "10.0.0.10"},
+						{Type: corev1.NodeInternalIP, Address: "192.168.0.10"},
+					}
+					return m
+				}(),
+			},
+			services: []*corev1.Service{
+				genService("lb-public", corev1.ServiceTypeLoadBalancer, "198.51.100.1"),
+				genService("lb-private", corev1.ServiceTypeLoadBalancer, "10.0.0.1"),
+				genService("lb-pending", corev1.ServiceTypeLoadBalancer, ""),
+				genService("cluster-ip", corev1.ServiceTypeClusterIP, ""),
+			},
+			expectedResourceUsage: &kubermaticv1.ResourceDetails{
+				CPU:           getQuantity("1"),
+				Memory:        getQuantity("1G"),
+				Storage:       getQuantity("1G"),
+				Machines:      ptr.To[int64](1),
+				LoadBalancers: ptr.To[int64](3),
+				PublicIPs:     ptr.To[int64](2),
 			},
 		},
 	}
@@ -115,6 +160,9 @@ func TestReconcile(t *testing.T) {
 			for _, m := range tc.machines {
 				userClientBuilder.WithObjects(m)
 			}
+			for _, s := range tc.services {
+				userClientBuilder.WithObjects(s)
+			}
 
 			seedClient := seedClientBuilder.Build()
 			userClient := userClientBuilder.Build()
@@ -156,6 +204,24 @@ func genFakeMachine(name, cpu, memory, storage string) *clusterv1alpha1.Machine
 		nil, nil)
 }
 
+func genService(name string, serviceType corev1.ServiceType, ingressIP string) *corev1.Service {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: serviceType,
+		},
+	}
+
+	if ingressIP != "" {
+		service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: ingressIP}}
+	}
+
+	return service
+}
+
 func getQuantity(q string) *resource.Quantity {
 	res := resource.MustParse(q)
 	return &res
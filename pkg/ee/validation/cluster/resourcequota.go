@@ -0,0 +1,67 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/ee/resource-quota/subject"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ValidateQuotas validates if a new cluster fits in the cluster count limits of all quotas that apply to it,
+// i.e. the quotas of the clusters project, of the groups bound to the project and of the clusters datacenter.
+func ValidateQuotas(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	if projectID == "" {
+		return nil
+	}
+
+	quotas, err := subject.ResourceQuotasForCluster(ctx, client, projectID, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return err
+	}
+
+	for _, quota := range quotas {
+		if quota.Spec.Quota.Clusters == nil {
+			continue
+		}
+
+		var currentClusters int64
+		if quota.Status.GlobalUsage.Clusters != nil {
+			currentClusters = *quota.Status.GlobalUsage.Clusters
+		}
+
+		if *quota.Spec.Quota.Clusters < currentClusters+1 {
+			return fmt.Errorf("%s %q: requested cluster would exceed current quota (quota/used %d/%d)",
+				quota.Spec.Subject.Kind, quota.Spec.Subject.Name, *quota.Spec.Quota.Clusters, currentClusters)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/ee/validation/cluster"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestValidateQuotas(t *testing.T) {
+	testCases := []struct {
+		name           string
+		existingQuotas []ctrlruntimeclient.Object
+		errExpected    bool
+	}{
+		{
+			name:        "no quotas",
+			errExpected: false,
+		},
+		{
+			name: "project quota without cluster limit",
+			existingQuotas: []ctrlruntimeclient.Object{
+				genResourceQuota(kubermaticv1.ProjectSubjectKind, "project", nil, 10),
+			},
+			errExpected: false,
+		},
+		{
+			name: "project quota with remaining clusters",
+			existingQuotas: []ctrlruntimeclient.Object{
+				genResourceQuota(kubermaticv1.ProjectSubjectKind, "project", ptr.To[int64](3), 2),
+			},
+			errExpected: false,
+		},
+		{
+			name: "project quota exceeded",
+			existingQuotas: []ctrlruntimeclient.Object{
+				genResourceQuota(kubermaticv1.ProjectSubjectKind, "project", ptr.To[int64](2), 2),
+			},
+			errExpected: true,
+		},
+		{
+			name: "group quota exceeded",
+			existingQuotas: []ctrlruntimeclient.Object{
+				genResourceQuota(kubermaticv1.ProjectSubjectKind, "project", ptr.To[int64](5), 2),
+				genResourceQuota(kubermaticv1.GroupSubjectKind, "developers", ptr.To[int64](4), 4),
+			},
+			errExpected: true,
+		},
+		{
+			name: "datacenter quota exceeded",
+			existingQuotas: []ctrlruntimeclient.Object{
+				genResourceQuota(kubermaticv1.DatacenterSubjectKind, "dc", ptr.To[int64](1), 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "quotas of other subjects are ignored",
+			existingQuotas: []ctrlruntimeclient.Object{
+				genResourceQuota(kubermaticv1.ProjectSubjectKind, "other-project", ptr.To[int64](1), 1),
+				genResourceQuota(kubermaticv1.GroupSubjectKind, "admins", ptr.To[int64](1), 1),
+				genResourceQuota(kubermaticv1.DatacenterSubjectKind, "other-dc", ptr.To[int64](1), 1),
+			},
+			errExpected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.
+				NewClientBuilder().
+				WithObjects(tc.existingQuotas...).
+				WithObjects(&kubermaticv1.GroupProjectBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "developers-project"},
+					Spec:       kubermaticv1.GroupProjectBindingSpec{Group: "developers", ProjectID: "project"},
+				}).
+				Build()
+
+			newCluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "cluster",
+					Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "project"},
+				},
+			}
+			newCluster.Spec.Cloud.DatacenterName = "dc"
+
+			err := cluster.ValidateQuotas(context.Background(), client, newCluster)
+			if (err != nil) != tc.errExpected {
+				t.Fatalf("Expected error: %v, got: %v", tc.errExpected, err)
+			}
+		})
+	}
+}
+
+func genResourceQuota(kind, name string, clusters *int64, usedClusters int64) *kubermaticv1.ResourceQuota {
+	rq := &kubermaticv1.ResourceQuota{}
+	rq.Name = kind + "-" + name
+	rq.Spec.Subject = kubermaticv1.Subject{Kind: kind, Name: name}
+	rq.Spec.Quota.Clusters = clusters
+	rq.Status.GlobalUsage.Clusters = ptr.To(usedClusters)
+
+	return rq
+}
@@ -34,10 +34,11 @@ import (
 )
 
 func getFakeQuotaRequest(config *providerconfig.Config) (*ResourceDetails, error) {
-	spec := &FakeProviderSpec{}
-	if err := json.Unmarshal(config.CloudProviderSpec.Raw, spec); err != nil {
-		return nil, fmt.Errorf("error unmarshalling fake raw config: %w", err)
+	spec, err := getFakeProviderSpec(config)
+	if err != nil {
+		return nil, err
 	}
+
 	cpu, err := resource.ParseQuantity(spec.CPU)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing quantity: %w", err)
@@ -56,8 +57,18 @@ func getFakeQuotaRequest(config *providerconfig.Config) (*ResourceDetails, error
 	return NewResourceDetails(cpu, mem, storage), nil
 }
 
+func getFakeProviderSpec(config *providerconfig.Config) (*FakeProviderSpec, error) {
+	spec := &FakeProviderSpec{}
+	if err := json.Unmarshal(config.CloudProviderSpec.Raw, spec); err != nil {
+		return nil, fmt.Errorf("error unmarshalling fake raw config: %w", err)
+	}
+
+	return spec, nil
+}
+
 type FakeProviderSpec struct {
-	CPU     string `json:"cpu"`
-	Memory  string `json:"memory"`
-	Storage string `json:"storage"`
+	CPU      string `json:"cpu"`
+	Memory   string `json:"memory"`
+	Storage  string `json:"storage"`
+	PublicIP bool   `json:"publicIP,omitempty"`
 }
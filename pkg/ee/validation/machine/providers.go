@@ -125,8 +125,19 @@ func GetMachineResourceUsage(ctx context.Context, userClient ctrlruntimeclient.C
 	default:
 		return nil, fmt.Errorf("Provider %s not supported", config.CloudProvider)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	publicIP, err := requestsPublicIP(ctx, userClient, config)
+	if err != nil {
+		return nil, fmt.Errorf("error checking if machine requests a public IP: %w", err)
+	}
+	if publicIP {
+		quotaUsage.publicIPs = 1
+	}
 
-	return quotaUsage, err
+	return quotaUsage, nil
 }
 
 func getAWSResourceRequirements(ctx context.Context, userClient ctrlruntimeclient.Client, config *providerconfig.Config) (*ResourceDetails, error) {
@@ -0,0 +1,120 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2022 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package machine
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "k8c.io/machine-controller/sdk/cloudprovider/aws"
+	azuretypes "k8c.io/machine-controller/sdk/cloudprovider/azure"
+	gcptypes "k8c.io/machine-controller/sdk/cloudprovider/gce"
+	hetznertypes "k8c.io/machine-controller/sdk/cloudprovider/hetzner"
+	openstacktypes "k8c.io/machine-controller/sdk/cloudprovider/openstack"
+	"k8c.io/machine-controller/sdk/providerconfig"
+	"k8c.io/machine-controller/sdk/providerconfig/configvar"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// requestsPublicIP returns true if a public IP address will be assigned to the Machine, according to its provider
+// spec and the defaults of the machine-controller. Providers without a setting for public IP addresses are not
+// counted, their public IPs are only accounted for once they are reported in the Machine status.
+func requestsPublicIP(ctx context.Context, userClient ctrlruntimeclient.Client, config *providerconfig.Config) (bool, error) {
+	configVarResolver := configvar.NewResolver(ctx, userClient)
+
+	switch config.CloudProvider {
+	case providerconfig.CloudProviderFake, providerconfig.CloudProviderExternal:
+		spec, err := getFakeProviderSpec(config)
+		if err != nil {
+			return false, err
+		}
+		return spec.PublicIP, nil
+
+	case providerconfig.CloudProviderAWS:
+		rawConfig, err := awstypes.GetConfig(*config)
+		if err != nil {
+			return false, fmt.Errorf("error getting aws raw config: %w", err)
+		}
+		// public IPs are assigned by default
+		return rawConfig.AssignPublicIP == nil || *rawConfig.AssignPublicIP, nil
+
+	case providerconfig.CloudProviderGoogle:
+		rawConfig, err := gcptypes.GetConfig(*config)
+		if err != nil {
+			return false, fmt.Errorf("error getting gcp raw config: %w", err)
+		}
+		// public IPs are assigned by default
+		if rawConfig.AssignPublicIPAddress == nil {
+			return true, nil
+		}
+		return boolValueOrDefault(configVarResolver, *rawConfig.AssignPublicIPAddress, true)
+
+	case providerconfig.CloudProviderAzure:
+		rawConfig, err := azuretypes.GetConfig(*config)
+		if err != nil {
+			return false, fmt.Errorf("error getting azure raw config: %w", err)
+		}
+		return boolValueOrDefault(configVarResolver, rawConfig.AssignPublicIP, false)
+
+	case providerconfig.CloudProviderHetzner:
+		rawConfig, err := hetznertypes.GetConfig(*config)
+		if err != nil {
+			return false, fmt.Errorf("error getting hetzner raw config: %w", err)
+		}
+		// public IPv4 addresses are assigned by default
+		return boolValueOrDefault(configVarResolver, rawConfig.AssignPublicIPv4, true)
+
+	case providerconfig.CloudProviderOpenstack:
+		rawConfig, err := openstacktypes.GetConfig(*config)
+		if err != nil {
+			return false, fmt.Errorf("error getting openstack raw config: %w", err)
+		}
+		floatingIPPool, err := configVarResolver.GetStringValue(rawConfig.FloatingIPPool)
+		if err != nil {
+			return false, fmt.Errorf("error getting openstack floating IP pool from machine config: %w", err)
+		}
+		return floatingIPPool != "", nil
+
+	case providerconfig.CloudProviderDigitalocean:
+		// droplets always have a public IPv4 address
+		return true, nil
+
+	default:
+		return false, nil
+	}
+}
+
+func boolValueOrDefault(configVarResolver *configvar.Resolver, configVar providerconfig.ConfigVarBool, defaultValue bool) (bool, error) {
+	value, valid, err := configVarResolver.GetBoolValue(configVar)
+	if err != nil {
+		return false, err
+	}
+	if !valid {
+		return defaultValue, nil
+	}
+
+	return value, nil
+}
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -37,22 +38,89 @@ import (
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// ValidateQuota validates if the requested Machine resource consumption fits in the quota of the clusters project.
-func ValidateQuota(ctx context.Context,
+// ValidateQuotas validates if the requested Machine resource consumption fits in all quotas that apply to the cluster,
+// i.e. the quotas of the clusters project, of the groups bound to the project and of the clusters datacenter.
+// It returns warnings for all quotas whose soft thresholds would be reached by the Machine.
+func ValidateQuotas(ctx context.Context,
 	log *zap.SugaredLogger,
 	userClient ctrlruntimeclient.Client,
 	machine *clusterv1alpha1.Machine,
 	caBundle *certificates.CABundle,
-	resourceQuota *kubermaticv1.ResourceQuota,
-) error {
+	resourceQuotas []kubermaticv1.ResourceQuota,
+) ([]string, error) {
+	if len(resourceQuotas) == 0 {
+		return nil, nil
+	}
+
+	machineResourceUsage, err := GetMachineResourceUsage(ctx, userClient, machine, caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("error getting machine resource request: %w", err)
+	}
+
+	for _, resourceQuota := range resourceQuotas {
+		subject := resourceQuota.Spec.Subject
+		if err := validateQuota(log.With("subject-kind", subject.Kind, "subject-name", subject.Name), machineResourceUsage, &resourceQuota); err != nil {
+			return nil, fmt.Errorf("%s %q: %w", subject.Kind, subject.Name, err)
+		}
+	}
+
+	return softThresholdWarnings(machineResourceUsage, 1, resourceQuotas), nil
+}
+
+// QuotaWarnings returns warnings for all quotas whose soft thresholds would be reached by adding the given number of
+// replicas of the Machine. This is used for MachineDeployments, which are not rejected if they exceed the quota, as
+// the quota is enforced for their Machines.
+func QuotaWarnings(ctx context.Context,
+	userClient ctrlruntimeclient.Client,
+	machine *clusterv1alpha1.Machine,
+	caBundle *certificates.CABundle,
+	replicas int64,
+	resourceQuotas []kubermaticv1.ResourceQuota,
+) ([]string, error) {
+	if len(resourceQuotas) == 0 || replicas <= 0 {
+		return nil, nil
+	}
+
 	machineResourceUsage, err := GetMachineResourceUsage(ctx, userClient, machine, caBundle)
 	if err != nil {
-		return fmt.Errorf("error getting machine resource request: %w", err)
+		return nil, fmt.Errorf("error getting machine resource request: %w", err)
+	}
+
+	return softThresholdWarnings(machineResourceUsage, replicas, resourceQuotas), nil
+}
+
+func softThresholdWarnings(machineResourceUsage *ResourceDetails, replicas int64, resourceQuotas []kubermaticv1.ResourceQuota) []string {
+	requested := kubermaticv1.ResourceDetails{
+		CPU:       multiplyQuantity(machineResourceUsage.CPU(), replicas),
+		Memory:    multiplyQuantity(machineResourceUsage.Memory(), replicas),
+		Storage:   multiplyQuantity(machineResourceUsage.Storage(), replicas),
+		Machines:  &replicas,
+		PublicIPs: ptr.To(machineResourceUsage.PublicIPs() * replicas),
 	}
 
+	var warnings []string
+	for _, resourceQuota := range resourceQuotas {
+		usage := resourceQuota.Status.GlobalUsage.DeepCopy()
+		usage.Add(requested)
+
+		if exceeded := resourceQuota.Spec.ExceededSoftThresholds(*usage); len(exceeded) > 0 {
+			warnings = append(warnings, fmt.Sprintf("%s %q: usage of %s would reach the soft threshold of the quota",
+				resourceQuota.Spec.Subject.Kind, resourceQuota.Spec.Subject.Name, strings.Join(exceeded, ", ")))
+		}
+	}
+
+	return warnings
+}
+
+func multiplyQuantity(q *resource.Quantity, factor int64) *resource.Quantity {
+	return resource.NewMilliQuantity(q.MilliValue()*factor, q.Format)
+}
+
+func validateQuota(log *zap.SugaredLogger, machineResourceUsage *ResourceDetails, resourceQuota *kubermaticv1.ResourceQuota) error {
 	var currentCPU = resource.Quantity{}
 	if resourceQuota.Status.GlobalUsage.CPU != nil {
 		currentCPU = *resourceQuota.Status.GlobalUsage.CPU
@@ -68,6 +136,16 @@ func ValidateQuota(ctx context.Context,
 		currentStorage = *resourceQuota.Status.GlobalUsage.Storage
 	}
 
+	var currentMachines int64
+	if resourceQuota.Status.GlobalUsage.Machines != nil {
+		currentMachines = *resourceQuota.Status.GlobalUsage.Machines
+	}
+
+	var currentPublicIPs int64
+	if resourceQuota.Status.GlobalUsage.PublicIPs != nil {
+		currentPublicIPs = *resourceQuota.Status.GlobalUsage.PublicIPs
+	}
+
 	// add requested resources to current usage and compare
 	combinedUsage := NewResourceDetails(currentCPU, currentMem, currentStorage)
 	combinedUsage.CPU().Add(*machineResourceUsage.CPU())
@@ -96,13 +174,24 @@ func ValidateQuota(ctx context.Context,
 			machineResourceUsage.Storage(), quota.Storage, currentStorage.String())
 	}
 
+	if quota.Machines != nil && *quota.Machines < currentMachines+1 {
+		log.Debugw("requested machine would exceed current quota", "quota", *quota.Machines, "used", currentMachines)
+		return fmt.Errorf("requested machine would exceed current quota (quota/used %d/%d)", *quota.Machines, currentMachines)
+	}
+
+	if requested := machineResourceUsage.PublicIPs(); requested > 0 && quota.PublicIPs != nil && *quota.PublicIPs < currentPublicIPs+requested {
+		log.Debugw("requested public IP would exceed current quota", "quota", *quota.PublicIPs, "used", currentPublicIPs)
+		return fmt.Errorf("requested public IP would exceed current quota (quota/used %d/%d)", *quota.PublicIPs, currentPublicIPs)
+	}
+
 	return nil
 }
 
 type ResourceDetails struct {
-	cpu     resource.Quantity
-	mem     resource.Quantity
-	storage resource.Quantity
+	cpu       resource.Quantity
+	mem       resource.Quantity
+	storage   resource.Quantity
+	publicIPs int64
 }
 
 func NewResourceDetails(cpu resource.Quantity, mem resource.Quantity, storage resource.Quantity) *ResourceDetails {
@@ -144,3 +233,8 @@ func (r *ResourceDetails) Memory() *resource.Quantity {
 func (r *ResourceDetails) Storage() *resource.Quantity {
 	return &r.storage
 }
+
+// PublicIPs returns the number of public IP addresses that will be assigned to the Machine.
+func (r *ResourceDetails) PublicIPs() int64 {
+	return r.publicIPs
+}
@@ -36,6 +36,7 @@ import (
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 )
 
 func TestResourceQuotaValidation(t *testing.T) {
@@ -44,6 +45,7 @@ func TestResourceQuotaValidation(t *testing.T) {
 	testCases := []struct {
 		name        string
 		machine     *clusterv1alpha1.Machine
+		quotas      []kubermaticv1.ResourceQuota
 		expectedErr bool
 	}{
 		{
@@ -51,6 +53,12 @@ func TestResourceQuotaValidation(t *testing.T) {
 			machine:     genFakeMachine("2", "2G", "10G"),
 			expectedErr: false,
 		},
+		{
+			name:        "no quotas should succeed",
+			machine:     genFakeMachine("2", "2G", "10G"),
+			quotas:      []kubermaticv1.ResourceQuota{},
+			expectedErr: false,
+		},
 		{
 			name:        "should fail with CPU quota exceeded",
 			machine:     genFakeMachine("50", "2G", "10G"),
@@ -66,11 +74,60 @@ func TestResourceQuotaValidation(t *testing.T) {
 			machine:     genFakeMachine("2", "2G", "5000G"),
 			expectedErr: true,
 		},
+		{
+			name:    "should fail with machine count quota exceeded",
+			machine: genFakeMachine("2", "2G", "10G"),
+			quotas: func() []kubermaticv1.ResourceQuota {
+				rq := genResourceQuota()
+				rq.Spec.Quota.Machines = ptr.To[int64](3)
+				rq.Status.GlobalUsage.Machines = ptr.To[int64](3)
+				return []kubermaticv1.ResourceQuota{*rq}
+			}(),
+			expectedErr: true,
+		},
+		{
+			name:    "should fail with public IP quota exceeded",
+			machine: genFakeMachineWithPublicIP("2", "2G", "10G"),
+			quotas: func() []kubermaticv1.ResourceQuota {
+				rq := genResourceQuota()
+				rq.Spec.Quota.PublicIPs = ptr.To[int64](2)
+				rq.Status.GlobalUsage.PublicIPs = ptr.To[int64](2)
+				return []kubermaticv1.ResourceQuota{*rq}
+			}(),
+			expectedErr: true,
+		},
+		{
+			name:    "machine without public IP should succeed with public IP quota exceeded",
+			machine: genFakeMachine("2", "2G", "10G"),
+			quotas: func() []kubermaticv1.ResourceQuota {
+				rq := genResourceQuota()
+				rq.Spec.Quota.PublicIPs = ptr.To[int64](2)
+				rq.Status.GlobalUsage.PublicIPs = ptr.To[int64](2)
+				return []kubermaticv1.ResourceQuota{*rq}
+			}(),
+			expectedErr: false,
+		},
+		{
+			name:    "should fail if any of the quotas is exceeded",
+			machine: genFakeMachine("2", "2G", "10G"),
+			quotas: func() []kubermaticv1.ResourceQuota {
+				datacenterQuota := genResourceQuota()
+				datacenterQuota.Spec.Subject = kubermaticv1.Subject{Kind: kubermaticv1.DatacenterSubjectKind, Name: "dc"}
+				datacenterQuota.Spec.Quota.CPU = ptr.To(resource.MustParse("4"))
+				return []kubermaticv1.ResourceQuota{*genResourceQuota(), *datacenterQuota}
+			}(),
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := machine.ValidateQuota(context.Background(), l, nil, tc.machine, nil, genResourceQuota())
+			quotas := tc.quotas
+			if quotas == nil {
+				quotas = []kubermaticv1.ResourceQuota{*genResourceQuota()}
+			}
+
+			_, err := machine.ValidateQuotas(context.Background(), l, nil, tc.machine, nil, quotas)
 			if err != nil {
 				if !tc.expectedErr {
 					t.Fatalf("unexpected error: %v", err)
@@ -84,12 +141,54 @@ func TestResourceQuotaValidation(t *testing.T) {
 	}
 }
 
+func TestResourceQuotaWarnings(t *testing.T) {
+	l := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
+
+	rq := genResourceQuota()
+	rq.Spec.Subject = kubermaticv1.Subject{Kind: kubermaticv1.ProjectSubjectKind, Name: "project"}
+	rq.Spec.SoftThresholds = &kubermaticv1.ResourceThresholds{CPU: ptr.To[int32](20)}
+	quotas := []kubermaticv1.ResourceQuota{*rq}
+
+	// 3 used + 2 requested CPUs are below 20% of 50 CPUs
+	warnings, err := machine.ValidateQuotas(context.Background(), l, nil, genFakeMachine("2", "2G", "10G"), nil, quotas)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("expected no warnings, got %v", warnings)
+	}
+
+	// 3 used + 7 requested CPUs reach 20% of 50 CPUs
+	warnings, err = machine.ValidateQuotas(context.Background(), l, nil, genFakeMachine("7", "2G", "10G"), nil, quotas)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `project "project": usage of cpu would reach the soft threshold of the quota`; len(warnings) != 1 || warnings[0] != expected {
+		t.Fatalf("expected warning %q, got %v", expected, warnings)
+	}
+
+	// 3 used + 4 replicas with 2 requested CPUs each reach 20% of 50 CPUs
+	warnings, err = machine.QuotaWarnings(context.Background(), nil, genFakeMachine("2", "2G", "10G"), nil, 4, quotas)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected one warning, got %v", warnings)
+	}
+}
+
 func genFakeMachine(cpu, memory, storage string) *clusterv1alpha1.Machine {
 	return generator.GenTestMachine("fake",
 		fmt.Sprintf(`{"cloudProvider":"fake", "cloudProviderSpec":{"cpu":"%s","memory":"%s","storage":"%s"}}`, cpu, memory, storage),
 		nil, nil)
 }
 
+func genFakeMachineWithPublicIP(cpu, memory, storage string) *clusterv1alpha1.Machine {
+	return generator.GenTestMachine("fake",
+		fmt.Sprintf(`{"cloudProvider":"fake", "cloudProviderSpec":{"cpu":"%s","memory":"%s","storage":"%s","publicIP":true}}`, cpu, memory, storage),
+		nil, nil)
+}
+
 func genResourceQuota() *kubermaticv1.ResourceQuota {
 	rq := &kubermaticv1.ResourceQuota{}
 	rq.Spec.Quota = *kubermaticv1.NewResourceDetails(resource.MustParse("50"), resource.MustParse("50G"), resource.MustParse("1000G"))
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	resourcequotadefaultcontroller "k8c.io/kubermatic/v2/pkg/ee/resource-quota/default-quota-controller"
@@ -35,6 +36,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -49,6 +51,11 @@ func ValidateCreate(ctx context.Context,
 		return nil
 	}
 
+	// the subject is copied into the labels of the quota to select quotas by subject
+	if errs := validation.IsValidLabelValue(incomingQuota.Spec.Subject.Name); len(errs) > 0 {
+		return fmt.Errorf("ResourceQuota: Subject's Name %q must be a valid label value: %s", incomingQuota.Spec.Subject.Name, strings.Join(errs, ", "))
+	}
+
 	currentQuotaList := &kubermaticv1.ResourceQuotaList{}
 	if err := client.List(ctx, currentQuotaList, &ctrlruntimeclient.ListOptions{}); err != nil {
 		return fmt.Errorf("failed to list resource quotas: %w", err)
@@ -114,6 +114,37 @@ func TestValidateCreate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Create group ResourceQuota Success",
+			resourceQuotaToValidate: &kubermaticv1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-quota",
+				},
+				Spec: kubermaticv1.ResourceQuotaSpec{
+					Subject: kubermaticv1.Subject{
+						Name: "developers",
+						Kind: "group",
+					},
+					Quota: kubermaticv1.ResourceDetails{},
+				},
+			},
+		},
+		{
+			name: "Create ResourceQuota with invalid Subject Name Failure",
+			resourceQuotaToValidate: &kubermaticv1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-quota",
+				},
+				Spec: kubermaticv1.ResourceQuotaSpec{
+					Subject: kubermaticv1.Subject{
+						Name: "cn=developers,dc=example",
+						Kind: "group",
+					},
+					Quota: kubermaticv1.ResourceDetails{},
+				},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,64 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package service
+
+import (
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ValidateQuotas validates if a Service fits in the load balancer count limits of all quotas that apply to the
+// cluster, i.e. the quotas of the clusters project, of the groups bound to the project and of the clusters datacenter.
+// Only Services that become of type LoadBalancer are validated, oldService is nil on creation.
+func ValidateQuotas(service, oldService *corev1.Service, quotas []kubermaticv1.ResourceQuota) error {
+	if !isLoadBalancer(service) || isLoadBalancer(oldService) {
+		return nil
+	}
+
+	for _, quota := range quotas {
+		if quota.Spec.Quota.LoadBalancers == nil {
+			continue
+		}
+
+		var currentLoadBalancers int64
+		if quota.Status.GlobalUsage.LoadBalancers != nil {
+			currentLoadBalancers = *quota.Status.GlobalUsage.LoadBalancers
+		}
+
+		if *quota.Spec.Quota.LoadBalancers < currentLoadBalancers+1 {
+			return fmt.Errorf("%s %q: requested load balancer would exceed current quota (quota/used %d/%d)",
+				quota.Spec.Subject.Kind, quota.Spec.Subject.Name, *quota.Spec.Quota.LoadBalancers, currentLoadBalancers)
+		}
+	}
+
+	return nil
+}
+
+func isLoadBalancer(service *corev1.Service) bool {
+	return service != nil && service.Spec.Type == corev1.ServiceTypeLoadBalancer
+}
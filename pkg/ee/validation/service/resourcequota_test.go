@@ -0,0 +1,117 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package service_test
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/ee/validation/service"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateQuotas(t *testing.T) {
+	testCases := []struct {
+		name        string
+		service     *corev1.Service
+		oldService  *corev1.Service
+		quotas      []kubermaticv1.ResourceQuota
+		errExpected bool
+	}{
+		{
+			name:        "no quotas",
+			service:     genService(corev1.ServiceTypeLoadBalancer),
+			errExpected: false,
+		},
+		{
+			name:        "quota without load balancer limit",
+			service:     genService(corev1.ServiceTypeLoadBalancer),
+			quotas:      []kubermaticv1.ResourceQuota{genResourceQuota(nil, 10)},
+			errExpected: false,
+		},
+		{
+			name:        "quota with remaining load balancers",
+			service:     genService(corev1.ServiceTypeLoadBalancer),
+			quotas:      []kubermaticv1.ResourceQuota{genResourceQuota(ptr.To[int64](3), 2)},
+			errExpected: false,
+		},
+		{
+			name:        "quota exceeded",
+			service:     genService(corev1.ServiceTypeLoadBalancer),
+			quotas:      []kubermaticv1.ResourceQuota{genResourceQuota(ptr.To[int64](2), 2)},
+			errExpected: true,
+		},
+		{
+			name:        "any of the quotas exceeded",
+			service:     genService(corev1.ServiceTypeLoadBalancer),
+			quotas:      []kubermaticv1.ResourceQuota{genResourceQuota(ptr.To[int64](3), 2), genResourceQuota(ptr.To[int64](2), 2)},
+			errExpected: true,
+		},
+		{
+			name:        "quota exceeded by changing the type to load balancer",
+			service:     genService(corev1.ServiceTypeLoadBalancer),
+			oldService:  genService(corev1.ServiceTypeNodePort),
+			quotas:      []kubermaticv1.ResourceQuota{genResourceQuota(ptr.To[int64](2), 2)},
+			errExpected: true,
+		},
+		{
+			name:        "quota exceeded but load balancer already exists",
+			service:     genService(corev1.ServiceTypeLoadBalancer),
+			oldService:  genService(corev1.ServiceTypeLoadBalancer),
+			quotas:      []kubermaticv1.ResourceQuota{genResourceQuota(ptr.To[int64](2), 2)},
+			errExpected: false,
+		},
+		{
+			name:        "quota exceeded but service is no load balancer",
+			service:     genService(corev1.ServiceTypeClusterIP),
+			quotas:      []kubermaticv1.ResourceQuota{genResourceQuota(ptr.To[int64](2), 2)},
+			errExpected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := service.ValidateQuotas(tc.service, tc.oldService, tc.quotas)
+			if (err != nil) != tc.errExpected {
+				t.Fatalf("Expected error: %v, got: %v", tc.errExpected, err)
+			}
+		})
+	}
+}
+
+func genService(serviceType corev1.ServiceType) *corev1.Service {
+	return &corev1.Service{Spec: corev1.ServiceSpec{Type: serviceType}}
+}
+
+func genResourceQuota(loadBalancers *int64, used int64) kubermaticv1.ResourceQuota {
+	quota := kubermaticv1.ResourceQuota{}
+	quota.Spec.Subject = kubermaticv1.Subject{Kind: kubermaticv1.ProjectSubjectKind, Name: "project"}
+	quota.Spec.Quota.LoadBalancers = loadBalancers
+	quota.Status.GlobalUsage.LoadBalancers = ptr.To(used)
+
+	return quota
+}
@@ -124,7 +124,7 @@ func getHelmChartRenderFunc(config *kubermaticv1.KubermaticConfiguration,
 			if appDef.Spec.Method != appskubermaticv1.HelmTemplateMethod {
 				// Only Helm ApplicationDefinitions are supported at the moment
 				appLog.Debugf("Skipping the ApplicationDefinition as the method '%s' is not supported yet", appDef.Spec.Method)
-				break
+				continue
 			}
 			appLog.Info("Retrieving images…")
 
@@ -66,6 +66,16 @@ func (b *hetznerConfig) WithNetwork(network string) *hetznerConfig {
 	return b
 }
 
+func (b *hetznerConfig) WithFirewall(firewall string) *hetznerConfig {
+	if b.Firewalls == nil {
+		b.Firewalls = []providerconfig.ConfigVarString{}
+	}
+
+	b.Firewalls = append(b.Firewalls, providerconfig.ConfigVarString{Value: firewall})
+
+	return b
+}
+
 func CompleteHetznerProviderSpec(config *hetzner.RawConfig, cluster *kubermaticv1.Cluster, datacenter *kubermaticv1.DatacenterSpecHetzner) (*hetzner.RawConfig, error) {
 	if cluster != nil && cluster.Spec.Cloud.Hetzner == nil {
 		return nil, fmt.Errorf("cannot use cluster to create Hetzner cloud spec as cluster uses %q", cluster.Spec.Cloud.ProviderName)
@@ -82,6 +92,12 @@ func CompleteHetznerProviderSpec(config *hetzner.RawConfig, cluster *kubermaticv
 				Value: cluster.Spec.Cloud.Hetzner.Network,
 			}}
 		}
+
+		if len(config.Firewalls) == 0 && cluster.Spec.Cloud.Hetzner.Firewall != "" {
+			config.Firewalls = []providerconfig.ConfigVarString{{
+				Value: cluster.Spec.Cloud.Hetzner.Firewall,
+			}}
+		}
 	}
 
 	if datacenter != nil {
@@ -94,4 +94,32 @@ func TestCompleteHetznerProviderSpec(t *testing.T) {
 	}
 
 	runProviderTestcases(t, goodCluster, testcases)
+
+	clusterWithNetworking := genCluster(kubermaticv1.CloudSpec{
+		ProviderName: string(kubermaticv1.HetznerCloudProvider),
+		Hetzner: &kubermaticv1.HetznerCloudSpec{
+			Network:  "test-network",
+			Firewall: "test-firewall",
+		},
+	})
+
+	networkingTestcases := []testcase[hetzner.RawConfig]{
+		&hetznerTestcase{
+			baseTestcase: baseTestcase[hetzner.RawConfig, kubermaticv1.DatacenterSpecHetzner]{
+				name:       "should apply the network and firewall from the cluster",
+				datacenter: &kubermaticv1.DatacenterSpecHetzner{},
+				expected:   cloneBuilder(defaultMachine).WithNetwork("test-network").WithFirewall("test-firewall"),
+			},
+		},
+		&hetznerTestcase{
+			baseTestcase: baseTestcase[hetzner.RawConfig, kubermaticv1.DatacenterSpecHetzner]{
+				name:       "should not overwrite firewalls in an existing spec",
+				datacenter: &kubermaticv1.DatacenterSpecHetzner{},
+				inputSpec:  cloneBuilder(defaultMachine).WithFirewall("keep-me"),
+				expected:   cloneBuilder(defaultMachine).WithNetwork("test-network").WithFirewall("keep-me"),
+			},
+		},
+	}
+
+	runProviderTestcases(t, clusterWithNetworking, networkingTestcases)
 }
@@ -57,14 +57,14 @@ func backfillOwnershipTags(ctx context.Context, cs *ClientSet, cluster *kubermat
 
 	// the tag finalizer is of no use at all
 	cluster, err := updater(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-		kuberneteshelper.RemoveFinalizer(cluster, tagCleanupFinalizer)
+		kuberneteshelper.RemoveFinalizer(cluster, TagCleanupFinalizer)
 	})
 	if err != nil {
-		return cluster, fmt.Errorf("failed to remove finalizer %q: %w", controlPlaneRoleCleanupFinalizer, err)
+		return cluster, fmt.Errorf("failed to remove finalizer %q: %w", ControlPlaneRoleCleanupFinalizer, err)
 	}
 
 	// security group
-	if kuberneteshelper.HasFinalizer(cluster, securityGroupCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, SecurityGroupCleanupFinalizer) {
 		vpc, err := getVPCByID(ctx, cs.EC2, cluster.Spec.Cloud.AWS.VPCID)
 		if err != nil {
 			return cluster, fmt.Errorf("failed to get VPC: %w", err)
@@ -86,15 +86,15 @@ func backfillOwnershipTags(ctx context.Context, cs *ClientSet, cluster *kubermat
 		}
 
 		cluster, err = updater(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, securityGroupCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, SecurityGroupCleanupFinalizer)
 		})
 		if err != nil {
-			return cluster, fmt.Errorf("failed to remove finalizer %q: %w", securityGroupCleanupFinalizer, err)
+			return cluster, fmt.Errorf("failed to remove finalizer %q: %w", SecurityGroupCleanupFinalizer, err)
 		}
 	}
 
 	// instance profile
-	if kuberneteshelper.HasFinalizer(cluster, instanceProfileCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, InstanceProfileCleanupFinalizer) {
 		profile, err := getInstanceProfile(ctx, cs.IAM, cluster.Spec.Cloud.AWS.InstanceProfileName)
 		if err != nil {
 			return cluster, fmt.Errorf("failed to get instance profile: %w", err)
@@ -111,15 +111,15 @@ func backfillOwnershipTags(ctx context.Context, cs *ClientSet, cluster *kubermat
 		}
 
 		cluster, err = updater(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, instanceProfileCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, InstanceProfileCleanupFinalizer)
 		})
 		if err != nil {
-			return cluster, fmt.Errorf("failed to remove finalizer %q: %w", instanceProfileCleanupFinalizer, err)
+			return cluster, fmt.Errorf("failed to remove finalizer %q: %w", InstanceProfileCleanupFinalizer, err)
 		}
 	}
 
 	// control plane role
-	if kuberneteshelper.HasFinalizer(cluster, controlPlaneRoleCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, ControlPlaneRoleCleanupFinalizer) {
 		role, err := getRole(ctx, cs.IAM, cluster.Spec.Cloud.AWS.ControlPlaneRoleARN)
 		if err != nil {
 			return cluster, fmt.Errorf("failed to get control plane role: %w", err)
@@ -136,10 +136,10 @@ func backfillOwnershipTags(ctx context.Context, cs *ClientSet, cluster *kubermat
 		}
 
 		cluster, err = updater(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, controlPlaneRoleCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, ControlPlaneRoleCleanupFinalizer)
 		})
 		if err != nil {
-			return cluster, fmt.Errorf("failed to remove finalizer %q: %w", controlPlaneRoleCleanupFinalizer, err)
+			return cluster, fmt.Errorf("failed to remove finalizer %q: %w", ControlPlaneRoleCleanupFinalizer, err)
 		}
 	}
 
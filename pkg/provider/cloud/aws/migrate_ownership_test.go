@@ -33,7 +33,7 @@ import (
 
 func TestBackfillOwnershipTags(t *testing.T) {
 	provider := newCloudProvider(t)
-	finalizer := tagCleanupFinalizer
+	finalizer := TagCleanupFinalizer
 
 	// create a vanilla cluster
 	cluster := makeCluster(&kubermaticv1.AWSCloudSpec{})
@@ -79,7 +79,7 @@ func TestBackfillOwnershipTagsAdoptsSecurityGroup(t *testing.T) {
 	}
 
 	securityGroupID := *sGroups[0].GroupId
-	finalizer := securityGroupCleanupFinalizer
+	finalizer := SecurityGroupCleanupFinalizer
 
 	// create a vanilla cluster that uses an existing SG;
 	// this will not put an owner tag on the SG
@@ -147,7 +147,7 @@ func TestBackfillOwnershipTagsAdoptsInstanceProfile(t *testing.T) {
 	provider := newCloudProvider(t)
 
 	profileName := "adopt-me-" + rand.String(10)
-	finalizer := instanceProfileCleanupFinalizer
+	finalizer := InstanceProfileCleanupFinalizer
 
 	// create an instance profile
 	createProfileInput := &iam.CreateInstanceProfileInput{
@@ -223,7 +223,7 @@ func TestBackfillOwnershipTagsAdoptsControlPlaneRole(t *testing.T) {
 	provider := newCloudProvider(t)
 
 	roleName := "adopt-me-" + rand.String(10)
-	finalizer := controlPlaneRoleCleanupFinalizer
+	finalizer := ControlPlaneRoleCleanupFinalizer
 
 	// create a role
 	createRoleInput := &iam.CreateRoleInput{
@@ -37,15 +37,15 @@ const (
 
 	regionAnnotationKey = "kubermatic.io/aws-region"
 
-	cleanupFinalizer = "kubermatic.k8c.io/cleanup-aws"
+	CleanupFinalizer = "kubermatic.k8c.io/cleanup-aws"
 
 	// The individual finalizers are deprecated and not used for newly reconciled
-	// clusters, where the single cleanupFinalizer is enough.
+	// clusters, where the single CleanupFinalizer is enough.
 
-	securityGroupCleanupFinalizer    = "kubermatic.k8c.io/cleanup-aws-security-group"
-	instanceProfileCleanupFinalizer  = "kubermatic.k8c.io/cleanup-aws-instance-profile"
-	controlPlaneRoleCleanupFinalizer = "kubermatic.k8c.io/cleanup-aws-control-plane-role"
-	tagCleanupFinalizer              = "kubermatic.k8c.io/cleanup-aws-tags"
+	SecurityGroupCleanupFinalizer    = "kubermatic.k8c.io/cleanup-aws-security-group"
+	InstanceProfileCleanupFinalizer  = "kubermatic.k8c.io/cleanup-aws-instance-profile"
+	ControlPlaneRoleCleanupFinalizer = "kubermatic.k8c.io/cleanup-aws-control-plane-role"
+	TagCleanupFinalizer              = "kubermatic.k8c.io/cleanup-aws-tags"
 
 	authFailure = "AuthFailure"
 )
@@ -229,7 +229,7 @@ func getRoleName(value string) string {
 
 func (a *AmazonEC2) InitializeCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
 	// Initialization should only occur once.
-	firstInitialization := !kuberneteshelper.HasFinalizer(cluster, cleanupFinalizer)
+	firstInitialization := !kuberneteshelper.HasFinalizer(cluster, CleanupFinalizer)
 
 	return a.reconcileCluster(ctx, cluster, update, false, firstInitialization)
 }
@@ -245,7 +245,7 @@ func (a *AmazonEC2) reconcileCluster(ctx context.Context, cluster *kubermaticv1.
 	}
 
 	cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-		kuberneteshelper.AddFinalizer(cluster, cleanupFinalizer)
+		kuberneteshelper.AddFinalizer(cluster, CleanupFinalizer)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to add finalizer: %w", err)
@@ -318,7 +318,7 @@ func (a *AmazonEC2) reconcileCluster(ctx context.Context, cluster *kubermaticv1.
 func (a *AmazonEC2) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, updater provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
 	// prevent excessive requests to AWS when a cluster is re-reconciled often
 	// during its deletion phase
-	if !kuberneteshelper.HasFinalizer(cluster, cleanupFinalizer) {
+	if !kuberneteshelper.HasFinalizer(cluster, CleanupFinalizer) {
 		return cluster, nil
 	}
 
@@ -351,6 +351,6 @@ func (a *AmazonEC2) CleanUpCloudProvider(ctx context.Context, cluster *kubermati
 	}
 
 	return updater(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-		kuberneteshelper.RemoveFinalizer(cluster, securityGroupCleanupFinalizer, controlPlaneRoleCleanupFinalizer, instanceProfileCleanupFinalizer, tagCleanupFinalizer, cleanupFinalizer)
+		kuberneteshelper.RemoveFinalizer(cluster, SecurityGroupCleanupFinalizer, ControlPlaneRoleCleanupFinalizer, InstanceProfileCleanupFinalizer, TagCleanupFinalizer, CleanupFinalizer)
 	})
 }
@@ -149,7 +149,7 @@ func TestInitializeCloudProvider(t *testing.T) {
 		t.Fatalf("InitializeCloudProvider should not have failed, but returned: %v", err)
 	}
 
-	if !kuberneteshelper.HasFinalizer(cluster, cleanupFinalizer) {
+	if !kuberneteshelper.HasFinalizer(cluster, CleanupFinalizer) {
 		t.Error("cluster should have cleanup finalizer, but does not")
 	}
 
@@ -195,7 +195,7 @@ func TestInitializeCloudProviderKeepsAnyData(t *testing.T) {
 	// As this is a somewhat synthetic usecase, we need to properly simulate that
 	// the cluster was already reconciled, which includes having this finalizer.
 	// Otherwise the code would try to tag the non-existing resources and fail.
-	kuberneteshelper.AddFinalizer(cluster, cleanupFinalizer)
+	kuberneteshelper.AddFinalizer(cluster, CleanupFinalizer)
 
 	cluster, err := provider.InitializeCloudProvider(context.Background(), cluster, testClusterUpdater(cluster))
 	if err != nil {
@@ -232,7 +232,7 @@ func TestReconcileCluster(t *testing.T) {
 		t.Fatalf("ReconcileCluster should not have failed, but returned: %v", err)
 	}
 
-	if !kuberneteshelper.HasFinalizer(cluster, cleanupFinalizer) {
+	if !kuberneteshelper.HasFinalizer(cluster, CleanupFinalizer) {
 		t.Error("cluster should have cleanup finalizer, but does not")
 	}
 
@@ -303,11 +303,11 @@ func TestCleanUpCloudProvider(t *testing.T) {
 
 	// ensure no finalizer remains
 	if kuberneteshelper.HasAnyFinalizer(cluster,
-		cleanupFinalizer,
-		securityGroupCleanupFinalizer,
-		instanceProfileCleanupFinalizer,
-		controlPlaneRoleCleanupFinalizer,
-		tagCleanupFinalizer,
+		CleanupFinalizer,
+		SecurityGroupCleanupFinalizer,
+		InstanceProfileCleanupFinalizer,
+		ControlPlaneRoleCleanupFinalizer,
+		TagCleanupFinalizer,
 	) {
 		t.Errorf("Cleaning up should have left no AWS finalizers on the cluster, but %v remained.", cluster.Finalizers)
 	}
@@ -33,11 +33,11 @@ import (
 )
 
 const (
-	selfRuleNamePattern         = "firewall-%s-self"
-	icmpRuleNamePattern         = "firewall-%s-icmp"
-	icmpIPv6RuleNamePattern     = "firewall-%s-icmp-ipv6"
-	nodePortRuleNamePattern     = "firewall-%s-nodeport"
-	nodePortIPv6RuleNamePattern = "firewall-%s-nodeport-ipv6"
+	SelfRuleNamePattern         = "firewall-%s-self"
+	ICMPRuleNamePattern         = "firewall-%s-icmp"
+	ICMPIPv6RuleNamePattern     = "firewall-%s-icmp-ipv6"
+	NodePortRuleNamePattern     = "firewall-%s-nodeport"
+	NodePortIPv6RuleNamePattern = "firewall-%s-nodeport-ipv6"
 
 	ipv6ICMPProtoNumber = "58" // IANA-assigned Internet Protocol Number for IPv6-ICMP
 )
@@ -52,11 +52,11 @@ func reconcileFirewallRules(ctx context.Context, cluster *kubermaticv1.Cluster,
 
 	firewallService := compute.NewFirewallsService(svc)
 	tag := fmt.Sprintf("kubernetes-cluster-%s", cluster.Name)
-	selfRuleName := fmt.Sprintf(selfRuleNamePattern, cluster.Name)
-	icmpRuleName := fmt.Sprintf(icmpRuleNamePattern, cluster.Name)
-	icmpIPv6RuleName := fmt.Sprintf(icmpIPv6RuleNamePattern, cluster.Name)
-	nodePortRuleName := fmt.Sprintf(nodePortRuleNamePattern, cluster.Name)
-	nodePortIPv6RuleName := fmt.Sprintf(nodePortIPv6RuleNamePattern, cluster.Name)
+	selfRuleName := fmt.Sprintf(SelfRuleNamePattern, cluster.Name)
+	icmpRuleName := fmt.Sprintf(ICMPRuleNamePattern, cluster.Name)
+	icmpIPv6RuleName := fmt.Sprintf(ICMPIPv6RuleNamePattern, cluster.Name)
+	nodePortRuleName := fmt.Sprintf(NodePortRuleNamePattern, cluster.Name)
+	nodePortIPv6RuleName := fmt.Sprintf(NodePortIPv6RuleNamePattern, cluster.Name)
 
 	ipv4Rules := cluster.IsIPv4Only() || cluster.IsDualStack()
 	ipv6Rules := cluster.IsIPv6Only() || cluster.IsDualStack()
@@ -88,7 +88,7 @@ func reconcileFirewallRules(ctx context.Context, cluster *kubermaticv1.Cluster,
 	if ipv6Rules {
 		allowedProtocols = append(allowedProtocols, &compute.FirewallAllowed{IPProtocol: ipv6ICMPProtoNumber})
 	}
-	err := createOrPatchFirewall(ctx, firewallService, projectID, selfRuleName, tag, tag, allowedProtocols, nil, update, cluster, firewallSelfCleanupFinalizer)
+	err := createOrPatchFirewall(ctx, firewallService, projectID, selfRuleName, tag, tag, allowedProtocols, nil, update, cluster, FirewallSelfCleanupFinalizer)
 	if err != nil {
 		return err
 	}
@@ -98,14 +98,14 @@ func reconcileFirewallRules(ctx context.Context, cluster *kubermaticv1.Cluster,
 	// so we need to create a separate rule for each IP family.
 	if ipv4Rules {
 		err = createOrPatchFirewall(ctx, firewallService, projectID, icmpRuleName, tag, "",
-			[]*compute.FirewallAllowed{{IPProtocol: "icmp"}}, []string{resources.IPv4MatchAnyCIDR}, update, cluster, firewallICMPCleanupFinalizer)
+			[]*compute.FirewallAllowed{{IPProtocol: "icmp"}}, []string{resources.IPv4MatchAnyCIDR}, update, cluster, FirewallICMPCleanupFinalizer)
 		if err != nil {
 			return err
 		}
 	}
 	if ipv6Rules {
 		err = createOrPatchFirewall(ctx, firewallService, projectID, icmpIPv6RuleName, tag, "",
-			[]*compute.FirewallAllowed{{IPProtocol: ipv6ICMPProtoNumber}}, []string{resources.IPv6MatchAnyCIDR}, update, cluster, firewallICMPCleanupFinalizer)
+			[]*compute.FirewallAllowed{{IPProtocol: ipv6ICMPProtoNumber}}, []string{resources.IPv6MatchAnyCIDR}, update, cluster, FirewallICMPCleanupFinalizer)
 		if err != nil {
 			return err
 		}
@@ -129,14 +129,14 @@ func reconcileFirewallRules(ctx context.Context, cluster *kubermaticv1.Cluster,
 	nodePortsIPv6CIDRs := nodePortsAllowedIPRanges.GetIPv6CIDRs()
 	if len(nodePortsIPv4CIDRs) > 0 {
 		err = createOrPatchFirewall(ctx, firewallService, projectID, nodePortRuleName, tag, "",
-			allowedProtocols, nodePortsIPv4CIDRs, update, cluster, firewallNodePortCleanupFinalizer)
+			allowedProtocols, nodePortsIPv4CIDRs, update, cluster, FirewallNodePortCleanupFinalizer)
 		if err != nil {
 			return err
 		}
 	}
 	if len(nodePortsIPv6CIDRs) > 0 {
 		err = createOrPatchFirewall(ctx, firewallService, projectID, nodePortIPv6RuleName, tag, "",
-			allowedProtocols, nodePortsIPv6CIDRs, update, cluster, firewallNodePortCleanupFinalizer)
+			allowedProtocols, nodePortsIPv6CIDRs, update, cluster, FirewallNodePortCleanupFinalizer)
 		if err != nil {
 			return err
 		}
@@ -210,30 +210,30 @@ func createOrPatchFirewall(ctx context.Context,
 func deleteFirewallRules(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater, log *zap.SugaredLogger, svc *compute.Service, projectID string) (*kubermaticv1.Cluster, error) {
 	firewallService := compute.NewFirewallsService(svc)
 
-	selfRuleName := fmt.Sprintf(selfRuleNamePattern, cluster.Name)
-	icmpRuleName := fmt.Sprintf(icmpRuleNamePattern, cluster.Name)
-	icmpIPv6RuleName := fmt.Sprintf(icmpIPv6RuleNamePattern, cluster.Name)
-	nodePortRuleName := fmt.Sprintf(nodePortRuleNamePattern, cluster.Name)
-	nodePortIPv6RuleName := fmt.Sprintf(nodePortIPv6RuleNamePattern, cluster.Name)
+	selfRuleName := fmt.Sprintf(SelfRuleNamePattern, cluster.Name)
+	icmpRuleName := fmt.Sprintf(ICMPRuleNamePattern, cluster.Name)
+	icmpIPv6RuleName := fmt.Sprintf(ICMPIPv6RuleNamePattern, cluster.Name)
+	nodePortRuleName := fmt.Sprintf(NodePortRuleNamePattern, cluster.Name)
+	nodePortIPv6RuleName := fmt.Sprintf(NodePortIPv6RuleNamePattern, cluster.Name)
 
 	ipv4Rules := cluster.IsIPv4Only() || cluster.IsDualStack()
 	ipv6Rules := cluster.IsIPv6Only() || cluster.IsDualStack()
 
-	if kuberneteshelper.HasFinalizer(cluster, firewallSelfCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, FirewallSelfCleanupFinalizer) {
 		_, err := firewallService.Delete(projectID, selfRuleName).Context(ctx).Do()
 		if err != nil && !isHTTPError(err, http.StatusNotFound) {
 			return nil, fmt.Errorf("failed to delete firewall rule %s: %w", selfRuleName, err)
 		}
 
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, firewallSelfCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, FirewallSelfCleanupFinalizer)
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to remove %s finalizer: %w", firewallSelfCleanupFinalizer, err)
+			return nil, fmt.Errorf("failed to remove %s finalizer: %w", FirewallSelfCleanupFinalizer, err)
 		}
 	}
 
-	if kuberneteshelper.HasFinalizer(cluster, firewallICMPCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, FirewallICMPCleanupFinalizer) {
 		if ipv4Rules {
 			_, err := firewallService.Delete(projectID, icmpRuleName).Context(ctx).Do()
 			if err != nil && !isHTTPError(err, http.StatusNotFound) {
@@ -249,15 +249,15 @@ func deleteFirewallRules(ctx context.Context, cluster *kubermaticv1.Cluster, upd
 
 		var err error
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, firewallICMPCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, FirewallICMPCleanupFinalizer)
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to remove %s finalizer: %w", firewallICMPCleanupFinalizer, err)
+			return nil, fmt.Errorf("failed to remove %s finalizer: %w", FirewallICMPCleanupFinalizer, err)
 		}
 	}
 
 	// remove the nodeport firewall rule
-	if kuberneteshelper.HasFinalizer(cluster, firewallNodePortCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, FirewallNodePortCleanupFinalizer) {
 		if ipv4Rules {
 			_, err := firewallService.Delete(projectID, nodePortRuleName).Context(ctx).Do()
 			if err != nil && !isHTTPError(err, http.StatusNotFound) {
@@ -273,23 +273,23 @@ func deleteFirewallRules(ctx context.Context, cluster *kubermaticv1.Cluster, upd
 
 		var err error
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, firewallNodePortCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, FirewallNodePortCleanupFinalizer)
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to remove %s finalizer: %w", firewallNodePortCleanupFinalizer, err)
+			return nil, fmt.Errorf("failed to remove %s finalizer: %w", FirewallNodePortCleanupFinalizer, err)
 		}
 	}
 
-	if kuberneteshelper.HasFinalizer(cluster, routesCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, RoutesCleanupFinalizer) {
 		err := cleanUnusedRoutes(ctx, cluster, log, svc, projectID)
 		if err != nil {
 			return nil, err
 		}
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, routesCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, RoutesCleanupFinalizer)
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to remove %s finalizer: %w", routesCleanupFinalizer, err)
+			return nil, fmt.Errorf("failed to remove %s finalizer: %w", RoutesCleanupFinalizer, err)
 		}
 	}
 
@@ -42,10 +42,10 @@ import (
 const (
 	DefaultNetwork                   = "global/networks/default"
 	computeAPIEndpoint               = "https://www.googleapis.com/compute/v1/"
-	firewallSelfCleanupFinalizer     = "kubermatic.k8c.io/cleanup-gcp-firewall-self"
-	firewallICMPCleanupFinalizer     = "kubermatic.k8c.io/cleanup-gcp-firewall-icmp"
-	firewallNodePortCleanupFinalizer = "kubermatic.k8c.io/cleanup-gcp-firewall-nodeport"
-	routesCleanupFinalizer           = "kubermatic.k8c.io/cleanup-gcp-routes"
+	FirewallSelfCleanupFinalizer     = "kubermatic.k8c.io/cleanup-gcp-firewall-self"
+	FirewallICMPCleanupFinalizer     = "kubermatic.k8c.io/cleanup-gcp-firewall-icmp"
+	FirewallNodePortCleanupFinalizer = "kubermatic.k8c.io/cleanup-gcp-firewall-nodeport"
+	RoutesCleanupFinalizer           = "kubermatic.k8c.io/cleanup-gcp-routes"
 
 	k8sNodeRouteTag          = "k8s-node-route"
 	k8sNodeRoutePrefixRegexp = "kubernetes-.*"
@@ -106,12 +106,12 @@ func (g *gcp) reconcileCluster(ctx context.Context, cluster *kubermaticv1.Cluste
 	}
 
 	// add the routes cleanup finalizer
-	if !kuberneteshelper.HasFinalizer(cluster, routesCleanupFinalizer) {
+	if !kuberneteshelper.HasFinalizer(cluster, RoutesCleanupFinalizer) {
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.AddFinalizer(cluster, routesCleanupFinalizer)
+			kuberneteshelper.AddFinalizer(cluster, RoutesCleanupFinalizer)
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to add %s finalizer: %w", routesCleanupFinalizer, err)
+			return nil, fmt.Errorf("failed to add %s finalizer: %w", RoutesCleanupFinalizer, err)
 		}
 	}
 	return cluster, nil
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	"k8s.io/utils/ptr"
+)
+
+// reconcileFirewall ensures that the cluster has a firewall. A firewall configured on the
+// cluster is adopted as-is, otherwise a firewall is created and owned by the cluster and
+// its rules are kept up-to-date. Note that Hetzner firewalls only filter traffic on the
+// public interfaces of servers, traffic within private networks is always allowed.
+func reconcileFirewall(ctx context.Context, client *hcloud.Client, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	firewallName := cluster.Spec.Cloud.Hetzner.Firewall
+	owned := kuberneteshelper.HasFinalizer(cluster, FirewallCleanupFinalizer)
+
+	if firewallName == "" {
+		firewallName = resourceNamePrefix + cluster.Name
+		owned = true
+	}
+
+	firewall, _, err := client.Firewall.GetByName(ctx, firewallName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get firewall %q: %w", firewallName, err)
+	}
+
+	if firewall == nil {
+		if !owned {
+			return nil, fmt.Errorf("firewall %q does not exist", firewallName)
+		}
+
+		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			kuberneteshelper.AddFinalizer(cluster, FirewallCleanupFinalizer)
+			cluster.Spec.Cloud.Hetzner.Firewall = firewallName
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+
+		result, _, err := client.Firewall.Create(ctx, hcloud.FirewallCreateOpts{
+			Name:   firewallName,
+			Labels: clusterLabels(cluster),
+			Rules:  firewallRules(cluster),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create firewall %q: %w", firewallName, err)
+		}
+
+		firewall = result.Firewall
+	} else if owned {
+		if !isOwnedByCluster(firewall.Labels, cluster) {
+			return nil, fmt.Errorf("firewall %q already exists, but does not belong to this cluster", firewallName)
+		}
+
+		rules := firewallRules(cluster)
+		if !firewallRulesEqual(firewall.Rules, rules) {
+			if _, _, err := client.Firewall.SetRules(ctx, firewall, hcloud.FirewallSetRulesOpts{Rules: rules}); err != nil {
+				return nil, fmt.Errorf("failed to update rules of firewall %q: %w", firewallName, err)
+			}
+		}
+	}
+
+	return update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
+		if owned {
+			kuberneteshelper.AddFinalizer(cluster, FirewallCleanupFinalizer)
+			cluster.Spec.Cloud.Hetzner.Firewall = firewallName
+		}
+		cluster.Spec.Cloud.Hetzner.FirewallID = firewall.ID
+	})
+}
+
+// firewallRules returns the inbound rules for the cluster's firewall. Outbound traffic is
+// not restricted, as Hetzner allows all outbound traffic if no outbound rule is configured.
+func firewallRules(cluster *kubermaticv1.Cluster) []hcloud.FirewallRule {
+	var anywhere []net.IPNet
+	if cluster.IsIPv4Only() || cluster.IsDualStack() {
+		anywhere = append(anywhere, mustParseCIDR(resources.IPv4MatchAnyCIDR))
+	}
+	if cluster.IsIPv6Only() || cluster.IsDualStack() {
+		anywhere = append(anywhere, mustParseCIDR(resources.IPv6MatchAnyCIDR))
+	}
+
+	rules := []hcloud.FirewallRule{
+		{
+			Description: ptr.To("ICMP"),
+			Direction:   hcloud.FirewallRuleDirectionIn,
+			Protocol:    hcloud.FirewallRuleProtocolICMP,
+			SourceIPs:   anywhere,
+		},
+		{
+			Description: ptr.To("SSH"),
+			Direction:   hcloud.FirewallRuleDirectionIn,
+			Protocol:    hcloud.FirewallRuleProtocolTCP,
+			Port:        ptr.To(fmt.Sprintf("%d", provider.DefaultSSHPort)),
+			SourceIPs:   anywhere,
+		},
+	}
+
+	nodePortRangeLow, nodePortRangeHigh := resources.NewTemplateDataBuilder().
+		WithNodePortRange(cluster.Spec.ComponentsOverride.Apiserver.NodePortRange).
+		WithCluster(cluster).
+		Build().
+		NodePorts()
+	nodePorts := fmt.Sprintf("%d-%d", nodePortRangeLow, nodePortRangeHigh)
+
+	var nodePortSources []net.IPNet
+	for _, cidr := range resources.GetNodePortsAllowedIPRanges(cluster, nil, "", nil).CIDRBlocks {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			nodePortSources = append(nodePortSources, *ipNet)
+		}
+	}
+
+	for _, protocol := range []hcloud.FirewallRuleProtocol{hcloud.FirewallRuleProtocolTCP, hcloud.FirewallRuleProtocolUDP} {
+		rules = append(rules, hcloud.FirewallRule{
+			Description: ptr.To("NodePorts"),
+			Direction:   hcloud.FirewallRuleDirectionIn,
+			Protocol:    protocol,
+			Port:        ptr.To(nodePorts),
+			SourceIPs:   nodePortSources,
+		})
+	}
+
+	return rules
+}
+
+// firewallRulesEqual compares two lists of rules, ignoring differences in how IP addresses
+// are represented internally.
+func firewallRulesEqual(a, b []hcloud.FirewallRule) bool {
+	return slices.Equal(formatFirewallRules(a), formatFirewallRules(b))
+}
+
+func formatFirewallRules(rules []hcloud.FirewallRule) []string {
+	formatted := make([]string, 0, len(rules))
+
+	formatIPs := func(ips []net.IPNet) string {
+		s := make([]string, 0, len(ips))
+		for _, ip := range ips {
+			s = append(s, ip.String())
+		}
+		return strings.Join(s, ",")
+	}
+
+	for _, rule := range rules {
+		formatted = append(formatted, fmt.Sprintf("%s/%s/%s/%s/%s/%s",
+			rule.Direction,
+			rule.Protocol,
+			ptr.Deref(rule.Port, ""),
+			formatIPs(rule.SourceIPs),
+			formatIPs(rule.DestinationIPs),
+			ptr.Deref(rule.Description, ""),
+		))
+	}
+
+	return formatted
+}
+
+func deleteFirewall(ctx context.Context, client *hcloud.Client, cluster *kubermaticv1.Cluster) error {
+	spec := cluster.Spec.Cloud.Hetzner
+
+	var (
+		firewall *hcloud.Firewall
+		err      error
+	)
+
+	if spec.FirewallID != 0 {
+		firewall, _, err = client.Firewall.GetByID(ctx, spec.FirewallID)
+	} else if spec.Firewall != "" {
+		firewall, _, err = client.Firewall.GetByName(ctx, spec.Firewall)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get firewall: %w", err)
+	}
+
+	// already gone
+	if firewall == nil {
+		return nil
+	}
+
+	if !isOwnedByCluster(firewall.Labels, cluster) {
+		return nil
+	}
+
+	if _, err := client.Firewall.Delete(ctx, firewall); err != nil && !hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
+		return fmt.Errorf("failed to delete firewall %q: %w", firewall.Name, err)
+	}
+
+	return nil
+}
+
+func mustParseCIDR(cidr string) net.IPNet {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	return *ipNet
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+const (
+	// defaultNetworkIPRange is the IP range of networks created by KKP. A single
+	// cloud subnet spanning the entire range is created in the network.
+	defaultNetworkIPRange = "192.168.0.0/16"
+)
+
+// reconcileNetwork ensures that the cluster has a network. If a network was configured
+// on the cluster or datacenter, it is adopted, otherwise a network is created and owned
+// by the cluster.
+func reconcileNetwork(ctx context.Context, client *hcloud.Client, cluster *kubermaticv1.Cluster, dc *kubermaticv1.DatacenterSpecHetzner, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	networkName := cluster.Spec.Cloud.Hetzner.Network
+	owned := kuberneteshelper.HasFinalizer(cluster, NetworkCleanupFinalizer)
+
+	// fall back to the datacenter network, just like the machine-controller and CCM do
+	if networkName == "" {
+		networkName = dc.Network
+	}
+
+	// neither cluster nor datacenter have a network configured, so we create our own
+	if networkName == "" {
+		networkName = resourceNamePrefix + cluster.Name
+		owned = true
+	}
+
+	network, _, err := client.Network.GetByName(ctx, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network %q: %w", networkName, err)
+	}
+
+	if network == nil {
+		if !owned {
+			return nil, fmt.Errorf("network %q does not exist", networkName)
+		}
+
+		// make sure the network is cleaned up even if the cluster update below fails
+		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			kuberneteshelper.AddFinalizer(cluster, NetworkCleanupFinalizer)
+			cluster.Spec.Cloud.Hetzner.Network = networkName
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+
+		network, err = createNetwork(ctx, client, cluster, dc, networkName)
+		if err != nil {
+			return nil, err
+		}
+	} else if owned && !isOwnedByCluster(network.Labels, cluster) {
+		return nil, fmt.Errorf("network %q already exists, but does not belong to this cluster", networkName)
+	}
+
+	return update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
+		if owned {
+			kuberneteshelper.AddFinalizer(cluster, NetworkCleanupFinalizer)
+			cluster.Spec.Cloud.Hetzner.Network = networkName
+		}
+		cluster.Spec.Cloud.Hetzner.NetworkID = network.ID
+	})
+}
+
+func createNetwork(ctx context.Context, client *hcloud.Client, cluster *kubermaticv1.Cluster, dc *kubermaticv1.DatacenterSpecHetzner, name string) (*hcloud.Network, error) {
+	datacenter, _, err := client.Datacenter.GetByName(ctx, dc.Datacenter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get datacenter %q: %w", dc.Datacenter, err)
+	}
+	if datacenter == nil || datacenter.Location == nil {
+		return nil, fmt.Errorf("datacenter %q not found", dc.Datacenter)
+	}
+
+	_, ipRange, err := net.ParseCIDR(defaultNetworkIPRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse network IP range: %w", err)
+	}
+
+	network, _, err := client.Network.Create(ctx, hcloud.NetworkCreateOpts{
+		Name:    name,
+		IPRange: ipRange,
+		Labels:  clusterLabels(cluster),
+		Subnets: []hcloud.NetworkSubnet{
+			{
+				Type:        hcloud.NetworkSubnetTypeCloud,
+				IPRange:     ipRange,
+				NetworkZone: datacenter.Location.NetworkZone,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network %q: %w", name, err)
+	}
+
+	return network, nil
+}
+
+func deleteNetwork(ctx context.Context, client *hcloud.Client, cluster *kubermaticv1.Cluster) error {
+	network, err := getNetwork(ctx, client, cluster.Spec.Cloud.Hetzner)
+	if err != nil {
+		return err
+	}
+
+	// already gone
+	if network == nil {
+		return nil
+	}
+
+	if !isOwnedByCluster(network.Labels, cluster) {
+		return nil
+	}
+
+	if _, err := client.Network.Delete(ctx, network); err != nil && !hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
+		return fmt.Errorf("failed to delete network %q: %w", network.Name, err)
+	}
+
+	return nil
+}
+
+func getNetwork(ctx context.Context, client *hcloud.Client, spec *kubermaticv1.HetznerCloudSpec) (*hcloud.Network, error) {
+	var (
+		network *hcloud.Network
+		err     error
+	)
+
+	if spec.NetworkID != 0 {
+		network, _, err = client.Network.GetByID(ctx, spec.NetworkID)
+	} else if spec.Network != "" {
+		network, _, err = client.Network.GetByName(ctx, spec.Network)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network: %w", err)
+	}
+
+	return network, nil
+}
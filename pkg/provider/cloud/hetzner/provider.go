@@ -25,22 +25,56 @@ import (
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
 )
 
+const (
+	// NetworkCleanupFinalizer will instruct the deletion of the network.
+	NetworkCleanupFinalizer = "kubermatic.k8c.io/cleanup-hetzner-network"
+	// FirewallCleanupFinalizer will instruct the deletion of the firewall.
+	FirewallCleanupFinalizer = "kubermatic.k8c.io/cleanup-hetzner-firewall"
+
+	resourceNamePrefix = "kubernetes-"
+
+	// clusterTagKey is the label put on all resources created by KKP.
+	clusterTagKey = "kubernetes-cluster"
+)
+
 type hetzner struct {
+	dc                *kubermaticv1.DatacenterSpecHetzner
 	secretKeySelector provider.SecretKeySelectorValueFunc
+
+	// clientOptions are passed to every hcloud client, which allows
+	// tests to point the provider to a fake API.
+	clientOptions []hcloud.ClientOption
 }
 
 // NewCloudProvider creates a new hetzner provider.
-func NewCloudProvider(secretKeyGetter provider.SecretKeySelectorValueFunc) provider.CloudProvider {
+func NewCloudProvider(dc *kubermaticv1.Datacenter, secretKeyGetter provider.SecretKeySelectorValueFunc) (provider.CloudProvider, error) {
+	if dc.Spec.Hetzner == nil {
+		return nil, errors.New("datacenter is not a Hetzner datacenter")
+	}
+
 	return &hetzner{
+		dc:                dc.Spec.Hetzner,
 		secretKeySelector: secretKeyGetter,
-	}
+	}, nil
 }
 
-var _ provider.CloudProvider = &hetzner{}
+var _ provider.ReconcilingCloudProvider = &hetzner{}
+
+func (h *hetzner) getClient(cloud kubermaticv1.CloudSpec) (*hcloud.Client, error) {
+	hetznerToken, err := GetCredentialsForCluster(cloud, h.secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+
+	options := append([]hcloud.ClientOption{hcloud.WithToken(hetznerToken)}, h.clientOptions...)
+
+	return hcloud.NewClient(options...), nil
+}
 
 // DefaultCloudSpec.
 func (h *hetzner) DefaultCloudSpec(_ context.Context, _ *kubermaticv1.ClusterSpec) error {
@@ -49,13 +83,11 @@ func (h *hetzner) DefaultCloudSpec(_ context.Context, _ *kubermaticv1.ClusterSpe
 
 // ValidateCloudSpec.
 func (h *hetzner) ValidateCloudSpec(ctx context.Context, spec kubermaticv1.CloudSpec) error {
-	hetznerToken, err := GetCredentialsForCluster(spec, h.secretKeySelector)
+	client, err := h.getClient(spec)
 	if err != nil {
 		return err
 	}
 
-	client := hcloud.NewClient(hcloud.WithToken(hetznerToken))
-
 	timeout, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
@@ -74,16 +106,97 @@ func (h *hetzner) ValidateCloudSpec(ctx context.Context, spec kubermaticv1.Cloud
 	return err
 }
 
-// InitializeCloudProvider.
-func (h *hetzner) InitializeCloudProvider(_ context.Context, cluster *kubermaticv1.Cluster, _ provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+// InitializeCloudProvider initializes a cluster.
+func (h *hetzner) InitializeCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	return h.reconcileCluster(ctx, cluster, update, false)
+}
+
+// ReconcileCluster enforces the existence of the network and firewall of the cluster.
+func (h *hetzner) ReconcileCluster(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	return h.reconcileCluster(ctx, cluster, update, true)
+}
+
+func (*hetzner) ClusterNeedsReconciling(cluster *kubermaticv1.Cluster) bool {
+	return false
+}
+
+func (h *hetzner) reconcileCluster(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater, force bool) (*kubermaticv1.Cluster, error) {
+	client, err := h.getClient(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	if force || cluster.Spec.Cloud.Hetzner.NetworkID == 0 {
+		cluster, err = reconcileNetwork(ctx, client, cluster, h.dc, update)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile network: %w", err)
+		}
+	}
+
+	if force || cluster.Spec.Cloud.Hetzner.FirewallID == 0 {
+		cluster, err = reconcileFirewall(ctx, client, cluster, update)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile firewall: %w", err)
+		}
+	}
+
 	return cluster, nil
 }
 
-// CleanUpCloudProvider.
-func (h *hetzner) CleanUpCloudProvider(_ context.Context, cluster *kubermaticv1.Cluster, _ provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+// CleanUpCloudProvider removes the network and firewall, if they were created by KKP.
+func (h *hetzner) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	if !kuberneteshelper.HasAnyFinalizer(cluster, FirewallCleanupFinalizer, NetworkCleanupFinalizer) {
+		return cluster, nil
+	}
+
+	client, err := h.getClient(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	// the firewall is deleted first, as it was possibly created for a network
+	// that is about to be removed
+	if kuberneteshelper.HasFinalizer(cluster, FirewallCleanupFinalizer) {
+		if err := deleteFirewall(ctx, client, cluster); err != nil {
+			return nil, err
+		}
+
+		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			kuberneteshelper.RemoveFinalizer(cluster, FirewallCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if kuberneteshelper.HasFinalizer(cluster, NetworkCleanupFinalizer) {
+		if err := deleteNetwork(ctx, client, cluster); err != nil {
+			return nil, err
+		}
+
+		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			kuberneteshelper.RemoveFinalizer(cluster, NetworkCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return cluster, nil
 }
 
+// clusterLabels returns the labels that are put on every resource created for the cluster.
+func clusterLabels(cluster *kubermaticv1.Cluster) map[string]string {
+	return map[string]string{
+		clusterTagKey: cluster.Name,
+	}
+}
+
+// isOwnedByCluster checks whether a resource was created by KKP for the given cluster.
+func isOwnedByCluster(labels map[string]string, cluster *kubermaticv1.Cluster) bool {
+	return labels[clusterTagKey] == cluster.Name
+}
+
 // ValidateCloudSpecUpdate verifies whether an update of cloud spec is valid and permitted.
 func (h *hetzner) ValidateCloudSpecUpdate(_ context.Context, _ kubermaticv1.CloudSpec, _ kubermaticv1.CloudSpec) error {
 	return nil
@@ -0,0 +1,539 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// fakeHetznerAPI is a minimal, in-memory implementation of the parts of the
+// Hetzner Cloud API that are used by the cloud provider.
+type fakeHetznerAPI struct {
+	lock      sync.Mutex
+	lastID    int64
+	networks  map[int64]*schema.Network
+	firewalls map[int64]*schema.Firewall
+}
+
+func newFakeHetznerAPI(t *testing.T) (*fakeHetznerAPI, *httptest.Server) {
+	api := &fakeHetznerAPI{
+		networks:  map[int64]*schema.Network{},
+		firewalls: map[int64]*schema.Firewall{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /datacenters", api.listDatacenters)
+	mux.HandleFunc("GET /networks", api.listNetworks)
+	mux.HandleFunc("POST /networks", api.createNetwork)
+	mux.HandleFunc("GET /networks/{id}", api.getNetwork)
+	mux.HandleFunc("DELETE /networks/{id}", api.deleteNetwork)
+	mux.HandleFunc("GET /firewalls", api.listFirewalls)
+	mux.HandleFunc("POST /firewalls", api.createFirewall)
+	mux.HandleFunc("GET /firewalls/{id}", api.getFirewall)
+	mux.HandleFunc("DELETE /firewalls/{id}", api.deleteFirewall)
+	mux.HandleFunc("POST /firewalls/{id}/actions/set_rules", api.setFirewallRules)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return api, server
+}
+
+func (a *fakeHetznerAPI) nextID() int64 {
+	a.lastID++
+	return a.lastID
+}
+
+func (a *fakeHetznerAPI) addNetwork(name string, labels map[string]string) *schema.Network {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	network := &schema.Network{ID: a.nextID(), Name: name, IPRange: "10.0.0.0/8", Labels: labels}
+	a.networks[network.ID] = network
+
+	return network
+}
+
+func (a *fakeHetznerAPI) addFirewall(name string, labels map[string]string) *schema.Firewall {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	firewall := &schema.Firewall{ID: a.nextID(), Name: name, Labels: labels}
+	a.firewalls[firewall.ID] = firewall
+
+	return firewall
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, schema.ErrorResponse{
+		Error: schema.Error{Code: string(hcloud.ErrorCodeNotFound), Message: "not found"},
+	})
+}
+
+func pathID(r *http.Request) int64 {
+	id, _ := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	return id
+}
+
+func (a *fakeHetznerAPI) listDatacenters(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, schema.DatacenterListResponse{
+		Datacenters: []schema.Datacenter{{
+			ID:   1,
+			Name: r.URL.Query().Get("name"),
+			Location: schema.Location{
+				ID:          1,
+				Name:        "nbg1",
+				NetworkZone: "eu-central",
+			},
+		}},
+	})
+}
+
+func (a *fakeHetznerAPI) listNetworks(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	response := schema.NetworkListResponse{Networks: []schema.Network{}}
+	for _, network := range a.networks {
+		if network.Name == r.URL.Query().Get("name") {
+			response.Networks = append(response.Networks, *network)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (a *fakeHetznerAPI) createNetwork(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	request := schema.NetworkCreateRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	network := &schema.Network{
+		ID:      a.nextID(),
+		Name:    request.Name,
+		IPRange: request.IPRange,
+		Subnets: request.Subnets,
+		Labels:  ptr.Deref(request.Labels, nil),
+	}
+	a.networks[network.ID] = network
+
+	writeJSON(w, http.StatusCreated, schema.NetworkCreateResponse{Network: *network})
+}
+
+func (a *fakeHetznerAPI) getNetwork(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	network, ok := a.networks[pathID(r)]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, schema.NetworkGetResponse{Network: *network})
+}
+
+func (a *fakeHetznerAPI) deleteNetwork(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if _, ok := a.networks[pathID(r)]; !ok {
+		writeNotFound(w)
+		return
+	}
+
+	delete(a.networks, pathID(r))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *fakeHetznerAPI) listFirewalls(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	response := schema.FirewallListResponse{Firewalls: []schema.Firewall{}}
+	for _, firewall := range a.firewalls {
+		if firewall.Name == r.URL.Query().Get("name") {
+			response.Firewalls = append(response.Firewalls, *firewall)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func toFirewallRules(requested []schema.FirewallRuleRequest) []schema.FirewallRule {
+	rules := []schema.FirewallRule{}
+	for _, rule := range requested {
+		rules = append(rules, schema.FirewallRule{
+			Direction:      rule.Direction,
+			SourceIPs:      rule.SourceIPs,
+			DestinationIPs: rule.DestinationIPs,
+			Protocol:       rule.Protocol,
+			Port:           rule.Port,
+			Description:    rule.Description,
+		})
+	}
+
+	return rules
+}
+
+func (a *fakeHetznerAPI) createFirewall(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	request := schema.FirewallCreateRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	firewall := &schema.Firewall{
+		ID:     a.nextID(),
+		Name:   request.Name,
+		Labels: ptr.Deref(request.Labels, nil),
+		Rules:  toFirewallRules(request.Rules),
+	}
+	a.firewalls[firewall.ID] = firewall
+
+	writeJSON(w, http.StatusCreated, schema.FirewallCreateResponse{Firewall: *firewall})
+}
+
+func (a *fakeHetznerAPI) getFirewall(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	firewall, ok := a.firewalls[pathID(r)]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, schema.FirewallGetResponse{Firewall: *firewall})
+}
+
+func (a *fakeHetznerAPI) deleteFirewall(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if _, ok := a.firewalls[pathID(r)]; !ok {
+		writeNotFound(w)
+		return
+	}
+
+	delete(a.firewalls, pathID(r))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *fakeHetznerAPI) setFirewallRules(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	firewall, ok := a.firewalls[pathID(r)]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	request := schema.FirewallActionSetRulesRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	firewall.Rules = toFirewallRules(request.Rules)
+
+	writeJSON(w, http.StatusCreated, schema.FirewallActionSetRulesResponse{Actions: []schema.Action{}})
+}
+
+func testClusterUpdater(cluster *kubermaticv1.Cluster) provider.ClusterUpdater {
+	return func(_ context.Context, clusterName string, patcher func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+		patcher(cluster)
+		return cluster, nil
+	}
+}
+
+func newTestCluster(spec *kubermaticv1.HetznerCloudSpec) *kubermaticv1.Cluster {
+	spec.Token = "test-token"
+
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-cluster",
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				Hetzner: spec,
+			},
+			ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+				Pods: kubermaticv1.NetworkRanges{
+					CIDRBlocks: []string{"172.25.0.0/16"},
+				},
+			},
+		},
+	}
+}
+
+func newTestProvider(server *httptest.Server, dc *kubermaticv1.DatacenterSpecHetzner) *hetzner {
+	return &hetzner{
+		dc:            dc,
+		clientOptions: []hcloud.ClientOption{hcloud.WithEndpoint(server.URL)},
+	}
+}
+
+func TestReconcileCluster(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		datacenter           *kubermaticv1.DatacenterSpecHetzner
+		spec                 *kubermaticv1.HetznerCloudSpec
+		existingNetwork      string
+		existingFirewall     string
+		expectedNetwork      string
+		expectedFirewall     string
+		expectedFinalizers   []string
+		expectedNetworkCount int
+	}{
+		{
+			name:               "create network and firewall",
+			datacenter:         &kubermaticv1.DatacenterSpecHetzner{Datacenter: "nbg1-dc3"},
+			spec:               &kubermaticv1.HetznerCloudSpec{},
+			expectedNetwork:    "kubernetes-test-cluster",
+			expectedFirewall:   "kubernetes-test-cluster",
+			expectedFinalizers: []string{NetworkCleanupFinalizer, FirewallCleanupFinalizer},
+		},
+		{
+			name:               "adopt network from the datacenter",
+			datacenter:         &kubermaticv1.DatacenterSpecHetzner{Datacenter: "nbg1-dc3", Network: "dc-network"},
+			spec:               &kubermaticv1.HetznerCloudSpec{},
+			existingNetwork:    "dc-network",
+			expectedNetwork:    "",
+			expectedFirewall:   "kubernetes-test-cluster",
+			expectedFinalizers: []string{FirewallCleanupFinalizer},
+		},
+		{
+			name:             "adopt network and firewall from the cluster",
+			datacenter:       &kubermaticv1.DatacenterSpecHetzner{Datacenter: "nbg1-dc3"},
+			spec:             &kubermaticv1.HetznerCloudSpec{Network: "my-network", Firewall: "my-firewall"},
+			existingNetwork:  "my-network",
+			existingFirewall: "my-firewall",
+			expectedNetwork:  "my-network",
+			expectedFirewall: "my-firewall",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			api, server := newFakeHetznerAPI(t)
+
+			var existingNetworkID, existingFirewallID int64
+			if tc.existingNetwork != "" {
+				existingNetworkID = api.addNetwork(tc.existingNetwork, nil).ID
+			}
+			if tc.existingFirewall != "" {
+				existingFirewallID = api.addFirewall(tc.existingFirewall, nil).ID
+			}
+
+			prov := newTestProvider(server, tc.datacenter)
+			cluster := newTestCluster(tc.spec)
+
+			cluster, err := prov.InitializeCloudProvider(ctx, cluster, testClusterUpdater(cluster))
+			if err != nil {
+				t.Fatalf("Failed to initialize cluster: %v", err)
+			}
+
+			spec := cluster.Spec.Cloud.Hetzner
+			if spec.Network != tc.expectedNetwork {
+				t.Errorf("Expected network %q, got %q.", tc.expectedNetwork, spec.Network)
+			}
+			if spec.Firewall != tc.expectedFirewall {
+				t.Errorf("Expected firewall %q, got %q.", tc.expectedFirewall, spec.Firewall)
+			}
+
+			if existingNetworkID != 0 && spec.NetworkID != existingNetworkID {
+				t.Errorf("Expected existing network %d to be adopted, but got network ID %d.", existingNetworkID, spec.NetworkID)
+			}
+			if existingFirewallID != 0 && spec.FirewallID != existingFirewallID {
+				t.Errorf("Expected existing firewall %d to be adopted, but got firewall ID %d.", existingFirewallID, spec.FirewallID)
+			}
+
+			network, ok := api.networks[spec.NetworkID]
+			if !ok {
+				t.Fatalf("Network %d does not exist.", spec.NetworkID)
+			}
+			firewall, ok := api.firewalls[spec.FirewallID]
+			if !ok {
+				t.Fatalf("Firewall %d does not exist.", spec.FirewallID)
+			}
+
+			if kuberneteshelper.HasFinalizer(cluster, NetworkCleanupFinalizer) {
+				if !isOwnedByCluster(network.Labels, cluster) {
+					t.Errorf("Expected created network to be labelled with the cluster ID, but got %v.", network.Labels)
+				}
+				if len(network.Subnets) != 1 || network.Subnets[0].NetworkZone != "eu-central" {
+					t.Errorf("Expected created network to have a single subnet in the datacenter's network zone, but got %v.", network.Subnets)
+				}
+			}
+
+			if kuberneteshelper.HasFinalizer(cluster, FirewallCleanupFinalizer) {
+				if !isOwnedByCluster(firewall.Labels, cluster) {
+					t.Errorf("Expected created firewall to be labelled with the cluster ID, but got %v.", firewall.Labels)
+				}
+				if len(firewall.Rules) == 0 {
+					t.Error("Expected created firewall to have rules.")
+				}
+			} else if len(firewall.Rules) != 0 {
+				t.Errorf("Expected adopted firewall to not be modified, but it has rules %v.", firewall.Rules)
+			}
+
+			if len(cluster.Finalizers) != len(tc.expectedFinalizers) || !kuberneteshelper.HasFinalizer(cluster, tc.expectedFinalizers...) {
+				t.Errorf("Expected finalizers %v, got %v.", tc.expectedFinalizers, cluster.Finalizers)
+			}
+
+			// reconciling again must not create any additional resources
+			if _, err := prov.ReconcileCluster(ctx, cluster, testClusterUpdater(cluster)); err != nil {
+				t.Fatalf("Failed to reconcile cluster: %v", err)
+			}
+
+			if len(api.networks) != 1 || len(api.firewalls) != 1 {
+				t.Errorf("Expected exactly one network and firewall, but got %d networks and %d firewalls.", len(api.networks), len(api.firewalls))
+			}
+		})
+	}
+}
+
+func TestReconcileClusterFixesFirewallRules(t *testing.T) {
+	ctx := context.Background()
+	api, server := newFakeHetznerAPI(t)
+
+	prov := newTestProvider(server, &kubermaticv1.DatacenterSpecHetzner{Datacenter: "nbg1-dc3"})
+	cluster := newTestCluster(&kubermaticv1.HetznerCloudSpec{})
+
+	cluster, err := prov.InitializeCloudProvider(ctx, cluster, testClusterUpdater(cluster))
+	if err != nil {
+		t.Fatalf("Failed to initialize cluster: %v", err)
+	}
+
+	// simulate someone tampering with the firewall
+	api.firewalls[cluster.Spec.Cloud.Hetzner.FirewallID].Rules = nil
+
+	if _, err := prov.ReconcileCluster(ctx, cluster, testClusterUpdater(cluster)); err != nil {
+		t.Fatalf("Failed to reconcile cluster: %v", err)
+	}
+
+	rules := api.firewalls[cluster.Spec.Cloud.Hetzner.FirewallID].Rules
+	if len(rules) != len(firewallRules(cluster)) {
+		t.Fatalf("Expected firewall rules to be restored, but got %v.", rules)
+	}
+}
+
+func TestReconcileClusterRejectsForeignResources(t *testing.T) {
+	ctx := context.Background()
+	api, server := newFakeHetznerAPI(t)
+
+	// a network with the name KKP would use, but not created for this cluster
+	api.addNetwork("kubernetes-test-cluster", map[string]string{clusterTagKey: "other-cluster"})
+
+	prov := newTestProvider(server, &kubermaticv1.DatacenterSpecHetzner{Datacenter: "nbg1-dc3"})
+	cluster := newTestCluster(&kubermaticv1.HetznerCloudSpec{})
+
+	if _, err := prov.InitializeCloudProvider(ctx, cluster, testClusterUpdater(cluster)); err == nil {
+		t.Fatal("Expected an error when a foreign network with the same name exists, but got none.")
+	}
+}
+
+func TestCleanUpCloudProvider(t *testing.T) {
+	ctx := context.Background()
+	api, server := newFakeHetznerAPI(t)
+
+	adoptedNetwork := api.addNetwork("dc-network", nil)
+
+	prov := newTestProvider(server, &kubermaticv1.DatacenterSpecHetzner{Datacenter: "nbg1-dc3", Network: "dc-network"})
+	cluster := newTestCluster(&kubermaticv1.HetznerCloudSpec{})
+
+	cluster, err := prov.InitializeCloudProvider(ctx, cluster, testClusterUpdater(cluster))
+	if err != nil {
+		t.Fatalf("Failed to initialize cluster: %v", err)
+	}
+
+	cluster, err = prov.CleanUpCloudProvider(ctx, cluster, testClusterUpdater(cluster))
+	if err != nil {
+		t.Fatalf("Failed to clean up cluster: %v", err)
+	}
+
+	if len(api.firewalls) != 0 {
+		t.Errorf("Expected firewall to be deleted, but %d firewalls remain.", len(api.firewalls))
+	}
+
+	if _, ok := api.networks[adoptedNetwork.ID]; !ok {
+		t.Error("Expected adopted network to not be deleted.")
+	}
+
+	if len(cluster.Finalizers) != 0 {
+		t.Errorf("Expected all finalizers to be removed, but got %v.", cluster.Finalizers)
+	}
+
+	// cleaning up again must be a no-op
+	if _, err := prov.CleanUpCloudProvider(ctx, cluster, testClusterUpdater(cluster)); err != nil {
+		t.Fatalf("Failed to clean up cluster a second time: %v", err)
+	}
+}
+
+func TestCleanUpCloudProviderDeletesOwnedNetwork(t *testing.T) {
+	ctx := context.Background()
+	api, server := newFakeHetznerAPI(t)
+
+	prov := newTestProvider(server, &kubermaticv1.DatacenterSpecHetzner{Datacenter: "nbg1-dc3"})
+	cluster := newTestCluster(&kubermaticv1.HetznerCloudSpec{})
+
+	cluster, err := prov.InitializeCloudProvider(ctx, cluster, testClusterUpdater(cluster))
+	if err != nil {
+		t.Fatalf("Failed to initialize cluster: %v", err)
+	}
+
+	if _, err := prov.CleanUpCloudProvider(ctx, cluster, testClusterUpdater(cluster)); err != nil {
+		t.Fatalf("Failed to clean up cluster: %v", err)
+	}
+
+	if len(api.networks) != 0 || len(api.firewalls) != 0 {
+		t.Errorf("Expected all resources to be deleted, but %d networks and %d firewalls remain.", len(api.networks), len(api.firewalls))
+	}
+}
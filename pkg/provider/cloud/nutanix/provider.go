@@ -41,7 +41,7 @@ const (
 
 	DefaultProject = "default"
 
-	categoryCleanupFinalizer = "kubermatic.k8c.io/cleanup-nutanix-categories"
+	CategoryCleanupFinalizer = "kubermatic.k8c.io/cleanup-nutanix-categories"
 )
 
 type Nutanix struct {
@@ -77,7 +77,7 @@ func (*Nutanix) ClusterNeedsReconciling(cluster *kubermaticv1.Cluster) bool {
 }
 
 func (n *Nutanix) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
-	if !kuberneteshelper.HasFinalizer(cluster, categoryCleanupFinalizer) {
+	if !kuberneteshelper.HasFinalizer(cluster, CategoryCleanupFinalizer) {
 		return cluster, nil
 	}
 
@@ -94,7 +94,7 @@ func (n *Nutanix) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv
 	}
 
 	return update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-		kuberneteshelper.RemoveFinalizer(cluster, categoryCleanupFinalizer)
+		kuberneteshelper.RemoveFinalizer(cluster, CategoryCleanupFinalizer)
 	})
 }
 
@@ -165,7 +165,7 @@ func (n *Nutanix) reconcileCluster(ctx context.Context, cluster *kubermaticv1.Cl
 	}
 
 	cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-		kuberneteshelper.AddFinalizer(cluster, categoryCleanupFinalizer)
+		kuberneteshelper.AddFinalizer(cluster, CategoryCleanupFinalizer)
 	})
 
 	if err != nil {
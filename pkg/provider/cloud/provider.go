@@ -64,7 +64,7 @@ func Provider(
 		return openstack.NewCloudProvider(datacenter, secretKeyGetter, caBundle)
 	}
 	if datacenter.Spec.Hetzner != nil {
-		return hetzner.NewCloudProvider(secretKeyGetter), nil
+		return hetzner.NewCloudProvider(datacenter, secretKeyGetter)
 	}
 	if datacenter.Spec.VMwareCloudDirector != nil {
 		return vmwareclouddirector.NewCloudProvider(datacenter, secretKeyGetter)
@@ -33,7 +33,7 @@ import (
 )
 
 const (
-	vappFinalizer = "kubermatic.k8c.io/cleanup-vmware-cloud-director-vapp"
+	VAppCleanupFinalizer = "kubermatic.k8c.io/cleanup-vmware-cloud-director-vapp"
 )
 
 type Provider struct {
@@ -113,7 +113,7 @@ func (p *Provider) ReconcileCluster(ctx context.Context, cluster *kubermaticv1.C
 
 func (p *Provider) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
 	// Cleanup is not required if finalizer was not present.
-	if !kuberneteshelper.HasFinalizer(cluster, vappFinalizer) {
+	if !kuberneteshelper.HasFinalizer(cluster, VAppCleanupFinalizer) {
 		return nil, nil
 	}
 
@@ -153,7 +153,7 @@ func (p *Provider) CleanUpCloudProvider(ctx context.Context, cluster *kubermatic
 
 	// vApp has been removed at this point. We need to cleanup the finalizer
 	return update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-		kuberneteshelper.RemoveFinalizer(cluster, vappFinalizer)
+		kuberneteshelper.RemoveFinalizer(cluster, VAppCleanupFinalizer)
 	})
 }
 
@@ -36,9 +36,9 @@ const (
 func reconcileVApp(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater, vdc *govcd.Vdc) (*kubermaticv1.Cluster, error) {
 	var err error
 	// Ensure that finalizer exists
-	if !kuberneteshelper.HasFinalizer(cluster, vappFinalizer) {
+	if !kuberneteshelper.HasFinalizer(cluster, VAppCleanupFinalizer) {
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.AddFinalizer(cluster, vappFinalizer)
+			kuberneteshelper.AddFinalizer(cluster, VAppCleanupFinalizer)
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to add finalizer: %w", err)
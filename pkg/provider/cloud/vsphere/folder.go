@@ -45,14 +45,14 @@ func reconcileFolder(ctx context.Context, s *Session, restSession *RESTSession,
 	}
 
 	cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-		if !kuberneteshelper.HasFinalizer(cluster, folderCleanupFinalizer) {
-			kuberneteshelper.AddFinalizer(cluster, folderCleanupFinalizer)
+		if !kuberneteshelper.HasFinalizer(cluster, FolderCleanupFinalizer) {
+			kuberneteshelper.AddFinalizer(cluster, FolderCleanupFinalizer)
 		}
 
 		cluster.Spec.Cloud.VSphere.Folder = folderPath
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to add finalizer %s on vsphere cluster object: %w", folderCleanupFinalizer, err)
+		return nil, fmt.Errorf("failed to add finalizer %s on vsphere cluster object: %w", FolderCleanupFinalizer, err)
 	}
 	return cluster, nil
 }
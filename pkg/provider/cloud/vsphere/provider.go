@@ -34,11 +34,11 @@ import (
 )
 
 const (
-	folderCleanupFinalizer = "kubermatic.k8c.io/cleanup-vsphere-folder"
-	// tagCleanupFinalizer will instruct the deletion of the default category tag.
-	tagCleanupFinalizer = "kubermatic.k8c.io/cleanup-vsphere-tags"
-	// tagCategoryCleanupFinalizer is a legacy finalizer that needs to be removed unconditionally.
-	tagCategoryCleanupFinalizer = "kubermatic.k8c.io/cleanup-vsphere-tag-category"
+	FolderCleanupFinalizer = "kubermatic.k8c.io/cleanup-vsphere-folder"
+	// TagCleanupFinalizer will instruct the deletion of the default category tag.
+	TagCleanupFinalizer = "kubermatic.k8c.io/cleanup-vsphere-tags"
+	// TagCategoryCleanupFinalizer is a legacy finalizer that needs to be removed unconditionally.
+	TagCategoryCleanupFinalizer = "kubermatic.k8c.io/cleanup-vsphere-tag-category"
 )
 
 // VSphere represents the vsphere provider.
@@ -240,12 +240,12 @@ func (v *VSphere) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv
 	}
 	defer restSession.Logout(ctx)
 
-	if kuberneteshelper.HasFinalizer(cluster, folderCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, FolderCleanupFinalizer) {
 		if err := deleteVMFolder(ctx, session, cluster.Spec.Cloud.VSphere.Folder); err != nil {
 			return nil, err
 		}
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, folderCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, FolderCleanupFinalizer)
 		})
 		if err != nil {
 			return nil, err
@@ -258,9 +258,9 @@ func (v *VSphere) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv
 		}
 	}
 
-	if kuberneteshelper.HasFinalizer(cluster, tagCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, TagCleanupFinalizer) {
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, tagCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, TagCleanupFinalizer)
 		})
 		if err != nil {
 			return nil, err
@@ -268,9 +268,9 @@ func (v *VSphere) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv
 	}
 
 	// remove orphaned Category finalizer
-	if kuberneteshelper.HasFinalizer(cluster, tagCategoryCleanupFinalizer) {
+	if kuberneteshelper.HasFinalizer(cluster, TagCategoryCleanupFinalizer) {
 		cluster, err = update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-			kuberneteshelper.RemoveFinalizer(cluster, tagCategoryCleanupFinalizer)
+			kuberneteshelper.RemoveFinalizer(cluster, TagCategoryCleanupFinalizer)
 		})
 		if err != nil {
 			return nil, err
@@ -39,12 +39,12 @@ func reconcileTags(ctx context.Context, restSession *RESTSession, cluster *kuber
 	}
 
 	cluster, err := update(ctx, cluster.Name, func(cluster *kubermaticv1.Cluster) {
-		if !kuberneteshelper.HasFinalizer(cluster, tagCleanupFinalizer) {
-			kuberneteshelper.AddFinalizer(cluster, tagCleanupFinalizer)
+		if !kuberneteshelper.HasFinalizer(cluster, TagCleanupFinalizer) {
+			kuberneteshelper.AddFinalizer(cluster, TagCleanupFinalizer)
 		}
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to add finalizer %s on vsphere cluster object: %w", tagCleanupFinalizer, err)
+		return nil, fmt.Errorf("failed to add finalizer %s on vsphere cluster object: %w", TagCleanupFinalizer, err)
 	}
 
 	return cluster, nil
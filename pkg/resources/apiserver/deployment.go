@@ -271,6 +271,17 @@ func DeploymentReconciler(data *resources.TemplateData, enableOIDCAuthentication
 				)
 			}
 
+			if isKMSPluginEnabled(data.Cluster()) {
+				kms := data.Cluster().Spec.EncryptionConfiguration.KMS
+
+				defResourceRequirements[kmsPluginSidecarName] = kmsPluginDefaultResourceRequirements.DeepCopy()
+				if kms.Resources != nil {
+					overrides[kmsPluginSidecarName] = kms.Resources.DeepCopy()
+				}
+
+				dep.Spec.Template.Spec.Containers = append(dep.Spec.Template.Spec.Containers, kmsPluginSidecar(kms))
+			}
+
 			err = resources.SetResourceRequirements(dep.Spec.Template.Spec.Containers, defResourceRequirements, overrides, dep.Annotations)
 			if err != nil {
 				return nil, fmt.Errorf("failed to set resource requirements: %w", err)
@@ -348,7 +359,7 @@ func getApiserverFlags(
 		"--tls-private-key-file", "/etc/kubernetes/tls/apiserver-tls.key",
 		"--proxy-client-cert-file", "/etc/kubernetes/pki/front-proxy/client/" + resources.ApiserverProxyClientCertificateCertSecretKey,
 		"--proxy-client-key-file", "/etc/kubernetes/pki/front-proxy/client/" + resources.ApiserverProxyClientCertificateKeySecretKey,
-		"--client-ca-file", "/etc/kubernetes/pki/ca/ca.crt",
+		"--client-ca-file", "/etc/kubernetes/pki/ca/ca-bundle.crt",
 		"--kubelet-client-certificate", "/etc/kubernetes/kubelet/kubelet-client.crt",
 		"--kubelet-client-key", "/etc/kubernetes/kubelet/kubelet-client.key",
 	}
@@ -360,7 +371,7 @@ func getApiserverFlags(
 	// the "bring-your-own" provider does not support automatic TLS rotation in kubelets yet,
 	// and because of that certs might expire and kube-apiserver cannot validate the connection anymore.
 	if cluster.Spec.Cloud.BringYourOwn == nil && cluster.Spec.Cloud.Edge == nil {
-		flags = append(flags, "--kubelet-certificate-authority", "/etc/kubernetes/pki/ca/ca.crt")
+		flags = append(flags, "--kubelet-certificate-authority", "/etc/kubernetes/pki/ca/ca-bundle.crt")
 	}
 
 	flags = append(flags,
@@ -631,6 +642,10 @@ func getVolumeMounts(data *resources.TemplateData, isEncryptionEnabled bool, isA
 		})
 	}
 
+	if isKMSPluginEnabled(data.Cluster()) {
+		vms = append(vms, kmsPluginSocketVolumeMount())
+	}
+
 	if isAuditWebhookEnabled {
 		vms = append(vms, corev1.VolumeMount{
 			Name:      resources.AuditWebhookVolumeName,
@@ -692,8 +707,8 @@ func getVolumes(data *resources.TemplateData, isEncryptionEnabled, isAuditEnable
 					SecretName: resources.CASecretName,
 					Items: []corev1.KeyToPath{
 						{
-							Path: resources.CACertSecretKey,
-							Key:  resources.CACertSecretKey,
+							Path: resources.CACertBundleSecretKey,
+							Key:  resources.CACertBundleSecretKey,
 						},
 					},
 				},
@@ -848,6 +863,10 @@ func getVolumes(data *resources.TemplateData, isEncryptionEnabled, isAuditEnable
 		})
 	}
 
+	if isKMSPluginEnabled(data.Cluster()) {
+		vs = append(vs, kmsPluginVolume())
+	}
+
 	if isAuditEnabled {
 		vs = append(vs, corev1.Volume{
 			Name: resources.FluentBitSecretName,
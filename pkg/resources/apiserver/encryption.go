@@ -21,6 +21,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -28,12 +29,33 @@ import (
 	"k8c.io/reconciler/pkg/reconciling"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 )
 
+const (
+	kmsPluginSidecarName    = "kms-plugin"
+	kmsPluginSocketVolume   = "kms-plugin-socket"
+	kmsPluginSocketDir      = "/var/run/kmsplugin"
+	kmsPluginSocketPath     = kmsPluginSocketDir + "/socket.sock"
+	kmsPluginSocketEnvName  = "KMS_SOCKET"
+	kmsPluginDefaultTimeout = 3 * time.Second
+)
+
+var kmsPluginDefaultResourceRequirements = corev1.ResourceRequirements{
+	Requests: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("32Mi"),
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+	},
+	Limits: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("128Mi"),
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+	},
+}
+
 type encryptionData interface {
 	Cluster() *kubermaticv1.Cluster
 	GetSecretKeyValue(ref *corev1.SecretKeySelector) ([]byte, error)
@@ -88,12 +110,29 @@ func EncryptionConfigurationSecretReconciler(data encryptionData) reconciling.Na
 
 				var providerList []apiserverconfigv1.ProviderConfiguration
 
+				// KMS always comes first if configured; a secretbox configuration next to it is
+				// only used for reading data that has not been re-encrypted yet.
+				if kms := data.Cluster().Spec.EncryptionConfiguration.KMS; kms != nil {
+					providerList = append(providerList, apiserverconfigv1.ProviderConfiguration{
+						KMS: &apiserverconfigv1.KMSConfiguration{
+							APIVersion: "v2",
+							Name:       kms.Name,
+							Endpoint:   "unix://" + kmsPluginSocketPath,
+							Timeout:    kmsPluginTimeout(kms),
+						},
+					})
+				}
+
 				if data.Cluster().Spec.EncryptionConfiguration.Secretbox != nil {
 					var existingKeys, secretboxKeys []apiserverconfigv1.Key
 
-					if len(existingConfig.Resources) == 1 && len(existingConfig.Resources[0].Providers) == 2 &&
-						existingConfig.Resources[0].Providers[0].Secretbox != nil {
-						existingKeys = existingConfig.Resources[0].Providers[0].Secretbox.Keys
+					if len(existingConfig.Resources) == 1 {
+						for _, provider := range existingConfig.Resources[0].Providers {
+							if provider.Secretbox != nil {
+								existingKeys = provider.Secretbox.Keys
+								break
+							}
+						}
 					}
 
 					for _, key := range data.Cluster().Spec.EncryptionConfiguration.Secretbox.Keys {
@@ -206,3 +245,59 @@ func getKeyByName(keys []apiserverconfigv1.Key, name string) *apiserverconfigv1.
 
 	return nil
 }
+
+func kmsPluginTimeout(kms *kubermaticv1.KMSEncryptionConfiguration) *metav1.Duration {
+	if kms.Timeout != nil {
+		return kms.Timeout
+	}
+
+	return &metav1.Duration{Duration: kmsPluginDefaultTimeout}
+}
+
+// isKMSPluginEnabled returns true if the KMS plugin sidecar needs to run next to kube-apiserver.
+// The plugin is required as long as encryption is active, even if it has been disabled in the
+// spec already, because data encrypted with KMS can only be read through the plugin.
+func isKMSPluginEnabled(cluster *kubermaticv1.Cluster) bool {
+	if !cluster.IsEncryptionEnabled() && !cluster.IsEncryptionActive() {
+		return false
+	}
+
+	return cluster.Spec.EncryptionConfiguration != nil && cluster.Spec.EncryptionConfiguration.KMS != nil
+}
+
+// kmsPluginSidecar returns the sidecar container running the user-provided KMS v2 plugin.
+func kmsPluginSidecar(kms *kubermaticv1.KMSEncryptionConfiguration) corev1.Container {
+	env := append([]corev1.EnvVar{
+		{
+			Name:  kmsPluginSocketEnvName,
+			Value: kmsPluginSocketPath,
+		},
+	}, kms.Env...)
+
+	return corev1.Container{
+		Name:    kmsPluginSidecarName,
+		Image:   kms.Image,
+		Command: kms.Command,
+		Args:    kms.Args,
+		Env:     env,
+		VolumeMounts: []corev1.VolumeMount{
+			kmsPluginSocketVolumeMount(),
+		},
+	}
+}
+
+func kmsPluginSocketVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      kmsPluginSocketVolume,
+		MountPath: kmsPluginSocketDir,
+	}
+}
+
+func kmsPluginVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: kmsPluginSocketVolume,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"errors"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"sigs.k8s.io/yaml"
+)
+
+type fakeEncryptionData struct {
+	cluster *kubermaticv1.Cluster
+}
+
+func (d *fakeEncryptionData) Cluster() *kubermaticv1.Cluster {
+	return d.cluster
+}
+
+func (d *fakeEncryptionData) GetSecretKeyValue(_ *corev1.SecretKeySelector) ([]byte, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestEncryptionConfigurationSecretReconciler(t *testing.T) {
+	secretboxConfig := &kubermaticv1.SecretboxEncryptionConfiguration{
+		Keys: []kubermaticv1.SecretboxKey{
+			{
+				Name:  "key1",
+				Value: "RGolflgAc+eBbm1lys87pTNQZVf0i67rlpPZGtTkVjQ=",
+			},
+		},
+	}
+
+	kmsConfig := &kubermaticv1.KMSEncryptionConfiguration{
+		Name:  "vault",
+		Image: "registry.example.com/vault-kms-plugin:v1.0.0",
+	}
+
+	testCases := []struct {
+		name              string
+		config            *kubermaticv1.EncryptionConfiguration
+		expectedProviders []string
+	}{
+		{
+			name: "secretbox only",
+			config: &kubermaticv1.EncryptionConfiguration{
+				Enabled:   true,
+				Resources: []string{"secrets"},
+				Secretbox: secretboxConfig,
+			},
+			expectedProviders: []string{"secretbox", "identity"},
+		},
+		{
+			name: "KMS only",
+			config: &kubermaticv1.EncryptionConfiguration{
+				Enabled:   true,
+				Resources: []string{"secrets"},
+				KMS:       kmsConfig,
+			},
+			expectedProviders: []string{"kms", "identity"},
+		},
+		{
+			name: "migrating from secretbox to KMS",
+			config: &kubermaticv1.EncryptionConfiguration{
+				Enabled:   true,
+				Resources: []string{"secrets"},
+				Secretbox: secretboxConfig,
+				KMS:       kmsConfig,
+			},
+			expectedProviders: []string{"kms", "secretbox", "identity"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := &fakeEncryptionData{
+				cluster: &kubermaticv1.Cluster{
+					Spec: kubermaticv1.ClusterSpec{
+						Features: map[string]bool{
+							kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+						},
+						EncryptionConfiguration: tc.config,
+					},
+				},
+			}
+
+			_, reconciler := EncryptionConfigurationSecretReconciler(data)()
+
+			secret, err := reconciler(&corev1.Secret{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile secret: %v", err)
+			}
+
+			config := apiserverconfigv1.EncryptionConfiguration{}
+			if err := yaml.Unmarshal(secret.Data[resources.EncryptionConfigurationKeyName], &config); err != nil {
+				t.Fatalf("Failed to parse EncryptionConfiguration: %v", err)
+			}
+
+			if len(config.Resources) != 1 {
+				t.Fatalf("Expected exactly one resource configuration, got %d", len(config.Resources))
+			}
+
+			providers := config.Resources[0].Providers
+			if len(providers) != len(tc.expectedProviders) {
+				t.Fatalf("Expected %d providers, got %d", len(tc.expectedProviders), len(providers))
+			}
+
+			for i, expected := range tc.expectedProviders {
+				provider := providers[i]
+
+				switch expected {
+				case "secretbox":
+					if provider.Secretbox == nil {
+						t.Errorf("Expected provider %d to be secretbox", i)
+					}
+				case "identity":
+					if provider.Identity == nil {
+						t.Errorf("Expected provider %d to be identity", i)
+					}
+				case "kms":
+					if provider.KMS == nil {
+						t.Fatalf("Expected provider %d to be kms", i)
+					}
+
+					if provider.KMS.APIVersion != "v2" {
+						t.Errorf("Expected KMS v2, got %q", provider.KMS.APIVersion)
+					}
+
+					if provider.KMS.Name != kmsConfig.Name {
+						t.Errorf("Expected KMS provider name %q, got %q", kmsConfig.Name, provider.KMS.Name)
+					}
+
+					if provider.KMS.Endpoint != "unix://"+kmsPluginSocketPath {
+						t.Errorf("Expected KMS endpoint to point to the plugin socket, got %q", provider.KMS.Endpoint)
+					}
+				}
+			}
+		})
+	}
+}
@@ -25,15 +25,17 @@ import (
 
 type etcdClientCertificateReconcilerData interface {
 	GetRootCA() (*triple.KeyPair, error)
+	GetRootCABundle() ([]byte, error)
 }
 
 // EtcdClientCertificateReconciler returns a function to create/update the secret with the client certificate for authenticating against etcd.
 func EtcdClientCertificateReconciler(data etcdClientCertificateReconcilerData) reconciling.NamedSecretReconcilerFactory {
-	return certificates.GetClientCertificateReconciler(
+	return certificates.GetClientCertificateWithCABundleReconciler(
 		resources.ApiserverEtcdClientCertificateSecretName,
 		"apiserver",
 		nil,
 		resources.ApiserverEtcdClientCertificateCertSecretKey,
 		resources.ApiserverEtcdClientCertificateKeySecretKey,
-		data.GetRootCA)
+		data.GetRootCA,
+		data.GetRootCABundle)
 }
@@ -29,8 +29,17 @@ import (
 
 type caGetter func() (*triple.KeyPair, error)
 
+type caBundleGetter func() ([]byte, error)
+
 // GetClientCertificateReconciler is a generic function to return a secret generator to create a client certificate signed by the cluster CA.
 func GetClientCertificateReconciler(name, commonName string, organizations []string, dataCertKey, dataKeyKey string, getCA caGetter) reconciling.NamedSecretReconcilerFactory {
+	return GetClientCertificateWithCABundleReconciler(name, commonName, organizations, dataCertKey, dataKeyKey, getCA, nil)
+}
+
+// GetClientCertificateWithCABundleReconciler is like GetClientCertificateReconciler, but includes the bundle
+// returned by getCABundle instead of only the signing CA. This allows clients to keep verifying servers whose
+// certificates have not yet been re-issued during a CA rotation.
+func GetClientCertificateWithCABundleReconciler(name, commonName string, organizations []string, dataCertKey, dataKeyKey string, getCA caGetter, getCABundle caBundleGetter) reconciling.NamedSecretReconcilerFactory {
 	return func() (string, reconciling.SecretReconciler) {
 		return name, func(se *corev1.Secret) (*corev1.Secret, error) {
 			ca, err := getCA()
@@ -38,10 +47,21 @@ func GetClientCertificateReconciler(name, commonName string, organizations []str
 				return nil, fmt.Errorf("failed to get CA: %w", err)
 			}
 
+			caCert := triple.EncodeCertPEM(ca.Cert)
+			if getCABundle != nil {
+				caCert, err = getCABundle()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get CA bundle: %w", err)
+				}
+			}
+
 			if se.Data == nil {
 				se.Data = map[string][]byte{}
 			}
 
+			// Include the CA for simplicity
+			se.Data[resources.CACertSecretKey] = caCert
+
 			if b, exists := se.Data[dataCertKey]; exists {
 				certs, err := certutil.ParseCertsPEM(b)
 				if err != nil {
@@ -60,8 +80,6 @@ func GetClientCertificateReconciler(name, commonName string, organizations []str
 
 			se.Data[dataKeyKey] = triple.EncodePrivateKeyPEM(newKP.Key)
 			se.Data[dataCertKey] = triple.EncodeCertPEM(newKP.Cert)
-			// Include the CA for simplicity
-			se.Data[resources.CACertSecretKey] = triple.EncodeCertPEM(ca.Cert)
 
 			return se, nil
 		}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+// InventoryCertificate is a single certificate found in a Secret.
+type InventoryCertificate struct {
+	// Purpose identifies the certificate by the name of its Secret and, if the Secret
+	// contains more than one certificate, the key within the Secret.
+	Purpose     string
+	Certificate *x509.Certificate
+	// Issuer is the CA from the same inventory that signed the certificate, if any.
+	Issuer *x509.Certificate
+}
+
+// Renewable returns false if renewing the certificate would not help, because it is a CA
+// (which can only be rotated), its issuer is unknown or the issuer itself expires within
+// the given threshold.
+func (c *InventoryCertificate) Renewable(now time.Time, threshold time.Duration) bool {
+	if c.Certificate.IsCA || c.Issuer == nil {
+		return false
+	}
+
+	return c.Issuer.NotAfter.Sub(now) >= threshold
+}
+
+// ExpiresWithin returns true if the certificate expires within the given threshold.
+func (c *InventoryCertificate) ExpiresWithin(now time.Time, threshold time.Duration) bool {
+	return c.Certificate.NotAfter.Sub(now) < threshold
+}
+
+// Inventory parses all certificates from the given Secrets. Certificates are read from
+// all "*.crt" keys and from the client certificate of kubeconfigs. Many Secrets contain
+// a copy of the CA certificate next to their leaf certificate; these copies are ignored,
+// so a CA is only reported for the Secret it originates from. For bundles, only the
+// first certificate is considered. Secrets with invalid data are skipped.
+func Inventory(secrets []corev1.Secret) []InventoryCertificate {
+	var inventory []InventoryCertificate
+
+	for _, secret := range secrets {
+		certs := secretCertificates(&secret)
+
+		// the trust bundle of the root CA secret starts with the CA itself
+		if _, hasCA := certs[resources.CACertSecretKey]; hasCA {
+			delete(certs, resources.CACertBundleSecretKey)
+		}
+
+		// drop the CA copy if the Secret contains any other certificate
+		if _, hasCA := certs[resources.CACertSecretKey]; hasCA && len(certs) > 1 {
+			delete(certs, resources.CACertSecretKey)
+		}
+
+		for key, cert := range certs {
+			purpose := secret.Name
+			if len(certs) > 1 {
+				purpose = fmt.Sprintf("%s/%s", secret.Name, key)
+			}
+
+			inventory = append(inventory, InventoryCertificate{
+				Purpose:     purpose,
+				Certificate: cert,
+			})
+		}
+	}
+
+	sort.Slice(inventory, func(i, j int) bool {
+		return inventory[i].Purpose < inventory[j].Purpose
+	})
+
+	for i, entry := range inventory {
+		for _, candidate := range inventory {
+			if candidate.Certificate.IsCA && !candidate.Certificate.Equal(entry.Certificate) && entry.Certificate.CheckSignatureFrom(candidate.Certificate) == nil {
+				inventory[i].Issuer = candidate.Certificate
+				break
+			}
+		}
+	}
+
+	return inventory
+}
+
+func secretCertificates(secret *corev1.Secret) map[string]*x509.Certificate {
+	result := map[string]*x509.Certificate{}
+
+	for key, value := range secret.Data {
+		var certPEM []byte
+
+		switch {
+		case strings.HasSuffix(key, ".crt"):
+			certPEM = value
+
+		case key == resources.KubeconfigSecretKey:
+			config, err := clientcmd.Load(value)
+			if err != nil {
+				continue
+			}
+
+			for _, authInfo := range config.AuthInfos {
+				if len(authInfo.ClientCertificateData) > 0 {
+					certPEM = authInfo.ClientCertificateData
+					break
+				}
+			}
+
+		default:
+			continue
+		}
+
+		certs, err := certutil.ParseCertsPEM(certPEM)
+		if err != nil || len(certs) == 0 {
+			continue
+		}
+
+		result[key] = certs[0]
+	}
+
+	return result
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"testing"
+	"time"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestInventory(t *testing.T) {
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	client, err := triple.NewClientKeyPair(ca, "client", nil)
+	if err != nil {
+		t.Fatalf("Failed to create client certificate: %v", err)
+	}
+
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.AuthInfos["default"] = &clientcmdapi.AuthInfo{
+		ClientCertificateData: triple.EncodeCertPEM(client.Cert),
+	}
+
+	kubeconfigData, err := clientcmd.Write(*kubeconfig)
+	if err != nil {
+		t.Fatalf("Failed to encode kubeconfig: %v", err)
+	}
+
+	secrets := []corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.CASecretName},
+			Data: map[string][]byte{
+				resources.CACertSecretKey:       triple.EncodeCertPEM(ca.Cert),
+				resources.CACertBundleSecretKey: triple.EncodeCertPEM(ca.Cert),
+				resources.CAKeySecretKey:        triple.EncodePrivateKeyPEM(ca.Key),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "client-cert"},
+			Data: map[string][]byte{
+				"client.crt":              triple.EncodeCertPEM(client.Cert),
+				resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "two-certs"},
+			Data: map[string][]byte{
+				"first.crt":  triple.EncodeCertPEM(client.Cert),
+				"second.crt": triple.EncodeCertPEM(client.Cert),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig"},
+			Data: map[string][]byte{
+				resources.KubeconfigSecretKey: kubeconfigData,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken"},
+			Data: map[string][]byte{
+				"broken.crt": []byte("not a certificate"),
+			},
+		},
+	}
+
+	inventory := Inventory(secrets)
+
+	expected := []string{resources.CASecretName, "client-cert", "kubeconfig", "two-certs/first.crt", "two-certs/second.crt"}
+	if len(inventory) != len(expected) {
+		t.Fatalf("Expected %d certificates, got %d: %v", len(expected), len(inventory), inventory)
+	}
+
+	now := time.Now()
+
+	for i, purpose := range expected {
+		entry := inventory[i]
+
+		if entry.Purpose != purpose {
+			t.Fatalf("Expected certificate %d to be %q, got %q", i, purpose, entry.Purpose)
+		}
+
+		if purpose == resources.CASecretName {
+			if entry.Issuer != nil {
+				t.Error("Self-signed CA should not have an issuer")
+			}
+			if entry.Renewable(now, time.Hour) {
+				t.Error("CA certificate should not be renewable")
+			}
+			continue
+		}
+
+		if entry.Issuer == nil || !entry.Issuer.Equal(ca.Cert) {
+			t.Errorf("Expected %q to be issued by the CA", purpose)
+		}
+
+		if !entry.Renewable(now, time.Hour) {
+			t.Errorf("Expected %q to be renewable", purpose)
+		}
+
+		if entry.Renewable(now, 100*365*24*time.Hour) {
+			t.Errorf("Expected %q not to be renewable once its CA expires within the threshold", purpose)
+		}
+	}
+}
@@ -67,10 +67,25 @@ type caReconcilerData interface {
 	Cluster() *kubermaticv1.Cluster
 }
 
-// RootCAReconciler returns a function to create a secret with the root ca.
+// RootCAReconciler returns a function to create a secret with the root ca. Next to the CA,
+// the secret contains the bundle of all CAs trusted by the cluster. The bundle is only
+// initialized here; during a CA rotation, it is managed by the ca-rotation-controller.
 func RootCAReconciler(data caReconcilerData) reconciling.NamedSecretReconcilerFactory {
 	return func() (string, reconciling.SecretReconciler) {
-		return resources.CASecretName, GetCAReconciler(fmt.Sprintf("root-ca.%s", data.Cluster().Status.Address.ExternalName))
+		caReconciler := GetCAReconciler(fmt.Sprintf("root-ca.%s", data.Cluster().Status.Address.ExternalName))
+
+		return resources.CASecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			se, err := caReconciler(se)
+			if err != nil {
+				return se, err
+			}
+
+			if _, exists := se.Data[resources.CACertBundleSecretKey]; !exists {
+				se.Data[resources.CACertBundleSecretKey] = se.Data[resources.CACertSecretKey]
+			}
+
+			return se, nil
+		}
 	}
 }
 
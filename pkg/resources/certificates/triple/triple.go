@@ -36,8 +36,9 @@ import (
 )
 
 const (
-	rsaKeySize             = 2048
-	duration365d           = time.Hour * 24 * 365
+	rsaKeySize = 2048
+	// CertificateValidity is the validity of the certificates created by this package.
+	CertificateValidity    = time.Hour * 24 * 365
 	certificateBlockType   = "CERTIFICATE"
 	RSAPrivateKeyBlockType = "RSA PRIVATE KEY"
 	// ECPrivateKeyBlockType is a possible value for pem.Block.Type.
@@ -161,7 +162,7 @@ func newSignedCert(cfg certutil.Config, key crypto.Signer, caCert *x509.Certific
 		IPAddresses:  cfg.AltNames.IPs,
 		SerialNumber: serial,
 		NotBefore:    caCert.NotBefore,
-		NotAfter:     time.Now().Add(duration365d).UTC(),
+		NotAfter:     time.Now().Add(CertificateValidity).UTC(),
 		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:  cfg.Usages,
 	}
@@ -204,6 +205,15 @@ func EncodeCertPEM(cert *x509.Certificate) []byte {
 	return pem.EncodeToMemory(&block)
 }
 
+// EncodeCertBundlePEM returns the PEM-encoded data of all given certificates, in order.
+func EncodeCertBundlePEM(certs []*x509.Certificate) []byte {
+	var bundle []byte
+	for _, cert := range certs {
+		bundle = append(bundle, EncodeCertPEM(cert)...)
+	}
+	return bundle
+}
+
 // EncodePrivateKeyPEM returns PEM-encoded private key data.
 func EncodePrivateKeyPEM(key *rsa.PrivateKey) []byte {
 	block := pem.Block{
@@ -307,7 +317,7 @@ func NewSignedCert(cfg certutil.Config, key crypto.Signer, caCert *x509.Certific
 		IPAddresses:  cfg.AltNames.IPs,
 		SerialNumber: serial,
 		NotBefore:    caCert.NotBefore,
-		NotAfter:     time.Now().Add(duration365d).UTC(),
+		NotAfter:     time.Now().Add(CertificateValidity).UTC(),
 		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:  cfg.Usages,
 	}
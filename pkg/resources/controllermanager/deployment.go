@@ -218,7 +218,8 @@ func getFlags(data *resources.TemplateData, version *semverlib.Version) ([]strin
 	flags := []string{
 		"--kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
 		"--service-account-private-key-file", "/etc/kubernetes/service-account-key/sa.key",
-		"--root-ca-file", "/etc/kubernetes/pki/ca/ca.crt",
+		"--root-ca-file", "/etc/kubernetes/pki/ca/ca-bundle.crt",
+		// the signer only accepts a single certificate, so it must not use the bundle
 		"--cluster-signing-cert-file", "/etc/kubernetes/pki/ca/ca.crt",
 		"--cluster-signing-key-file", "/etc/kubernetes/pki/ca/ca.key",
 		"--controllers", strings.Join(controllers, ","),
@@ -282,7 +283,7 @@ func getFlags(data *resources.TemplateData, version *semverlib.Version) ([]strin
 	// New flag in v1.12 which gets used to perform permission checks for tokens
 	flags = append(flags, "--authentication-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig")
 	// New flag in v1.12 which gets used to perform permission checks for certs
-	flags = append(flags, "--client-ca-file", "/etc/kubernetes/pki/ca/ca.crt")
+	flags = append(flags, "--client-ca-file", "/etc/kubernetes/pki/ca/ca-bundle.crt")
 
 	// With 1.13 we're using the secure port for scraping metrics as the insecure port got marked deprecated
 	flags = append(flags, "--authentication-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig")
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllermanager
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"path"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	httpproberapi "k8c.io/kubermatic/v2/cmd/http-prober/api"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+// mountedFile resolves a file path in the controller manager container to the data it
+// would contain if the given Secret was mounted. It returns nil if the file is not
+// backed by the Secret.
+func mountedFile(t *testing.T, dep *appsv1.Deployment, secret *corev1.Secret, file string) []byte {
+	t.Helper()
+
+	container := dep.Spec.Template.Spec.Containers[0]
+
+	for _, mount := range container.VolumeMounts {
+		if path.Dir(file) != mount.MountPath {
+			continue
+		}
+
+		for _, volume := range dep.Spec.Template.Spec.Volumes {
+			if volume.Name != mount.Name || volume.Secret == nil || volume.Secret.SecretName != secret.Name {
+				continue
+			}
+
+			filename := path.Base(file)
+			if len(volume.Secret.Items) == 0 {
+				return secret.Data[filename]
+			}
+
+			for _, item := range volume.Secret.Items {
+				if item.Path == filename {
+					return secret.Data[item.Key]
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func flagValue(args []string, flag string) string {
+	for i, arg := range args {
+		if arg == flag && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+
+	return ""
+}
+
+func TestDeploymentDuringCARotation(t *testing.T) {
+	oldCA, err := triple.NewCA("old-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	newCA, err := triple.NewCA("new-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	// the CA secret after the new CA has been promoted, while the old CA is still trusted
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: resources.CASecretName,
+		},
+		Data: map[string][]byte{
+			resources.CACertSecretKey:       triple.EncodeCertPEM(newCA.Cert),
+			resources.CAKeySecretKey:        triple.EncodePrivateKeyPEM(newCA.Key),
+			resources.CACertBundleSecretKey: triple.EncodeCertBundlePEM([]*x509.Certificate{newCA.Cert, oldCA.Cert}),
+		},
+	}
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "testcluster",
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				BringYourOwn: &kubermaticv1.BringYourOwnCloudSpec{},
+			},
+			CNIPlugin: &kubermaticv1.CNIPluginSettings{
+				Type: kubermaticv1.CNIPluginTypeCanal,
+			},
+			ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+				Pods:     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"172.25.0.0/16"}},
+				Services: kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.16.0/20"}},
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-testcluster",
+			Versions: kubermaticv1.ClusterVersionsStatus{
+				ControllerManager: *semver.NewSemverOrDie("1.32.0"),
+			},
+		},
+	}
+
+	data := resources.NewTemplateDataBuilder().
+		WithContext(context.Background()).
+		WithCluster(cluster).
+		WithDatacenter(&kubermaticv1.Datacenter{}).
+		WithSeed(&kubermaticv1.Seed{}).
+		WithKubermaticConfiguration(&kubermaticv1.KubermaticConfiguration{}).
+		WithVersions(kubermatic.GetFakeVersions()).
+		WithKonnectivityEnabled(true).
+		Build()
+
+	_, reconciler := DeploymentReconciler(data)()
+
+	dep, err := reconciler(&appsv1.Deployment{})
+	if err != nil {
+		t.Fatalf("Failed to render Deployment: %v", err)
+	}
+
+	// the container is wrapped by the http-prober, which receives the original command as JSON
+	command := httpproberapi.Command{}
+	if err := json.Unmarshal([]byte(flagValue(dep.Spec.Template.Spec.Containers[0].Args, "-command")), &command); err != nil {
+		t.Fatalf("Failed to parse wrapped command: %v", err)
+	}
+	args := command.Args
+
+	// the signer only accepts a file with exactly one certificate, which must belong to the signing key
+	signingCerts, err := certutil.ParseCertsPEM(mountedFile(t, dep, caSecret, flagValue(args, "--cluster-signing-cert-file")))
+	if err != nil {
+		t.Fatalf("Failed to parse signing certificate: %v", err)
+	}
+
+	if len(signingCerts) != 1 {
+		t.Fatalf("Expected exactly one signing certificate, got %d", len(signingCerts))
+	}
+
+	signingKey, err := triple.ParsePrivateKeyPEM(mountedFile(t, dep, caSecret, flagValue(args, "--cluster-signing-key-file")))
+	if err != nil {
+		t.Fatalf("Failed to parse signing key: %v", err)
+	}
+
+	if !signingKey.(*rsa.PrivateKey).PublicKey.Equal(signingCerts[0].PublicKey) {
+		t.Error("Signing key does not belong to the signing certificate")
+	}
+
+	// trust consumers must see both CAs
+	for _, flag := range []string{"--root-ca-file", "--client-ca-file"} {
+		certs, err := certutil.ParseCertsPEM(mountedFile(t, dep, caSecret, flagValue(args, flag)))
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v", flag, err)
+		}
+
+		if len(certs) != 2 || !certs[0].Equal(newCA.Cert) || !certs[1].Equal(oldCA.Cert) {
+			t.Errorf("Expected %s to contain both CAs, got %d certificates", flag, len(certs))
+		}
+	}
+}
@@ -110,6 +110,7 @@ type TemplateData struct {
 	etcdLauncherImage         string
 	etcdBackupStoreContainer  *corev1.Container
 	etcdBackupDeleteContainer *corev1.Container
+	etcdBackupObjectName      string
 	etcdBackupDestination     *kubermaticv1.BackupDestination
 }
 
@@ -228,6 +229,11 @@ func (td *TemplateDataBuilder) WithEtcdBackupDeleteContainer(container *corev1.C
 	return td
 }
 
+func (td *TemplateDataBuilder) WithEtcdBackupObjectName(name string) *TemplateDataBuilder {
+	td.data.etcdBackupObjectName = name
+	return td
+}
+
 func (td *TemplateDataBuilder) WithEtcdBackupDestination(destination *kubermaticv1.BackupDestination) *TemplateDataBuilder {
 	td.data.etcdBackupDestination = destination
 	return td
@@ -365,6 +371,10 @@ func (d *TemplateData) EtcdBackupDeleteContainer() *corev1.Container {
 	return d.etcdBackupDeleteContainer
 }
 
+func (d *TemplateData) EtcdBackupObjectName() string {
+	return d.etcdBackupObjectName
+}
+
 func (d *TemplateData) EtcdBackupDestination() *kubermaticv1.BackupDestination {
 	return d.etcdBackupDestination
 }
@@ -513,6 +523,11 @@ func (d *TemplateData) GetRootCA() (*triple.KeyPair, error) {
 	return GetClusterRootCA(d.ctx, d.cluster.Status.NamespaceName, d.client)
 }
 
+// GetRootCABundle returns the PEM-encoded bundle of all CAs trusted by the cluster.
+func (d *TemplateData) GetRootCABundle() ([]byte, error) {
+	return GetClusterRootCABundle(d.ctx, d.cluster.Status.NamespaceName, d.client)
+}
+
 // GetFrontProxyCA returns the root CA for the front proxy.
 func (d *TemplateData) GetFrontProxyCA() (*triple.KeyPair, error) {
 	return GetClusterFrontProxyCA(d.ctx, d.cluster.Status.NamespaceName, d.client)
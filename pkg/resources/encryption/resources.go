@@ -21,5 +21,6 @@ const (
 	ApiserverEncryptionHashLabelKey     = "kubermatic.k8c.io/encryption-spec-hash"
 
 	SecretboxPrefix = "secretbox"
+	KMSPrefix       = "kms"
 	IdentityKey     = "identity"
 )
@@ -63,6 +63,9 @@ const (
 	// BackupInsecureEnvVarKey defines the environment variable key for a boolean that tells whether the
 	// configured endpoint uses HTTPS ("false") or HTTP ("true").
 	BackupInsecureEnvVarKey = "INSECURE"
+	// EncryptionKeyEnvVarKey defines the environment variable key for the key used to encrypt
+	// and decrypt snapshots, if encryption is configured for the backup destination.
+	EncryptionKeyEnvVarKey = "ENCRYPTION_KEY"
 )
 
 type etcdBackupData interface {
@@ -70,6 +73,7 @@ type etcdBackupData interface {
 	EtcdBackupDestination() *kubermaticv1.BackupDestination
 	EtcdBackupStoreContainer() *corev1.Container
 	EtcdBackupDeleteContainer() *corev1.Container
+	EtcdBackupObjectName() string
 	EtcdLauncherImage() string
 	EtcdLauncherTag() string
 	GetClusterRef() metav1.OwnerReference
@@ -131,6 +135,12 @@ func BackupJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig, statu
 		ReadOnly:  true,
 	})
 
+	// snapshots are encrypted by the backup-creator, so the store container never sees the plain snapshot
+	var creatorEnv []corev1.EnvVar
+	if destination := data.EtcdBackupDestination(); destination != nil && destination.Encryption != nil {
+		creatorEnv = append(creatorEnv, GenEncryptionKeyEnvVar(destination))
+	}
+
 	job := jobBase(config, data.Cluster(), status.JobName)
 
 	job.Spec.Template.Spec.ServiceAccountName = fmt.Sprintf("%s-%s", rbac.EtcdLauncherServiceAccountName, data.Cluster().Name)
@@ -140,6 +150,7 @@ func BackupJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig, statu
 			Name:    "backup-creator",
 			Image:   fmt.Sprintf("%s:%s", data.EtcdLauncherImage(), data.EtcdLauncherTag()),
 			Command: snapshotCommand(data.Cluster()),
+			Env:     creatorEnv,
 			VolumeMounts: []corev1.VolumeMount{
 				{
 					Name:      SharedVolumeName,
@@ -286,6 +297,111 @@ func BackupDeleteJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig,
 	return job
 }
 
+// BackupVerifyJob returns a job that downloads the given backup and verifies it by
+// restoring it into a temporary etcd data directory. The job writes the verification
+// result as JSON into its termination message.
+func BackupVerifyJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig, status *kubermaticv1.BackupStatus) *batchv1.Job {
+	// the object name can reference these variables, like the store container does
+	env := []corev1.EnvVar{
+		{
+			Name:  clusterEnvVarKey,
+			Value: data.Cluster().Name,
+		},
+		{
+			Name:  BackupToCreateEnvVarKey,
+			Value: status.BackupName,
+		},
+	}
+
+	if data.EtcdBackupDestination() != nil {
+		env = append(env,
+			GenSecretEnvVar(AccessKeyIDEnvVarKey, AccessKeyIDEnvVarKey, data.EtcdBackupDestination()),
+			GenSecretEnvVar(SecretAccessKeyEnvVarKey, SecretAccessKeyEnvVarKey, data.EtcdBackupDestination()),
+			corev1.EnvVar{
+				Name:  BucketNameEnvVarKey,
+				Value: data.EtcdBackupDestination().BucketName,
+			},
+			corev1.EnvVar{
+				Name:  BackupEndpointEnvVarKey,
+				Value: data.EtcdBackupDestination().Endpoint,
+			},
+		)
+
+		insecure := "false"
+		if isInsecureURL(data.EtcdBackupDestination().Endpoint) {
+			insecure = "true"
+		}
+
+		env = append(env, corev1.EnvVar{
+			Name:  BackupInsecureEnvVarKey,
+			Value: insecure,
+		})
+
+		if data.EtcdBackupDestination().Encryption != nil {
+			env = append(env, GenEncryptionKeyEnvVar(data.EtcdBackupDestination()))
+		}
+	}
+
+	job := jobBase(config, data.Cluster(), status.VerifyJobName)
+	// downloading and restoring large snapshots can take a while
+	job.Spec.ActiveDeadlineSeconds = resources.Int64(10 * 60)
+	job.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name:    "backup-verifier",
+			Image:   fmt.Sprintf("%s:%s", data.EtcdLauncherImage(), data.EtcdLauncherTag()),
+			Command: verifyCommand(data.Cluster(), data.EtcdBackupObjectName()),
+			Env:     env,
+			// the verification result is read by the etcd backup controller
+			TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      SharedVolumeName,
+					MountPath: "/backup",
+				},
+				{
+					Name:      "ca-bundle",
+					MountPath: "/etc/ca-bundle/",
+					ReadOnly:  true,
+				},
+			},
+		},
+	}
+	job.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: SharedVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+		{
+			Name: "ca-bundle",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: resources.BackupCABundleConfigMapName(data.Cluster()),
+					},
+				},
+			},
+		},
+	}
+
+	return job
+}
+
+func verifyCommand(cluster *kubermaticv1.Cluster, objectName string) []string {
+	return []string{
+		"/etcd-launcher",
+		"verify-snapshot",
+		fmt.Sprintf("--cluster=%s", cluster.Name),
+		// variables in the object name are expanded by Kubernetes
+		fmt.Sprintf("--object=%s", objectName),
+		"--work-dir=/backup",
+		"--ca-bundle=/etc/ca-bundle/ca-bundle.pem",
+		fmt.Sprintf("--result-file=%s", corev1.TerminationMessagePathDefault),
+	}
+}
+
 func jobBase(backupConfig *kubermaticv1.EtcdBackupConfig, cluster *kubermaticv1.Cluster, jobName string) *batchv1.Job {
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
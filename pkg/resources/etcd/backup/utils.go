@@ -38,6 +38,17 @@ func GenSecretEnvVar(name, key string, destination *kubermaticv1.BackupDestinati
 	}
 }
 
+// GenEncryptionKeyEnvVar returns the environment variable holding the snapshot encryption
+// key of the given destination. The destination must have encryption configured.
+func GenEncryptionKeyEnvVar(destination *kubermaticv1.BackupDestination) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: EncryptionKeyEnvVarKey,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: destination.Encryption.KeySecret.DeepCopy(),
+		},
+	}
+}
+
 func GetEtcdBackupSecretName(cluster *kubermaticv1.Cluster) string {
 	return fmt.Sprintf("cluster-%s-etcd-client-certificate", cluster.Name)
 }
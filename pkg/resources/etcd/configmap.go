@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// JobOutcomesConfigMapReconciler returns a func to create the ConfigMap in which the etcd defrag and snapshot jobs
+// record their outcome. The ConfigMap is created up front, so the etcd-launcher only needs permission to update it;
+// its data is owned by the etcd-launcher and must not be touched.
+func JobOutcomesConfigMapReconciler() reconciling.NamedConfigMapReconcilerFactory {
+	return func() (string, reconciling.ConfigMapReconciler) {
+		return resources.EtcdLauncherJobOutcomesConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			return cm, nil
+		}
+	}
+}
@@ -216,6 +216,10 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 					ContainerPort: 2381,
 					Protocol:      corev1.ProtocolTCP,
 					Name:          "peer-tls",
+				}, corev1.ContainerPort{
+					ContainerPort: resources.EtcdLauncherMetricsPort,
+					Protocol:      corev1.ProtocolTCP,
+					Name:          "launcher-metrics",
 				})
 
 				kubernetes.EnsureAnnotations(&set.Spec.Template, map[string]string{
@@ -316,6 +320,18 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 				},
 			}
 
+			// the etcd-launcher only reports members as ready once they are voting members, so
+			// that the cluster is not scaled any further while a new member is still a learner
+			if launcherEnabled {
+				set.Spec.Template.Spec.Containers[0].ReadinessProbe.ProbeHandler = corev1.ProbeHandler{
+					HTTPGet: &corev1.HTTPGetAction{
+						Path:   "/ready",
+						Port:   intstr.FromInt(resources.EtcdLauncherMetricsPort),
+						Scheme: corev1.URISchemeHTTP,
+					},
+				}
+			}
+
 			set.Spec.Template.Spec.Tolerations = data.Cluster().Spec.ComponentsOverride.Etcd.Tolerations
 
 			err = resources.SetResourceRequirements(set.Spec.Template.Spec.Containers, defaultResourceRequirements, resources.GetOverrides(data.Cluster().Spec.ComponentsOverride), set.Annotations)
@@ -385,8 +401,8 @@ func getVolumes() []corev1.Volume {
 					SecretName: resources.CASecretName,
 					Items: []corev1.KeyToPath{
 						{
-							Path: resources.CACertSecretKey,
-							Key:  resources.CACertSecretKey,
+							Path: resources.CACertBundleSecretKey,
+							Key:  resources.CACertBundleSecretKey,
 						},
 					},
 				},
@@ -446,7 +462,7 @@ func computeReplicas(data etcdStatefulSetReconcilerData, set *appsv1.StatefulSet
 	if !data.Cluster().Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
 		return kubermaticv1.DefaultEtcdClusterSize
 	}
-	etcdClusterSize := getClusterSize(data.Cluster().Spec.ComponentsOverride.Etcd)
+	etcdClusterSize := GetClusterSize(data.Cluster().Spec.ComponentsOverride.Etcd)
 	if set.Spec.Replicas == nil { // new replicaset
 		return etcdClusterSize
 	}
@@ -465,7 +481,8 @@ func computeReplicas(data etcdStatefulSetReconcilerData, set *appsv1.StatefulSet
 	return replicas
 }
 
-func getClusterSize(settings kubermaticv1.EtcdStatefulSetSettings) int32 {
+// GetClusterSize returns the configured number of etcd members, within the supported bounds.
+func GetClusterSize(settings kubermaticv1.EtcdStatefulSetSettings) int32 {
 	if settings.ClusterSize == nil {
 		return kubermaticv1.DefaultEtcdClusterSize
 	}
@@ -525,7 +542,7 @@ func getEtcdCommand(cluster *kubermaticv1.Cluster, enableCorruptionCheck, launch
 		"--initial-advertise-peer-urls",
 		fmt.Sprintf("http://$(POD_NAME).%s.%s.svc.cluster.local:2380", resources.EtcdServiceName, cluster.Status.NamespaceName),
 		"--trusted-ca-file",
-		"/etc/etcd/pki/ca/ca.crt",
+		"/etc/etcd/pki/ca/ca-bundle.crt",
 		"--client-cert-auth",
 		"--cert-file",
 		"/etc/etcd/pki/tls/etcd-tls.crt",
@@ -75,7 +75,7 @@ Y1OSU+4JRXF62VQY
 	assert.NotNil(t, caCert)
 	assert.NoError(t, err)
 
-	c := GetBaseKubeconfig(caCert, "example.com", clusterName)
+	c := GetBaseKubeconfig(triple.EncodeCertPEM(caCert), "example.com", clusterName)
 	assert.NotNil(t, c)
 
 	assert.Len(t, c.Clusters, 1)
@@ -106,6 +106,10 @@ func (fake *fakeDataProvider) GetFrontProxyCA() (*triple.KeyPair, error) { retur
 
 func (fake *fakeDataProvider) GetRootCA() (*triple.KeyPair, error) { return fake.caPair, nil }
 
+func (fake *fakeDataProvider) GetRootCABundle() ([]byte, error) {
+	return triple.EncodeCertPEM(fake.caPair.Cert), nil
+}
+
 func (fake *fakeDataProvider) GetOpenVPNCA() (*ECDSAKeyPair, error) { return &ECDSAKeyPair{}, nil }
 
 func (fake *fakeDataProvider) InClusterApiserverAddress() (string, error) { return "", nil }
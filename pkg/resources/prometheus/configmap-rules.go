@@ -146,6 +146,24 @@ groups:
     labels:
       severity: warning
 
+  - alert: EtcdMembershipFlapping
+    annotations:
+      message: 'Etcd cluster "{{ $labels.job }}": etcd-launcher on instance {{ $labels.instance }} has changed the cluster membership {{ $value }} times within the last hour.'
+    expr: |
+      sum(increase(etcd_launcher_member_joins_total[1h])) by (job, instance) > 3
+      or
+      sum(increase(etcd_launcher_member_removals_total[1h])) by (job, instance) > 3
+    labels:
+      severity: warning
+
+  - alert: EtcdLauncherJobFailed
+    annotations:
+      message: 'Etcd cluster "{{ $labels.job }}": the last {{ $labels.operation }} run has failed.'
+    expr: |
+      max(etcd_launcher_job_last_run_success) by (job, operation) == 0
+    labels:
+      severity: warning
+
   - record: job:etcd_server_has_leader:sum
     expr: sum(etcd_server_has_leader)
     labels:
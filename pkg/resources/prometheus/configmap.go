@@ -51,11 +51,12 @@ type CustomizationData struct {
 }
 
 type configTemplateData struct {
-	TemplateData          interface{}
-	APIServerHost         string
-	EtcdTLSConfig         string
-	ApiserverTLSConfig    string
-	CustomScrapingConfigs string
+	TemplateData            interface{}
+	APIServerHost           string
+	EtcdTLSConfig           string
+	ApiserverTLSConfig      string
+	CustomScrapingConfigs   string
+	EtcdLauncherMetricsPort int
 	// ScrapingAnnotationPrefix is normalized to fit into a Prometheus rewrite rule.
 	ScrapingAnnotationPrefix string
 }
@@ -120,6 +121,7 @@ func ConfigMapReconciler(data *resources.TemplateData) reconciling.NamedConfigMa
 				CustomScrapingConfigs:    customScrapingConfigs,
 				EtcdTLSConfig:            strings.TrimSpace(string(etcdTLSYaml)),
 				ApiserverTLSConfig:       strings.TrimSpace(string(apiserverTLSYaml)),
+				EtcdLauncherMetricsPort:  resources.EtcdLauncherMetricsPort,
 				ScrapingAnnotationPrefix: scrapeAnnotationPrefix,
 			}
 
@@ -227,6 +229,24 @@ scrape_configs:
     replacement: $1
     target_label: instance
 
+{{- if index .TemplateData.Cluster.Spec.Features "etcdLauncher" }}
+
+# scrape the etcd-launchers running next to the etcd members
+- job_name: etcd-launcher
+  static_configs:
+  - targets:
+    - 'etcd-0.etcd.{{ .TemplateData.Cluster.Status.NamespaceName }}.svc.cluster.local:{{ .EtcdLauncherMetricsPort }}'
+    - 'etcd-1.etcd.{{ .TemplateData.Cluster.Status.NamespaceName }}.svc.cluster.local:{{ .EtcdLauncherMetricsPort }}'
+    - 'etcd-2.etcd.{{ .TemplateData.Cluster.Status.NamespaceName }}.svc.cluster.local:{{ .EtcdLauncherMetricsPort }}'
+
+  relabel_configs:
+  - source_labels: [__address__]
+    regex: (etcd-\d+).+
+    action: replace
+    replacement: $1
+    target_label: instance
+{{- end }}
+
 # scrape the cluster's control plane (apiserver, controller-manager, scheduler)
 - job_name: kubernetes-control-plane
   scheme: https
@@ -35,6 +35,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/util/backupencryption"
 	"k8c.io/kubermatic/v2/pkg/util/s3"
 	"k8c.io/reconciler/pkg/reconciling"
 
@@ -252,6 +253,8 @@ const (
 	PrometheusConfigConfigMapName = "prometheus"
 	// AuditConfigMapName is the name for the configmap that contains the content of the file that will be passed to the apiserver with the flag "--audit-policy-file".
 	AuditConfigMapName = "audit-config"
+	// EtcdLauncherJobOutcomesConfigMapName is the name for the configmap in which the etcd defrag and snapshot jobs record their outcome.
+	EtcdLauncherJobOutcomesConfigMapName = "etcd-launcher-job-outcomes"
 
 	// FluentBitSecretName is the name of the secret that contains the fluent-bit configuration mounted
 	// into kube-apisever and used by the "audit-logs" sidecar to ship audit logs.
@@ -528,6 +531,10 @@ const (
 	CAKeySecretKey = "ca.key"
 	// CACertSecretKey ca.crt.
 	CACertSecretKey = "ca.crt"
+	// CACertBundleSecretKey ca-bundle.crt holds all CAs trusted by the cluster. Outside of a CA rotation, it is equal to ca.crt.
+	CACertBundleSecretKey = "ca-bundle.crt"
+	// CANextKeySecretKey next-ca.key holds the key of a CA that is being rotated in, but is not yet used for signing.
+	CANextKeySecretKey = "next-ca.key"
 	// ApiserverTLSKeySecretKey apiserver-tls.key.
 	ApiserverTLSKeySecretKey = "apiserver-tls.key"
 	// ApiserverTLSCertSecretKey apiserver-tls.crt.
@@ -571,6 +578,9 @@ const (
 	EtcdRestoreS3BucketNameKey    = "BUCKET_NAME"
 	EtcdRestoreS3EndpointKey      = "ENDPOINT"
 	EtcdRestoreDefaultS3SEndpoint = "s3.amazonaws.com"
+	// EtcdRestoreEncryptionKeyKey is the key in the backup download Secret that holds the
+	// key to decrypt the snapshot, if the backup destination has encryption configured.
+	EtcdRestoreEncryptionKeyKey = "ENCRYPTION_KEY"
 
 	// ApiserverEtcdClientCertificateCertSecretKey apiserver-etcd-client.crt.
 	ApiserverEtcdClientCertificateCertSecretKey = "apiserver-etcd-client.crt"
@@ -616,9 +626,18 @@ const (
 )
 
 const (
-	minimumCertValidity30d = 30 * 24 * time.Hour
+	// DefaultCertificateRenewalThreshold is the remaining validity below which certificates
+	// are renewed if the KubermaticConfiguration does not specify a threshold.
+	DefaultCertificateRenewalThreshold = 30 * 24 * time.Hour
+	// MaximumCertificateRenewalThreshold is the largest configurable renewal threshold. It leaves
+	// at least 30 days between two renewals of a freshly issued certificate.
+	MaximumCertificateRenewalThreshold = triple.CertificateValidity - 30*24*time.Hour
 )
 
+// certificateRenewalThreshold is shared by all certificate reconcilers in a process, so that
+// it does not need to be passed through every single one of them.
+var certificateRenewalThreshold = DefaultCertificateRenewalThreshold
+
 const (
 	ExternalClusterKubeconfigPrefix = "kubeconfig-external-cluster"
 	// KubeOneNamespacePrefix is the kubeone namespace prefix.
@@ -864,7 +883,7 @@ const (
 )
 
 const (
-	EtcdTrustedCAFile = "/etc/etcd/pki/ca/ca.crt"
+	EtcdTrustedCAFile = "/etc/etcd/pki/ca/ca-bundle.crt"
 	EtcdCertFile      = "/etc/etcd/pki/tls/etcd-tls.crt"
 	EtcdKeyFile       = "/etc/etcd/pki/tls/etcd-tls.key"
 
@@ -873,6 +892,9 @@ const (
 
 	EtcdClientCertFile = "/etc/etcd/pki/client/apiserver-etcd-client.crt"
 	EtcdClientKeyFile  = "/etc/etcd/pki/client/apiserver-etcd-client.key"
+
+	// EtcdLauncherMetricsPort is the port on which the etcd-launcher serves its Prometheus metrics.
+	EtcdLauncherMetricsPort = 2377
 )
 
 const (
@@ -1245,9 +1267,25 @@ func BaseAppLabels(appName string, additionalLabels map[string]string) map[strin
 	return labels
 }
 
-// CertWillExpireSoon returns if the certificate will expire in the next 30 days.
+// SetCertificateRenewalThreshold configures the remaining validity below which certificates
+// are renewed. It must be called before any controller is started. A non-positive duration
+// restores the default threshold.
+func SetCertificateRenewalThreshold(threshold time.Duration) {
+	if threshold <= 0 {
+		threshold = DefaultCertificateRenewalThreshold
+	}
+
+	certificateRenewalThreshold = threshold
+}
+
+// CertificateRenewalThreshold returns the remaining validity below which certificates are renewed.
+func CertificateRenewalThreshold() time.Duration {
+	return certificateRenewalThreshold
+}
+
+// CertWillExpireSoon returns if the certificate will expire within the certificate renewal threshold.
 func CertWillExpireSoon(cert *x509.Certificate) bool {
-	return time.Until(cert.NotAfter) < minimumCertValidity30d
+	return time.Until(cert.NotAfter) < CertificateRenewalThreshold()
 }
 
 // IsServerCertificateValidForAllOf validates if the given data is present in the given server certificate.
@@ -1397,6 +1435,33 @@ func GetClusterRootCA(ctx context.Context, namespace string, client ctrlruntimec
 	return getRSAClusterCAFromLister(ctx, namespace, CASecretName, client)
 }
 
+// GetClusterRootCABundle returns the PEM-encoded bundle of all CAs the cluster trusts. Outside of a CA
+// rotation, this is just the root CA.
+func GetClusterRootCABundle(ctx context.Context, namespace string, client ctrlruntimeclient.Client) ([]byte, error) {
+	caSecret := &corev1.Secret{}
+	caSecretKey := types.NamespacedName{Namespace: namespace, Name: CASecretName}
+	if err := client.Get(ctx, caSecretKey, caSecret); err != nil {
+		return nil, fmt.Errorf("failed to get CA secret: %w", err)
+	}
+
+	certs, err := certutil.ParseCertsPEM(GetCABundleFromSecret(caSecret))
+	if err != nil {
+		return nil, fmt.Errorf("got an invalid cert from the CA secret %s: %w", caSecretKey, err)
+	}
+
+	return triple.EncodeCertBundlePEM(certs), nil
+}
+
+// GetCABundleFromSecret returns the trust bundle from the given CA secret. Secrets that
+// have not been reconciled since the bundle was introduced only contain the CA itself.
+func GetCABundleFromSecret(caSecret *corev1.Secret) []byte {
+	if bundle, exists := caSecret.Data[CACertBundleSecretKey]; exists {
+		return bundle
+	}
+
+	return caSecret.Data[CACertSecretKey]
+}
+
 // GetClusterFrontProxyCA returns the frontproxy CA of the cluster from the lister.
 func GetClusterFrontProxyCA(ctx context.Context, namespace string, client ctrlruntimeclient.Client) (*triple.KeyPair, error) {
 	return getRSAClusterCAFromLister(ctx, namespace, FrontProxyCASecretName, client)
@@ -1619,6 +1684,20 @@ func GetEtcdRestoreS3Client(ctx context.Context, restore *kubermaticv1.EtcdResto
 		secretData[EtcdRestoreS3BucketNameKey] = destination.BucketName
 		secretData[EtcdRestoreS3EndpointKey] = destination.Endpoint
 
+		if destination.Encryption != nil {
+			keySecret := &corev1.Secret{}
+			keySecretName := destination.Encryption.KeySecret.Name
+			if err := client.Get(ctx, types.NamespacedName{Namespace: destination.Credentials.Namespace, Name: keySecretName}, keySecret); err != nil {
+				return nil, "", fmt.Errorf("failed to get encryption key secret %v/%v: %w", destination.Credentials.Namespace, keySecretName, err)
+			}
+
+			key, ok := keySecret.Data[destination.Encryption.KeySecret.Key]
+			if !ok {
+				return nil, "", fmt.Errorf("encryption key secret %v/%v does not contain key %q", destination.Credentials.Namespace, keySecretName, destination.Encryption.KeySecret.Key)
+			}
+			secretData[EtcdRestoreEncryptionKeyKey] = string(key)
+		}
+
 		creator := func(se *corev1.Secret) (*corev1.Secret, error) {
 			if se.Data == nil {
 				se.Data = map[string][]byte{}
@@ -1679,6 +1758,31 @@ func GetEtcdRestoreS3Client(ctx context.Context, restore *kubermaticv1.EtcdResto
 	return s3Client, bucketName, nil
 }
 
+// GetEtcdRestoreEncryptionKey returns the key to decrypt the backup for a given EtcdRestore
+// from the backup download Secret, or nil if the backup destination has no encryption configured.
+func GetEtcdRestoreEncryptionKey(ctx context.Context, restore *kubermaticv1.EtcdRestore, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) ([]byte, error) {
+	if restore.Spec.BackupDownloadCredentialsSecret == "" {
+		return nil, fmt.Errorf("BackupDownloadCredentialsSecret not set")
+	}
+
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: restore.Spec.BackupDownloadCredentialsSecret}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get BackupDownloadCredentialsSecret credentials secret %v: %w", restore.Spec.BackupDownloadCredentialsSecret, err)
+	}
+
+	encoded, ok := secret.Data[EtcdRestoreEncryptionKeyKey]
+	if !ok {
+		return nil, nil
+	}
+
+	key, err := backupencryption.ParseKey(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+
+	return key, nil
+}
+
 // GetClusterNodeCIDRMaskSizeIPv4 returns effective mask size used to address the nodes within provided IPv4 Pods CIDR.
 func GetClusterNodeCIDRMaskSizeIPv4(cluster *kubermaticv1.Cluster) int32 {
 	if cluster.Spec.ClusterNetwork.NodeCIDRMaskSizeIPv4 != nil {
@@ -66,7 +66,7 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 				"--authentication-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
 				"--authorization-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
 				// This is used to validate certs
-				"--client-ca-file", "/etc/kubernetes/pki/ca/ca.crt",
+				"--client-ca-file", "/etc/kubernetes/pki/ca/ca-bundle.crt",
 				// this can't be passed as two strings as the other parameters
 				"--profiling=false",
 			}
@@ -214,8 +214,8 @@ func getVolumes(isKonnectivityEnabled bool) []corev1.Volume {
 					SecretName: resources.CASecretName,
 					Items: []corev1.KeyToPath{
 						{
-							Path: resources.CACertSecretKey,
-							Key:  resources.CACertSecretKey,
+							Path: resources.CACertBundleSecretKey,
+							Key:  resources.CACertBundleSecretKey,
 						},
 					},
 				},
@@ -103,6 +103,7 @@ func DeploymentReconciler(data webhookData) reconciling.NamedDeploymentReconcile
 				fmt.Sprintf("-ca-bundle=/opt/ca-bundle/%s", resources.CABundleConfigMapKey),
 				fmt.Sprintf("-project-id=%s", projectID),
 				fmt.Sprintf("-cluster-name=%s", data.Cluster().Name),
+				fmt.Sprintf("-datacenter=%s", data.Cluster().Spec.Cloud.DatacenterName),
 			}
 
 			if data.Cluster().Spec.DebugLog {
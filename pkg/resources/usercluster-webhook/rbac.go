@@ -62,6 +62,15 @@ func ClusterRole() reconciling.NamedClusterRoleReconcilerFactory {
 						"watch",
 					},
 				},
+				{
+					APIGroups: []string{kubermaticv1.GroupName},
+					Resources: []string{"groupprojectbindings"},
+					Verbs: []string{
+						"get",
+						"list",
+						"watch",
+					},
+				},
 			}
 			return r, nil
 		}
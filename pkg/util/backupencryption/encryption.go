@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backupencryption implements the client-side encryption of etcd snapshots.
+//
+// Snapshots are encrypted using envelope encryption: every snapshot is encrypted with
+// a random 256 bit data key using AES-GCM, and the data key itself is encrypted with
+// the key configured for the backup destination. As snapshots can be several GB in
+// size, the data is split into chunks that are sealed individually. Each chunk's nonce
+// contains its sequence number and a flag marking the final chunk, so that reordering
+// or truncating the chunks is detected during decryption.
+//
+// An encrypted snapshot has the following layout:
+//
+//	magic | key nonce | encrypted data key | nonce prefix | chunk 1 | ... | chunk n
+package backupencryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// KeySize is the size of encryption keys in bytes.
+	KeySize = 32
+
+	// chunkSize is the amount of plaintext that is sealed in a single chunk.
+	chunkSize = 64 * 1024
+
+	// noncePrefixSize is the number of random bytes in each chunk's nonce, the
+	// remaining 5 bytes are the chunk counter and the final chunk flag.
+	noncePrefixSize = 7
+)
+
+// magic is written at the start of every encrypted snapshot and is used to tell
+// encrypted snapshots apart from plain ones.
+var magic = []byte("KKPENC01")
+
+// ParseKey decodes a base64-encoded encryption key, as it is stored in the Secret
+// referenced by a backup destination.
+func ParseKey(encoded []byte) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(encoded)))
+	if err != nil {
+		return nil, fmt.Errorf("key is not valid base64: %w", err)
+	}
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes long, but is %d bytes", KeySize, len(key))
+	}
+
+	return key, nil
+}
+
+// IsEncrypted checks whether the given data starts with the header of an encrypted snapshot.
+func IsEncrypted(header []byte) bool {
+	return bytes.HasPrefix(header, magic)
+}
+
+// PrefixSize is the number of bytes that must be passed to IsEncrypted.
+func PrefixSize() int {
+	return len(magic)
+}
+
+// Encrypt reads the plaintext from src and writes the encrypted snapshot to dst.
+func Encrypt(dst io.Writer, src io.Reader, key []byte) error {
+	keyAEAD, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return err
+	}
+
+	keyNonce := make([]byte, keyAEAD.NonceSize())
+	if _, err := rand.Read(keyNonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	noncePrefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	header := bytes.NewBuffer(nil)
+	header.Write(magic)
+	header.Write(keyNonce)
+	header.Write(keyAEAD.Seal(nil, keyNonce, dataKey, magic))
+	header.Write(noncePrefix)
+
+	if _, err := dst.Write(header.Bytes()); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	reader := bufio.NewReaderSize(src, chunkSize)
+	plaintext := make([]byte, chunkSize)
+	ciphertext := make([]byte, 0, chunkSize+dataAEAD.Overhead())
+
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(reader, plaintext)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("failed to read plaintext: %w", err)
+		}
+
+		last := err != nil || isEOF(reader)
+
+		ciphertext = dataAEAD.Seal(ciphertext[:0], chunkNonce(noncePrefix, counter, last), plaintext[:n], nil)
+		if _, err := dst.Write(ciphertext); err != nil {
+			return fmt.Errorf("failed to write ciphertext: %w", err)
+		}
+
+		if last {
+			return nil
+		}
+
+		if counter == ^uint32(0) {
+			return errors.New("plaintext is too large")
+		}
+	}
+}
+
+// Decrypt reads an encrypted snapshot from src and writes the plaintext to dst. An error
+// is returned if the snapshot was encrypted with a different key or has been tampered with.
+// Note that in the latter case, parts of the plaintext might have been written already.
+func Decrypt(dst io.Writer, src io.Reader, key []byte) error {
+	keyAEAD, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReaderSize(src, chunkSize)
+
+	header := make([]byte, len(magic)+keyAEAD.NonceSize()+KeySize+keyAEAD.Overhead()+noncePrefixSize)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return fmt.Errorf("failed to read header: %w", err)
+	}
+
+	if !IsEncrypted(header) {
+		return errors.New("data is not encrypted")
+	}
+
+	header = header[len(magic):]
+	keyNonce, header := header[:keyAEAD.NonceSize()], header[keyAEAD.NonceSize():]
+	encryptedDataKey, noncePrefix := header[:KeySize+keyAEAD.Overhead()], header[KeySize+keyAEAD.Overhead():]
+
+	dataKey, err := keyAEAD.Open(nil, keyNonce, encryptedDataKey, magic)
+	if err != nil {
+		return errors.New("failed to decrypt data key, the snapshot was encrypted with a different key")
+	}
+
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return err
+	}
+
+	ciphertext := make([]byte, chunkSize+dataAEAD.Overhead())
+	plaintext := make([]byte, 0, chunkSize)
+
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(reader, ciphertext)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("failed to read ciphertext: %w", err)
+		}
+
+		last := err != nil || isEOF(reader)
+
+		plaintext, err = dataAEAD.Open(plaintext[:0], chunkNonce(noncePrefix, counter, last), ciphertext[:n], nil)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt chunk %d, the snapshot is corrupted or was truncated", counter)
+		}
+
+		if _, err := dst.Write(plaintext); err != nil {
+			return fmt.Errorf("failed to write plaintext: %w", err)
+		}
+
+		if last {
+			return nil
+		}
+	}
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes long", KeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+
+	return nonce
+}
+
+func isEOF(reader *bufio.Reader) bool {
+	_, err := reader.Peek(1)
+	return errors.Is(err, io.EOF)
+}
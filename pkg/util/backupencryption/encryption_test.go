@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+)
+
+func genKey(t *testing.T) []byte {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	return key
+}
+
+func encrypt(t *testing.T, plaintext, key []byte) []byte {
+	encrypted := &bytes.Buffer{}
+	if err := Encrypt(encrypted, bytes.NewReader(plaintext), key); err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+
+	return encrypted.Bytes()
+}
+
+func TestRoundtrip(t *testing.T) {
+	testCases := []struct {
+		name string
+		size int
+	}{
+		{
+			name: "empty",
+			size: 0,
+		},
+		{
+			name: "smaller than a chunk",
+			size: 1000,
+		},
+		{
+			name: "exactly one chunk",
+			size: chunkSize,
+		},
+		{
+			name: "multiple chunks",
+			size: 3*chunkSize + 42,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := genKey(t)
+
+			plaintext := make([]byte, tc.size)
+			if _, err := rand.Read(plaintext); err != nil {
+				t.Fatalf("Failed to generate plaintext: %v", err)
+			}
+
+			encrypted := encrypt(t, plaintext, key)
+			if !IsEncrypted(encrypted) {
+				t.Fatal("Encrypted data is not recognized as encrypted.")
+			}
+
+			decrypted := &bytes.Buffer{}
+			if err := Decrypt(decrypted, bytes.NewReader(encrypted), key); err != nil {
+				t.Fatalf("Failed to decrypt: %v", err)
+			}
+
+			if !bytes.Equal(plaintext, decrypted.Bytes()) {
+				t.Fatal("Decrypted data does not match plaintext.")
+			}
+		})
+	}
+}
+
+func TestDecryptionFailures(t *testing.T) {
+	key := genKey(t)
+	// 2.5 chunks
+	plaintext := bytes.Repeat([]byte("etcd"), chunkSize*5/8)
+	encrypted := encrypt(t, plaintext, key)
+
+	headerSize := len(magic) + 12 + KeySize + 16 + noncePrefixSize
+
+	testCases := []struct {
+		name string
+		data []byte
+		key  []byte
+	}{
+		{
+			name: "wrong key",
+			data: encrypted,
+			key:  genKey(t),
+		},
+		{
+			name: "truncated after a full chunk",
+			data: encrypted[:headerSize+2*(chunkSize+16)],
+			key:  key,
+		},
+		{
+			name: "truncated within a chunk",
+			data: encrypted[:len(encrypted)-10],
+			key:  key,
+		},
+		{
+			name: "tampered",
+			data: func() []byte {
+				tampered := bytes.Clone(encrypted)
+				tampered[len(tampered)/2] ^= 0xff
+				return tampered
+			}(),
+			key: key,
+		},
+		{
+			name: "not encrypted",
+			data: plaintext,
+			key:  key,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := Decrypt(&bytes.Buffer{}, bytes.NewReader(tc.data), tc.key); err == nil {
+				t.Fatal("Expected decryption to fail, but it succeeded.")
+			}
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	key := genKey(t)
+
+	parsed, err := ParseKey([]byte(base64.StdEncoding.EncodeToString(key) + "\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse valid key: %v", err)
+	}
+
+	if !bytes.Equal(key, parsed) {
+		t.Fatal("Parsed key does not match original key.")
+	}
+
+	if _, err := ParseKey([]byte(base64.StdEncoding.EncodeToString(key[:16]))); err == nil {
+		t.Fatal("Expected short key to be rejected.")
+	}
+
+	if _, err := ParseKey([]byte("not base64!")); err == nil {
+		t.Fatal("Expected invalid base64 to be rejected.")
+	}
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clustertemplate implements the parameters of ClusterTemplates, which allow
+// ClusterTemplateInstances to customize the clusters created from a template.
+package clustertemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// SpecRoot is the root of all parameter paths that refer to the cluster spec.
+	SpecRoot = "spec"
+	// InitialMachineDeploymentRoot is the root of all parameter paths that refer to the
+	// initial MachineDeployment of the cluster.
+	InitialMachineDeploymentRoot = "initialMachineDeployment"
+)
+
+var supportedTypes = sets.New(
+	kubermaticv1.ClusterTemplateParameterTypeString,
+	kubermaticv1.ClusterTemplateParameterTypeInteger,
+	kubermaticv1.ClusterTemplateParameterTypeBoolean,
+)
+
+// ValidateParameters validates the parameters declared by the given template. Besides
+// the declarations themselves, this ensures that all defaults can be applied to the template.
+func ValidateParameters(template *kubermaticv1.ClusterTemplate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, param := range template.Parameters {
+		paramPath := fldPath.Index(i)
+
+		if names.Has(param.Name) {
+			allErrs = append(allErrs, field.Duplicate(paramPath.Child("name"), param.Name))
+		}
+		names.Insert(param.Name)
+
+		if !supportedTypes.Has(param.Type) {
+			allErrs = append(allErrs, field.NotSupported(paramPath.Child("type"), param.Type, sets.List(supportedTypes)))
+			continue
+		}
+
+		if len(param.Paths) == 0 {
+			allErrs = append(allErrs, field.Required(paramPath.Child("paths"), "at least one path is required"))
+		}
+
+		for j, path := range param.Paths {
+			if _, err := parsePath(path); err != nil {
+				allErrs = append(allErrs, field.Invalid(paramPath.Child("paths").Index(j), path, err.Error()))
+			}
+		}
+
+		if param.Pattern != "" {
+			if param.Type != kubermaticv1.ClusterTemplateParameterTypeString {
+				allErrs = append(allErrs, field.Forbidden(paramPath.Child("pattern"), "pattern is only supported for string parameters"))
+			} else if _, err := regexp.Compile(param.Pattern); err != nil {
+				allErrs = append(allErrs, field.Invalid(paramPath.Child("pattern"), param.Pattern, err.Error()))
+				continue
+			}
+		}
+
+		if param.Minimum != nil || param.Maximum != nil {
+			if param.Type != kubermaticv1.ClusterTemplateParameterTypeInteger {
+				allErrs = append(allErrs, field.Forbidden(paramPath, "minimum and maximum are only supported for integer parameters"))
+			} else if param.Minimum != nil && param.Maximum != nil && *param.Minimum > *param.Maximum {
+				allErrs = append(allErrs, field.Invalid(paramPath.Child("maximum"), *param.Maximum, "maximum must not be smaller than minimum"))
+			}
+		}
+
+		for j, value := range param.Enum {
+			if _, err := convertValue(param, value); err != nil {
+				allErrs = append(allErrs, field.Invalid(paramPath.Child("enum").Index(j), value, err.Error()))
+			}
+		}
+
+		if param.Default != nil {
+			if err := validateValue(param, *param.Default); err != nil {
+				allErrs = append(allErrs, field.Invalid(paramPath.Child("default"), *param.Default, err.Error()))
+			}
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	// ensure the paths fit the template by applying all defaults
+	defaults := map[string]interface{}{}
+	for _, param := range template.Parameters {
+		if param.Default != nil {
+			// the defaults have been validated already
+			defaults[param.Name], _ = convertValue(param, *param.Default)
+		}
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	cluster.Annotations = template.Annotations
+	cluster.Spec = *template.Spec.DeepCopy()
+
+	if err := Apply(cluster, template.Parameters, defaults); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", fmt.Sprintf("failed to apply defaults: %v", err)))
+	}
+
+	return allErrs
+}
+
+// ResolveValues validates the given values against the parameters and returns the typed value
+// of every parameter. Parameters without a value fall back to their default.
+func ResolveValues(params []kubermaticv1.ClusterTemplateParameter, values map[string]string, fldPath *field.Path) (map[string]interface{}, field.ErrorList) {
+	allErrs := field.ErrorList{}
+	resolved := map[string]interface{}{}
+
+	for _, name := range sets.List(sets.KeySet(values)) {
+		if !slices.ContainsFunc(params, func(p kubermaticv1.ClusterTemplateParameter) bool { return p.Name == name }) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), values[name], "the ClusterTemplate does not declare this parameter"))
+		}
+	}
+
+	for _, param := range params {
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Default == nil {
+				allErrs = append(allErrs, field.Required(fldPath.Key(param.Name), "parameter has no default and must be set"))
+				continue
+			}
+
+			value = *param.Default
+		}
+
+		if err := validateValue(param, value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(param.Name), value, err.Error()))
+			continue
+		}
+
+		resolved[param.Name], _ = convertValue(param, value)
+	}
+
+	return resolved, allErrs
+}
+
+// Apply substitutes the resolved values into the cluster. The cluster must have been
+// created from the template that declares the given parameters.
+func Apply(cluster *kubermaticv1.Cluster, params []kubermaticv1.ClusterTemplateParameter, resolved map[string]interface{}) error {
+	if len(resolved) == 0 {
+		return nil
+	}
+
+	spec, err := toUnstructured(cluster.Spec)
+	if err != nil {
+		return fmt.Errorf("failed to encode cluster spec: %w", err)
+	}
+
+	doc := map[string]interface{}{
+		SpecRoot: spec,
+	}
+
+	if request := cluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]; request != "" {
+		md := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(request), &md); err != nil {
+			return fmt.Errorf("failed to decode initial MachineDeployment: %w", err)
+		}
+
+		doc[InitialMachineDeploymentRoot] = md
+	}
+
+	for _, param := range params {
+		value, ok := resolved[param.Name]
+		if !ok {
+			continue
+		}
+
+		for _, path := range param.Paths {
+			tokens, err := parsePath(path)
+			if err != nil {
+				return fmt.Errorf("parameter %q: %w", param.Name, err)
+			}
+
+			if _, exists := doc[tokens[0]]; !exists {
+				return fmt.Errorf("parameter %q: cannot set %q, the cluster has no initial MachineDeployment", param.Name, path)
+			}
+
+			if _, err := setValue(doc, tokens, value); err != nil {
+				return fmt.Errorf("parameter %q: cannot set %q: %w", param.Name, path, err)
+			}
+		}
+	}
+
+	newSpec := kubermaticv1.ClusterSpec{}
+	if err := fromUnstructured(doc[SpecRoot], &newSpec); err != nil {
+		return fmt.Errorf("failed to decode cluster spec: %w", err)
+	}
+	cluster.Spec = newSpec
+
+	if md, ok := doc[InitialMachineDeploymentRoot]; ok {
+		newMD := clusterv1alpha1.MachineDeployment{}
+		if err := fromUnstructured(md, &newMD); err != nil {
+			return fmt.Errorf("failed to decode initial MachineDeployment: %w", err)
+		}
+
+		encoded, err := json.Marshal(newMD)
+		if err != nil {
+			return fmt.Errorf("failed to encode initial MachineDeployment: %w", err)
+		}
+
+		// do not modify the annotations of the template the cluster was created from
+		annotations := make(map[string]string, len(cluster.Annotations))
+		for k, v := range cluster.Annotations {
+			annotations[k] = v
+		}
+		annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation] = string(encoded)
+		cluster.Annotations = annotations
+	}
+
+	return nil
+}
+
+func validateValue(param kubermaticv1.ClusterTemplateParameter, value string) error {
+	converted, err := convertValue(param, value)
+	if err != nil {
+		return err
+	}
+
+	if len(param.Enum) > 0 && !slices.Contains(param.Enum, value) {
+		return fmt.Errorf("must be one of %v", param.Enum)
+	}
+
+	if param.Pattern != "" {
+		pattern, err := regexp.Compile(param.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("must match %s", param.Pattern)
+		}
+	}
+
+	if i, ok := converted.(int64); ok {
+		if param.Minimum != nil && i < *param.Minimum {
+			return fmt.Errorf("must be greater than or equal to %d", *param.Minimum)
+		}
+
+		if param.Maximum != nil && i > *param.Maximum {
+			return fmt.Errorf("must be less than or equal to %d", *param.Maximum)
+		}
+	}
+
+	return nil
+}
+
+func convertValue(param kubermaticv1.ClusterTemplateParameter, value string) (interface{}, error) {
+	switch param.Type {
+	case kubermaticv1.ClusterTemplateParameterTypeString:
+		return value, nil
+
+	case kubermaticv1.ClusterTemplateParameterTypeInteger:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.New("must be an integer")
+		}
+		return i, nil
+
+	case kubermaticv1.ClusterTemplateParameterTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.New("must be a boolean")
+		}
+		return b, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported parameter type %q", param.Type)
+	}
+}
+
+// parsePath parses a JSON pointer and returns its unescaped reference tokens.
+func parsePath(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, errors.New("path must be a JSON pointer starting with a slash")
+	}
+
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	if root := tokens[0]; root != SpecRoot && root != InitialMachineDeploymentRoot {
+		return nil, fmt.Errorf("path must start with /%s or /%s", SpecRoot, InitialMachineDeploymentRoot)
+	}
+
+	if len(tokens) < 2 || tokens[1] == "" {
+		return nil, errors.New("path must refer to a field")
+	}
+
+	return tokens, nil
+}
+
+// setValue sets the value at the location described by tokens, creating missing
+// objects along the way, and returns the updated node.
+func setValue(node interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	token, rest := tokens[0], tokens[1:]
+
+	switch n := node.(type) {
+	case nil:
+		child, err := setValue(nil, rest, value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{token: child}, nil
+
+	case map[string]interface{}:
+		child, err := setValue(n[token], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		n[token] = child
+		return n, nil
+
+	case []interface{}:
+		if token == "-" {
+			child, err := setValue(nil, rest, value)
+			if err != nil {
+				return nil, err
+			}
+			return append(n, child), nil
+		}
+
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 || index >= len(n) {
+			return nil, fmt.Errorf("invalid index %q for a list with %d elements", token, len(n))
+		}
+
+		child, err := setValue(n[index], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		n[index] = child
+		return n, nil
+
+	default:
+		return nil, fmt.Errorf("cannot set %q on a %T value", token, node)
+	}
+}
+
+func toUnstructured(obj interface{}) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// fromUnstructured decodes the given data into obj, rejecting unknown fields
+// to catch paths that do not exist in the target type.
+func fromUnstructured(data interface{}, obj interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(obj)
+}
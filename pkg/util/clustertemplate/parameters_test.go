@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplate
+
+import (
+	"encoding/json"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func genTemplate(params ...kubermaticv1.ClusterTemplateParameter) *kubermaticv1.ClusterTemplate {
+	md := clusterv1alpha1.MachineDeployment{
+		Spec: clusterv1alpha1.MachineDeploymentSpec{
+			Replicas: ptr.To[int32](1),
+		},
+	}
+
+	encoded, _ := json.Marshal(md)
+
+	return &kubermaticv1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "template",
+			Annotations: map[string]string{
+				kubermaticv1.InitialMachineDeploymentRequestAnnotation: string(encoded),
+			},
+		},
+		Parameters: params,
+		Spec: kubermaticv1.ClusterSpec{
+			HumanReadableName: "template",
+			Version:           *semver.NewSemverOrDie("1.33.0"),
+			Cloud: kubermaticv1.CloudSpec{
+				DatacenterName: "fake-dc",
+				Fake:           &kubermaticv1.FakeCloudSpec{},
+			},
+			ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+				Pods: kubermaticv1.NetworkRanges{
+					CIDRBlocks: []string{"172.25.0.0/16"},
+				},
+			},
+		},
+	}
+}
+
+var (
+	versionParam = kubermaticv1.ClusterTemplateParameter{
+		Name:    "version",
+		Type:    kubermaticv1.ClusterTemplateParameterTypeString,
+		Paths:   []string{"/spec/version"},
+		Default: ptr.To("1.33.0"),
+		Enum:    []string{"1.32.0", "1.33.0"},
+	}
+
+	datacenterParam = kubermaticv1.ClusterTemplateParameter{
+		Name:    "datacenter",
+		Type:    kubermaticv1.ClusterTemplateParameterTypeString,
+		Paths:   []string{"/spec/cloud/dc"},
+		Pattern: "^[a-z-]+$",
+	}
+
+	podCIDRParam = kubermaticv1.ClusterTemplateParameter{
+		Name:  "podCIDR",
+		Type:  kubermaticv1.ClusterTemplateParameterTypeString,
+		Paths: []string{"/spec/clusterNetwork/pods/cidrBlocks/0"},
+	}
+
+	workersParam = kubermaticv1.ClusterTemplateParameter{
+		Name:    "workers",
+		Type:    kubermaticv1.ClusterTemplateParameterTypeInteger,
+		Paths:   []string{"/initialMachineDeployment/spec/replicas"},
+		Default: ptr.To("3"),
+		Minimum: ptr.To[int64](1),
+		Maximum: ptr.To[int64](10),
+	}
+
+	auditLoggingParam = kubermaticv1.ClusterTemplateParameter{
+		Name:    "auditLogging",
+		Type:    kubermaticv1.ClusterTemplateParameterTypeBoolean,
+		Paths:   []string{"/spec/auditLogging/enabled"},
+		Default: ptr.To("false"),
+	}
+)
+
+func TestValidateParameters(t *testing.T) {
+	testCases := []struct {
+		name        string
+		params      []kubermaticv1.ClusterTemplateParameter
+		expectedErr bool
+	}{
+		{
+			name:   "valid parameters",
+			params: []kubermaticv1.ClusterTemplateParameter{versionParam, datacenterParam, podCIDRParam, workersParam, auditLoggingParam},
+		},
+		{
+			name:        "duplicate names",
+			params:      []kubermaticv1.ClusterTemplateParameter{versionParam, versionParam},
+			expectedErr: true,
+		},
+		{
+			name: "path outside of the supported roots",
+			params: []kubermaticv1.ClusterTemplateParameter{{
+				Name:  "name",
+				Type:  kubermaticv1.ClusterTemplateParameterTypeString,
+				Paths: []string{"/metadata/name"},
+			}},
+			expectedErr: true,
+		},
+		{
+			name: "default does not match the type",
+			params: []kubermaticv1.ClusterTemplateParameter{{
+				Name:    "workers",
+				Type:    kubermaticv1.ClusterTemplateParameterTypeInteger,
+				Paths:   []string{"/initialMachineDeployment/spec/replicas"},
+				Default: ptr.To("three"),
+			}},
+			expectedErr: true,
+		},
+		{
+			name: "default is out of range",
+			params: []kubermaticv1.ClusterTemplateParameter{{
+				Name:    "workers",
+				Type:    kubermaticv1.ClusterTemplateParameterTypeInteger,
+				Paths:   []string{"/initialMachineDeployment/spec/replicas"},
+				Default: ptr.To("11"),
+				Maximum: ptr.To[int64](10),
+			}},
+			expectedErr: true,
+		},
+		{
+			name: "pattern on an integer parameter",
+			params: []kubermaticv1.ClusterTemplateParameter{{
+				Name:    "workers",
+				Type:    kubermaticv1.ClusterTemplateParameterTypeInteger,
+				Paths:   []string{"/initialMachineDeployment/spec/replicas"},
+				Pattern: "^[0-9]$",
+			}},
+			expectedErr: true,
+		},
+		{
+			name: "default does not fit the target field",
+			params: []kubermaticv1.ClusterTemplateParameter{{
+				Name:    "version",
+				Type:    kubermaticv1.ClusterTemplateParameterTypeInteger,
+				Paths:   []string{"/spec/clusterNetwork/pods"},
+				Default: ptr.To("1"),
+			}},
+			expectedErr: true,
+		},
+		{
+			name: "default targets an unknown field",
+			params: []kubermaticv1.ClusterTemplateParameter{{
+				Name:    "typo",
+				Type:    kubermaticv1.ClusterTemplateParameterTypeString,
+				Paths:   []string{"/spec/verison"},
+				Default: ptr.To("1.33.0"),
+			}},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateParameters(genTemplate(tc.params...), field.NewPath("parameters"))
+
+			if tc.expectedErr != (len(errs) > 0) {
+				t.Fatalf("Expected error: %v, got %v", tc.expectedErr, errs)
+			}
+		})
+	}
+}
+
+func TestResolveValues(t *testing.T) {
+	params := []kubermaticv1.ClusterTemplateParameter{versionParam, datacenterParam, workersParam, auditLoggingParam}
+
+	testCases := []struct {
+		name             string
+		values           map[string]string
+		expectedResolved map[string]interface{}
+		expectedErr      bool
+	}{
+		{
+			name:   "defaults are used for unset parameters",
+			values: map[string]string{"datacenter": "hetzner-fsn"},
+			expectedResolved: map[string]interface{}{
+				"version":      "1.33.0",
+				"datacenter":   "hetzner-fsn",
+				"workers":      int64(3),
+				"auditLogging": false,
+			},
+		},
+		{
+			name:   "values are converted to the parameter's type",
+			values: map[string]string{"version": "1.32.0", "datacenter": "hetzner-fsn", "workers": "5", "auditLogging": "true"},
+			expectedResolved: map[string]interface{}{
+				"version":      "1.32.0",
+				"datacenter":   "hetzner-fsn",
+				"workers":      int64(5),
+				"auditLogging": true,
+			},
+		},
+		{
+			name:        "required parameter is missing",
+			values:      map[string]string{},
+			expectedErr: true,
+		},
+		{
+			name:        "unknown parameter",
+			values:      map[string]string{"datacenter": "hetzner-fsn", "region": "eu"},
+			expectedErr: true,
+		},
+		{
+			name:        "value not in enum",
+			values:      map[string]string{"datacenter": "hetzner-fsn", "version": "1.31.0"},
+			expectedErr: true,
+		},
+		{
+			name:        "value does not match pattern",
+			values:      map[string]string{"datacenter": "Hetzner"},
+			expectedErr: true,
+		},
+		{
+			name:        "value below minimum",
+			values:      map[string]string{"datacenter": "hetzner-fsn", "workers": "0"},
+			expectedErr: true,
+		},
+		{
+			name:        "value is not a boolean",
+			values:      map[string]string{"datacenter": "hetzner-fsn", "auditLogging": "maybe"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved, errs := ResolveValues(params, tc.values, field.NewPath("spec", "values"))
+
+			if tc.expectedErr {
+				if len(errs) == 0 {
+					t.Fatal("Expected an error, but got none.")
+				}
+				return
+			}
+
+			if len(errs) > 0 {
+				t.Fatalf("Unexpected error: %v", errs)
+			}
+
+			if !diff.SemanticallyEqual(tc.expectedResolved, resolved) {
+				t.Fatalf("Diff:\n%s", diff.ObjectDiff(tc.expectedResolved, resolved))
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	params := []kubermaticv1.ClusterTemplateParameter{versionParam, datacenterParam, podCIDRParam, workersParam, auditLoggingParam}
+	template := genTemplate(params...)
+
+	originalRequest := template.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]
+
+	cluster := &kubermaticv1.Cluster{}
+	cluster.Annotations = template.Annotations
+	cluster.Spec = *template.Spec.DeepCopy()
+
+	resolved := map[string]interface{}{
+		"version":      "1.32.0",
+		"datacenter":   "hetzner-fsn",
+		"podCIDR":      "10.0.0.0/16",
+		"workers":      int64(5),
+		"auditLogging": true,
+	}
+
+	if err := Apply(cluster, params, resolved); err != nil {
+		t.Fatalf("Failed to apply parameters: %v", err)
+	}
+
+	expectedSpec := template.Spec.DeepCopy()
+	expectedSpec.Version = *semver.NewSemverOrDie("1.32.0")
+	expectedSpec.Cloud.DatacenterName = "hetzner-fsn"
+	expectedSpec.ClusterNetwork.Pods.CIDRBlocks = []string{"10.0.0.0/16"}
+	expectedSpec.AuditLogging = &kubermaticv1.AuditLoggingSettings{Enabled: true}
+
+	if !diff.SemanticallyEqual(*expectedSpec, cluster.Spec) {
+		t.Fatalf("Diff:\n%s", diff.ObjectDiff(*expectedSpec, cluster.Spec))
+	}
+
+	md := clusterv1alpha1.MachineDeployment{}
+	if err := json.Unmarshal([]byte(cluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]), &md); err != nil {
+		t.Fatalf("Failed to decode initial MachineDeployment: %v", err)
+	}
+
+	if md.Spec.Replicas == nil || *md.Spec.Replicas != 5 {
+		t.Fatalf("Expected initial MachineDeployment to have 5 replicas, got %v", md.Spec.Replicas)
+	}
+
+	// the template must not have been modified
+	if template.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation] != originalRequest {
+		t.Fatal("Expected the template's annotations to be left untouched.")
+	}
+}
+
+func TestApplyWithoutInitialMachineDeployment(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{}
+	cluster.Spec = genTemplate().Spec
+
+	err := Apply(cluster, []kubermaticv1.ClusterTemplateParameter{workersParam}, map[string]interface{}{"workers": int64(2)})
+	if err == nil {
+		t.Fatal("Expected an error when targeting a missing initial MachineDeployment.")
+	}
+}
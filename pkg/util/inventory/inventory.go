@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inventory implements server-side applying a set of objects into a cluster
+// while keeping track of them, so that objects that are not part of the set anymore
+// can be pruned later on. It is used for addons and application manifests.
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Item references a single object that was applied into a cluster.
+type Item struct {
+	Group   string
+	Version string
+	Kind    string
+	// Namespace is empty for cluster-scoped objects.
+	Namespace string
+	Name      string
+}
+
+// ItemForObject returns the inventory item referencing the given object.
+func ItemForObject(obj *unstructured.Unstructured) Item {
+	gvk := obj.GroupVersionKind()
+
+	return Item{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+}
+
+// SameObject returns true if both items reference the same object. The version is
+// ignored, as the same object can be applied using different API versions, e.g. when
+// a manifest is updated from v1beta1 to v1.
+func (i Item) SameObject(other Item) bool {
+	return i.Group == other.Group &&
+		i.Kind == other.Kind &&
+		i.Namespace == other.Namespace &&
+		i.Name == other.Name
+}
+
+// String returns a string similar to what kubectl prints,
+// e.g. "deployment.apps kube-system/coredns".
+func (i Item) String() string {
+	kind := strings.ToLower(i.Kind)
+	if i.Group != "" {
+		kind = fmt.Sprintf("%s.%s", kind, i.Group)
+	}
+
+	name := i.Name
+	if i.Namespace != "" {
+		name = fmt.Sprintf("%s/%s", i.Namespace, i.Name)
+	}
+
+	return fmt.Sprintf("%s %s", kind, name)
+}
+
+// FormatObject returns the same string as Item.String for the given object.
+func FormatObject(obj *unstructured.Unstructured) string {
+	return ItemForObject(obj).String()
+}
+
+// Contains returns true if the items contain the same object as item, regardless of its version.
+func Contains(items []Item, item Item) bool {
+	return slices.ContainsFunc(items, item.SameObject)
+}
+
+// Merge returns all items from the current inventory, followed by all items from
+// the previous inventory whose objects are not part of the current one.
+func Merge(current, previous []Item) []Item {
+	merged := slices.Clone(current)
+
+	for _, item := range previous {
+		if !Contains(merged, item) {
+			merged = append(merged, item)
+		}
+	}
+
+	return merged
+}
+
+// Applier applies and prunes objects in a single cluster.
+type Applier struct {
+	Client ctrlruntimeclient.Client
+	Log    *zap.SugaredLogger
+
+	// FieldManager is the field manager used to server-side apply the objects.
+	FieldManager string
+
+	// OwnerLabels are added to all applied objects. Objects are only deleted if they still
+	// carry these labels, so that objects that have been taken over by someone else are left alone.
+	OwnerLabels map[string]string
+
+	// DefaultNamespace is used for namespaced objects that do not specify a namespace.
+	DefaultNamespace string
+
+	// MigrateFieldManagers are moved to FieldManager before applying an object. Without this, fields
+	// that were set by a client-side apply and that have been removed from a manifest would never be
+	// removed from the live object, as they would still be owned by the previous field manager.
+	MigrateFieldManagers sets.Set[string]
+}
+
+// Apply server-side applies all given objects. A failure to apply a single object does
+// not abort the operation, instead all errors are collected and returned together, so
+// that each failing object is visible. The returned inventory always contains all given
+// objects, regardless of errors.
+func (a *Applier) Apply(ctx context.Context, objs []*unstructured.Unstructured) ([]Item, error) {
+	var (
+		items []Item
+		errs  []error
+	)
+
+	for _, obj := range objs {
+		if err := a.NormalizeNamespace(obj); err != nil {
+			items = append(items, ItemForObject(obj))
+			errs = append(errs, fmt.Errorf("%s: %w", FormatObject(obj), err))
+			continue
+		}
+
+		if len(a.OwnerLabels) > 0 {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			for k, v := range a.OwnerLabels {
+				labels[k] = v
+			}
+			obj.SetLabels(labels)
+		}
+
+		items = append(items, ItemForObject(obj))
+
+		if a.MigrateFieldManagers.Len() > 0 {
+			if err := a.migrateFieldManagers(ctx, obj); err != nil {
+				errs = append(errs, fmt.Errorf("%s: failed to migrate field managers: %w", FormatObject(obj), err))
+				continue
+			}
+		}
+
+		a.Log.Debugw("Applying object", "object", FormatObject(obj))
+
+		if err := a.Client.Apply(ctx, ctrlruntimeclient.ApplyConfigurationFromUnstructured(obj), ctrlruntimeclient.FieldOwner(a.FieldManager), ctrlruntimeclient.ForceOwnership); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", FormatObject(obj), err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return items, fmt.Errorf("failed to apply %d of %d objects: %w", len(errs), len(objs), kerrors.NewAggregate(errs))
+	}
+
+	return items, nil
+}
+
+// NormalizeNamespace defaults the namespace of namespaced objects, just like kubectl
+// would do, and removes it from cluster-scoped objects.
+func (a *Applier) NormalizeNamespace(obj *unstructured.Unstructured) error {
+	namespaced, err := a.Client.IsObjectNamespaced(obj)
+	if err != nil {
+		return fmt.Errorf("failed to determine scope: %w", err)
+	}
+
+	switch {
+	case !namespaced:
+		obj.SetNamespace("")
+	case obj.GetNamespace() == "":
+		namespace := a.DefaultNamespace
+		if namespace == "" {
+			namespace = metav1.NamespaceDefault
+		}
+		obj.SetNamespace(namespace)
+	}
+
+	return nil
+}
+
+func (a *Applier) migrateFieldManagers(ctx context.Context, obj *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+
+	if err := a.Client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), existing); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing, a.MigrateFieldManagers, a.FieldManager)
+	if err != nil {
+		return err
+	}
+
+	// nothing to migrate
+	if patch == nil {
+		return nil
+	}
+
+	return a.Client.Patch(ctx, existing, ctrlruntimeclient.RawPatch(types.JSONPatchType, patch))
+}
+
+// Prune deletes all objects from the previous inventory that are not part of the current
+// inventory anymore. The returned list contains all stale objects that could not be deleted
+// and must therefore remain in the inventory.
+func (a *Applier) Prune(ctx context.Context, previous, current []Item) ([]Item, error) {
+	var (
+		remaining []Item
+		errs      []error
+	)
+
+	for _, item := range previous {
+		if Contains(current, item) {
+			continue
+		}
+
+		a.Log.Debugw("Pruning object", "object", item.String())
+
+		if err := a.deleteItem(ctx, item); err != nil {
+			remaining = append(remaining, item)
+			errs = append(errs, fmt.Errorf("%s: %w", item, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return remaining, fmt.Errorf("failed to prune %d objects: %w", len(errs), kerrors.NewAggregate(errs))
+	}
+
+	return nil, nil
+}
+
+// Delete deletes all given objects in reverse order, so that for example custom
+// resources are removed before their CRDs.
+func (a *Applier) Delete(ctx context.Context, items []Item) error {
+	var errs []error
+
+	for _, item := range slices.Backward(items) {
+		a.Log.Debugw("Deleting object", "object", item.String())
+
+		if err := a.deleteItem(ctx, item); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", item, err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func (a *Applier) deleteItem(ctx context.Context, item Item) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: item.Group, Version: item.Version, Kind: item.Kind})
+
+	key := types.NamespacedName{Namespace: item.Namespace, Name: item.Name}
+	if err := a.Client.Get(ctx, key, obj); err != nil {
+		// the object or even its type (i.e. the CRD) are already gone
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	currentLabels := obj.GetLabels()
+	for k, v := range a.OwnerLabels {
+		if currentLabels[k] != v {
+			a.Log.Debugw("Skipping deletion because object is not labelled as being owned anymore", "object", item.String())
+			return nil
+		}
+	}
+
+	return ctrlruntimeclient.IgnoreNotFound(a.Client.Delete(ctx, obj, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground)))
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const ownerLabelKey = "owner"
+
+func newUnstructured(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+func TestApply(t *testing.T) {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	restMapper.Add(rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), meta.RESTScopeRoot)
+
+	client := fake.NewClientBuilder().WithRESTMapper(restMapper).Build()
+
+	applier := &Applier{
+		Client:               client,
+		Log:                  kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+		FieldManager:         "test",
+		OwnerLabels:          map[string]string{ownerLabelKey: "test"},
+		DefaultNamespace:     "app-ns",
+		MigrateFieldManagers: sets.New("kubectl-client-side-apply"),
+	}
+
+	objects := []*unstructured.Unstructured{
+		newUnstructured("v1", "ConfigMap", "kube-system", "test1"),
+		// namespace should be defaulted
+		newUnstructured("v1", "ConfigMap", "", "test2"),
+		// namespace should be removed
+		newUnstructured("rbac.authorization.k8s.io/v1", "ClusterRole", "kube-system", "test3"),
+	}
+
+	items, err := applier.Apply(context.Background(), objects)
+	if err != nil {
+		t.Fatalf("Failed to apply objects: %v", err)
+	}
+
+	expected := []Item{
+		{Version: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: "test1"},
+		{Version: "v1", Kind: "ConfigMap", Namespace: "app-ns", Name: "test2"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole", Name: "test3"},
+	}
+
+	if !diff.SemanticallyEqual(expected, items) {
+		t.Fatalf("Inventory does not match expectation:\n%v", diff.ObjectDiff(expected, items))
+	}
+
+	for _, item := range expected {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(item.Version)
+		if item.Group != "" {
+			obj.SetAPIVersion(item.Group + "/" + item.Version)
+		}
+		obj.SetKind(item.Kind)
+
+		if err := client.Get(context.Background(), types.NamespacedName{Namespace: item.Namespace, Name: item.Name}, obj); err != nil {
+			t.Errorf("Failed to get %s: %v", item, err)
+			continue
+		}
+
+		if value := obj.GetLabels()[ownerLabelKey]; value != "test" {
+			t.Errorf("Expected %s to be labelled with the owner, got %q.", item, value)
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	labels := map[string]string{ownerLabelKey: "test"}
+
+	ownedConfigMap := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "kube-system",
+				Labels:    labels,
+			},
+		}
+	}
+
+	configMapItem := func(name string) Item {
+		return Item{Version: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: name}
+	}
+
+	testCases := []struct {
+		name              string
+		existingObjects   []ctrlruntimeclient.Object
+		previous          []Item
+		current           []Item
+		expectedDeleted   []string
+		expectedRemaining []string
+	}{
+		{
+			name:              "nothing to prune",
+			existingObjects:   []ctrlruntimeclient.Object{ownedConfigMap("a")},
+			previous:          []Item{configMapItem("a")},
+			current:           []Item{configMapItem("a")},
+			expectedRemaining: []string{"a"},
+		},
+		{
+			name:              "object removed from the manifests",
+			existingObjects:   []ctrlruntimeclient.Object{ownedConfigMap("a"), ownedConfigMap("b")},
+			previous:          []Item{configMapItem("a"), configMapItem("b")},
+			current:           []Item{configMapItem("a")},
+			expectedDeleted:   []string{"b"},
+			expectedRemaining: []string{"a"},
+		},
+		{
+			name:            "object applied using a different API version",
+			existingObjects: []ctrlruntimeclient.Object{ownedConfigMap("a")},
+			previous:        []Item{{Version: "v1beta1", Kind: "ConfigMap", Namespace: "kube-system", Name: "a"}},
+			current:         []Item{configMapItem("a")},
+			// the object must not be deleted through its old version
+			expectedRemaining: []string{"a"},
+		},
+		{
+			name: "object not labelled as being owned anymore",
+			existingObjects: []ctrlruntimeclient.Object{
+				ownedConfigMap("a"),
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "b",
+						Namespace: "kube-system",
+					},
+				},
+			},
+			previous:          []Item{configMapItem("a"), configMapItem("b")},
+			current:           []Item{configMapItem("a")},
+			expectedRemaining: []string{"a", "b"},
+		},
+		{
+			name:              "object already gone",
+			existingObjects:   []ctrlruntimeclient.Object{ownedConfigMap("a")},
+			previous:          []Item{configMapItem("a"), configMapItem("b")},
+			current:           []Item{configMapItem("a")},
+			expectedRemaining: []string{"a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.NewClientBuilder().WithObjects(tc.existingObjects...).Build()
+
+			applier := &Applier{
+				Client:      client,
+				Log:         kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+				OwnerLabels: labels,
+			}
+
+			remaining, err := applier.Prune(ctx, tc.previous, tc.current)
+			if err != nil {
+				t.Fatalf("Failed to prune objects: %v", err)
+			}
+
+			if len(remaining) > 0 {
+				t.Fatalf("Expected all stale objects to be pruned, but %v remain.", remaining)
+			}
+
+			for _, name := range tc.expectedDeleted {
+				err := client.Get(ctx, types.NamespacedName{Namespace: "kube-system", Name: name}, &corev1.ConfigMap{})
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("Expected ConfigMap %q to be deleted, but got %v.", name, err)
+				}
+			}
+
+			for _, name := range tc.expectedRemaining {
+				if err := client.Get(ctx, types.NamespacedName{Namespace: "kube-system", Name: name}, &corev1.ConfigMap{}); err != nil {
+					t.Errorf("Expected ConfigMap %q to still exist, but got %v.", name, err)
+				}
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := Item{Version: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: "a"}
+	b := Item{Version: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: "b"}
+	bOld := Item{Version: "v1beta1", Kind: "ConfigMap", Namespace: "kube-system", Name: "b"}
+	c := Item{Version: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: "c"}
+
+	merged := Merge([]Item{a, b}, []Item{bOld, c})
+	expected := []Item{a, b, c}
+
+	if !diff.SemanticallyEqual(expected, merged) {
+		t.Fatalf("Merged inventory does not match expectation:\n%v", diff.ObjectDiff(expected, merged))
+	}
+}
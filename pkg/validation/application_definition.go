@@ -19,6 +19,7 @@ package validation
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/containerd/containerd/remotes/docker"
 
@@ -37,6 +38,7 @@ func ValidateApplicationDefinitionSpec(ad appskubermaticv1.ApplicationDefinition
 
 	allErrs = append(allErrs, ValidateApplicationDefinitionWithOpenAPI(ad, parentFieldPath)...)
 	allErrs = append(allErrs, ValidateApplicationVersions(ad.Spec.Versions, parentFieldPath.Child("spec"))...)
+	allErrs = append(allErrs, validateTemplateMethod(ad.Spec, parentFieldPath.Child("spec"))...)
 	allErrs = append(allErrs, ValidateDeployOpts(ad.Spec.DefaultDeployOptions, parentFieldPath.Child("spec.defaultDeployOptions"))...)
 	allErrs = append(allErrs, ValidateApplicationValues(ad.Spec, parentFieldPath.Child("spec"))...)
 	return allErrs
@@ -106,6 +108,23 @@ func ValidateApplicationVersions(vs []appskubermaticv1.ApplicationVersion, paren
 	return allErrs
 }
 
+// validateTemplateMethod ensures that all versions use a source that is supported by the template method.
+// Kustomizations and plain manifests can only be retrieved from Git repositories.
+func validateTemplateMethod(spec appskubermaticv1.ApplicationDefinitionSpec, parentFieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch spec.Method {
+	case appskubermaticv1.KustomizeTemplateMethod, appskubermaticv1.ManifestsTemplateMethod:
+		for i, v := range spec.Versions {
+			if v.Template.Source.Helm != nil {
+				allErrs = append(allErrs, field.Forbidden(parentFieldPath.Child(fmt.Sprintf("versions[%d].template.source.helm", i)), fmt.Sprintf("helm source can not be used with method %q", spec.Method)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateSource(source appskubermaticv1.ApplicationSource, f *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -133,6 +152,8 @@ func validateHelmSource(helmSource *appskubermaticv1.HelmSource, f *field.Path)
 		allErrs = append(allErrs, e)
 	}
 
+	allErrs = append(allErrs, validateHelmChartVerification(helmSource, f.Child("verification"))...)
+
 	return allErrs
 }
 
@@ -213,6 +234,47 @@ func validateHelmCredentials(credential *appskubermaticv1.HelmCredentials, f *fi
 	return nil
 }
 
+func validateHelmChartVerification(helmSource *appskubermaticv1.HelmSource, f *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	verification := helmSource.Verification
+	if verification == nil {
+		return allErrs
+	}
+
+	isOCI := strings.HasPrefix(helmSource.URL, "oci://")
+
+	switch {
+	case verification.Cosign != nil && verification.Provenance != nil:
+		allErrs = append(allErrs, field.Forbidden(f, "only one verification method can be provided"))
+
+	case verification.Cosign != nil:
+		cosign := verification.Cosign
+		if !isOCI {
+			allErrs = append(allErrs, field.Forbidden(f.Child("cosign"), "cosign verification can only be used with OCI URLs"))
+		}
+
+		switch {
+		case cosign.PublicKey != nil && cosign.Keyless != nil:
+			allErrs = append(allErrs, field.Forbidden(f.Child("cosign"), "publicKey can not be used in conjunction with keyless"))
+		case cosign.PublicKey == nil && cosign.Keyless == nil:
+			allErrs = append(allErrs, field.Required(f.Child("cosign"), "either publicKey or keyless must be specified"))
+		case cosign.Keyless != nil && cosign.IgnoreTransparencyLog:
+			allErrs = append(allErrs, field.Forbidden(f.Child("cosign", "ignoreTransparencyLog"), "transparency log can not be ignored with keyless verification"))
+		}
+
+	case verification.Provenance != nil:
+		if isOCI {
+			allErrs = append(allErrs, field.Forbidden(f.Child("provenance"), "provenance verification can only be used with HTTP(S) URLs"))
+		}
+
+	default:
+		allErrs = append(allErrs, field.Required(f, "no verification method provided"))
+	}
+
+	return allErrs
+}
+
 func validateGitSource(gitSource *appskubermaticv1.GitSource, f *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
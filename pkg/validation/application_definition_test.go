@@ -181,6 +181,27 @@ func TestValidateApplicationDefinitionSpec(t *testing.T) {
 			},
 			0,
 		},
+		"valid kustomize method with git source": {
+			appskubermaticv1.ApplicationDefinition{
+				Spec: func() appskubermaticv1.ApplicationDefinitionSpec {
+					s := spec.DeepCopy()
+					s.Method = appskubermaticv1.KustomizeTemplateMethod
+					s.Versions = []appskubermaticv1.ApplicationVersion{gitv}
+					return *s
+				}(),
+			},
+			0,
+		},
+		"invalid manifests method with helm source": {
+			appskubermaticv1.ApplicationDefinition{
+				Spec: func() appskubermaticv1.ApplicationDefinitionSpec {
+					s := spec.DeepCopy()
+					s.Method = appskubermaticv1.ManifestsTemplateMethod
+					return *s
+				}(),
+			},
+			1,
+		},
 		"invalid missing source": {
 			appskubermaticv1.ApplicationDefinition{
 				Spec: func() appskubermaticv1.ApplicationDefinitionSpec {
@@ -960,6 +981,93 @@ func TestValidateHelmSourceURL(t *testing.T) {
 	}
 }
 
+func TestValidateHelmChartVerification(t *testing.T) {
+	testcases := []struct {
+		name    string
+		source  appskubermaticv1.HelmSource
+		invalid bool
+	}{
+		{
+			name:   "no verification",
+			source: appskubermaticv1.HelmSource{URL: "oci://example.com"},
+		},
+		{
+			name: "cosign with public key for OCI URL",
+			source: appskubermaticv1.HelmSource{
+				URL:          "oci://example.com",
+				Verification: &appskubermaticv1.HelmChartVerification{Cosign: &appskubermaticv1.CosignVerification{PublicKey: secretKeySelector, IgnoreTransparencyLog: true}},
+			},
+		},
+		{
+			name: "cosign keyless for OCI URL",
+			source: appskubermaticv1.HelmSource{
+				URL:          "oci://example.com",
+				Verification: &appskubermaticv1.HelmChartVerification{Cosign: &appskubermaticv1.CosignVerification{Keyless: &appskubermaticv1.CosignKeylessIdentity{Issuer: "https://issuer.example.com", Subject: "me@example.com"}}},
+			},
+		},
+		{
+			name: "cosign for HTTPS URL",
+			source: appskubermaticv1.HelmSource{
+				URL:          "https://example.com",
+				Verification: &appskubermaticv1.HelmChartVerification{Cosign: &appskubermaticv1.CosignVerification{PublicKey: secretKeySelector}},
+			},
+			invalid: true,
+		},
+		{
+			name: "cosign without public key or keyless",
+			source: appskubermaticv1.HelmSource{
+				URL:          "oci://example.com",
+				Verification: &appskubermaticv1.HelmChartVerification{Cosign: &appskubermaticv1.CosignVerification{}},
+			},
+			invalid: true,
+		},
+		{
+			name: "cosign keyless without transparency log",
+			source: appskubermaticv1.HelmSource{
+				URL:          "oci://example.com",
+				Verification: &appskubermaticv1.HelmChartVerification{Cosign: &appskubermaticv1.CosignVerification{Keyless: &appskubermaticv1.CosignKeylessIdentity{Issuer: "https://issuer.example.com", Subject: "me@example.com"}, IgnoreTransparencyLog: true}},
+			},
+			invalid: true,
+		},
+		{
+			name: "provenance for HTTPS URL",
+			source: appskubermaticv1.HelmSource{
+				URL:          "https://example.com",
+				Verification: &appskubermaticv1.HelmChartVerification{Provenance: &appskubermaticv1.ProvenanceVerification{Keyring: *secretKeySelector}},
+			},
+		},
+		{
+			name: "provenance for OCI URL",
+			source: appskubermaticv1.HelmSource{
+				URL:          "oci://example.com",
+				Verification: &appskubermaticv1.HelmChartVerification{Provenance: &appskubermaticv1.ProvenanceVerification{Keyring: *secretKeySelector}},
+			},
+			invalid: true,
+		},
+		{
+			name: "no verification method",
+			source: appskubermaticv1.HelmSource{
+				URL:          "oci://example.com",
+				Verification: &appskubermaticv1.HelmChartVerification{},
+			},
+			invalid: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateHelmChartVerification(&tc.source, nil)
+			if tc.invalid {
+				if len(errs) == 0 {
+					t.Fatal("Expected verification to be invalid, but validation succeeded.")
+				}
+			} else if len(errs) > 0 {
+				t.Fatalf("Expected verification to be valid, but got errors: %v", errs.ToAggregate())
+			}
+		})
+	}
+}
+
 func TestValidateGitCredentials(t *testing.T) {
 	tt := map[string]struct {
 		ad        appskubermaticv1.ApplicationDefinition
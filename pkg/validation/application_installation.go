@@ -19,6 +19,9 @@ package validation
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	semverlib "github.com/Masterminds/semver/v3"
 
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -27,6 +30,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/strings/slices"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +46,12 @@ func ValidateApplicationInstallationSpec(ctx context.Context, client ctrlruntime
 		allErrs = append(allErrs, field.Invalid(specPath.Child("reconciliationInterval"), spec.ReconciliationInterval.Duration.String(), "should be a positive value, or zero to disable"))
 	}
 
+	if constraint := spec.ApplicationRef.VersionConstraint; constraint != "" {
+		if _, err := semverlib.NewConstraint(constraint); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("applicationRef", "versionConstraint"), constraint, fmt.Sprintf("invalid version constraint: %v", err)))
+		}
+	}
+
 	// Ensure that the referenced ApplicationDefinition exists only if applicationInstallation is not deleting (removing finalizer raise an UPDATE event)
 	if ai.DeletionTimestamp.IsZero() {
 		ad := &appskubermaticv1.ApplicationDefinition{}
@@ -222,6 +232,92 @@ func ValidateApplicationInstallationDelete(ctx context.Context, client ctrlrunti
 	return allErrs
 }
 
+// ValidateApplicationInstallationDependencies ensures that the dependencies of the ApplicationInstallation do not
+// introduce a dependency cycle with the other ApplicationInstallations in the user cluster. Dependencies that do not
+// exist yet are allowed, as the ApplicationInstallation is held back until they have been created.
+func ValidateApplicationInstallationDependencies(ctx context.Context, userClient ctrlruntimeclient.Reader, ai appskubermaticv1.ApplicationInstallation) field.ErrorList {
+	dependsOnPath := field.NewPath("spec", "dependsOn")
+	allErrs := field.ErrorList{}
+
+	if len(ai.Spec.DependsOn) == 0 || !ai.DeletionTimestamp.IsZero() {
+		return allErrs
+	}
+
+	key := types.NamespacedName{Namespace: ai.Namespace, Name: ai.Name}
+	seen := sets.New[types.NamespacedName]()
+
+	for i, dependency := range ai.Spec.DependsOn {
+		dependencyKey := ai.DependencyKey(dependency)
+
+		switch {
+		case dependencyKey == key:
+			allErrs = append(allErrs, field.Invalid(dependsOnPath.Index(i), dependency.Name, "an ApplicationInstallation cannot depend on itself"))
+		case seen.Has(dependencyKey):
+			allErrs = append(allErrs, field.Duplicate(dependsOnPath.Index(i), dependencyKey.String()))
+		}
+
+		seen.Insert(dependencyKey)
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	appList := &appskubermaticv1.ApplicationInstallationList{}
+	if err := userClient.List(ctx, appList); err != nil {
+		return append(allErrs, field.InternalError(dependsOnPath, err))
+	}
+
+	installations := map[types.NamespacedName]*appskubermaticv1.ApplicationInstallation{key: &ai}
+	for i := range appList.Items {
+		other := &appList.Items[i]
+		if otherKey := ctrlruntimeclient.ObjectKeyFromObject(other); otherKey != key {
+			installations[otherKey] = other
+		}
+	}
+
+	if cycle := findDependencyCycle(installations, key, key, sets.New[types.NamespacedName]()); cycle != nil {
+		names := make([]string, len(cycle))
+		for i, k := range cycle {
+			names[i] = k.String()
+		}
+
+		allErrs = append(allErrs, field.Invalid(dependsOnPath, ai.Spec.DependsOn, fmt.Sprintf("dependency cycle detected: %s", strings.Join(names, " -> "))))
+	}
+
+	return allErrs
+}
+
+// findDependencyCycle does a depth-first search along the dependencies of the current ApplicationInstallation and returns
+// the path back to the start, if there is one. Cycles that do not contain the start are ignored, as they are not caused by
+// the ApplicationInstallation under validation.
+func findDependencyCycle(installations map[types.NamespacedName]*appskubermaticv1.ApplicationInstallation, start, current types.NamespacedName, visited sets.Set[types.NamespacedName]) []types.NamespacedName {
+	visited.Insert(current)
+
+	installation, exists := installations[current]
+	if !exists {
+		return nil
+	}
+
+	for _, dependency := range installation.Spec.DependsOn {
+		dependencyKey := installation.DependencyKey(dependency)
+
+		if dependencyKey == start {
+			return []types.NamespacedName{current, start}
+		}
+
+		if visited.Has(dependencyKey) {
+			continue
+		}
+
+		if cycle := findDependencyCycle(installations, start, dependencyKey, visited); cycle != nil {
+			return append([]types.NamespacedName{current}, cycle...)
+		}
+	}
+
+	return nil
+}
+
 func validateImmutableLabel(newLabels, oldLabels map[string]string, labelName string) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if newLabels[labelName] != oldLabels[labelName] {
@@ -158,6 +158,26 @@ func TestValidateApplicationInstallationSpec(t *testing.T) {
 				}(),
 			}, expectedError: `[spec.applicationRef.version: Not found: "3.2.3"]`,
 		},
+		{
+			name: "Create ApplicationInstallation Success - Valid VersionConstraint",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.ApplicationRef.VersionConstraint = "~1.2"
+					return *spec
+				}(),
+			}, expectedError: `[]`,
+		},
+		{
+			name: "Create ApplicationInstallation Failure - Invalid VersionConstraint",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.ApplicationRef.VersionConstraint = "latest"
+					return *spec
+				}(),
+			}, expectedError: `[spec.applicationRef.versionConstraint: Invalid value: "latest": invalid version constraint: improper constraint: latest]`,
+		},
 		{
 			name: "Create ApplicationInstallation Success - ReconciliationInterval equals 0",
 			ai: &appskubermaticv1.ApplicationInstallation{
@@ -489,6 +509,77 @@ func TestValidateApplicationInstallationDelete(t *testing.T) {
 	}
 }
 
+func TestValidateApplicationInstallationDependencies(t *testing.T) {
+	genInstallation := func(name string, dependencies ...string) *appskubermaticv1.ApplicationInstallation {
+		ai := getApplicationInstallation(name, defaultAppName, defaultAppVersion, nil)
+		ai.Namespace = "apps"
+
+		for _, dependency := range dependencies {
+			ai.Spec.DependsOn = append(ai.Spec.DependsOn, appskubermaticv1.ApplicationInstallationDependency{Name: dependency})
+		}
+
+		return ai
+	}
+
+	fakeClient := fake.
+		NewClientBuilder().
+		WithObjects(
+			genInstallation("cert-manager"),
+			genInstallation("ingress", "cert-manager"),
+			genInstallation("app", "ingress"),
+			// a pre-existing cycle that does not involve the validated installations
+			genInstallation("x", "y"),
+			genInstallation("y", "x"),
+		).
+		Build()
+
+	testCases := []struct {
+		name          string
+		ai            *appskubermaticv1.ApplicationInstallation
+		expectedError string
+	}{
+		{
+			name:          "scenario 1: dependencies without a cycle are allowed",
+			ai:            genInstallation("monitoring", "ingress", "cert-manager"),
+			expectedError: "[]",
+		},
+		{
+			name:          "scenario 2: dependencies that do not exist yet are allowed",
+			ai:            genInstallation("monitoring", "does-not-exist"),
+			expectedError: "[]",
+		},
+		{
+			name:          "scenario 3: depending on itself is not allowed",
+			ai:            genInstallation("monitoring", "monitoring"),
+			expectedError: `[spec.dependsOn[0]: Invalid value: "monitoring": an ApplicationInstallation cannot depend on itself]`,
+		},
+		{
+			name:          "scenario 4: duplicate dependencies are not allowed",
+			ai:            genInstallation("monitoring", "ingress", "ingress"),
+			expectedError: `[spec.dependsOn[1]: Duplicate value: "apps/ingress"]`,
+		},
+		{
+			name:          "scenario 5: updating an installation to create a cycle is not allowed",
+			ai:            genInstallation("cert-manager", "app"),
+			expectedError: `[spec.dependsOn: Invalid value: [{"name":"app"}]: dependency cycle detected: apps/cert-manager -> apps/app -> apps/ingress -> apps/cert-manager]`,
+		},
+		{
+			name:          "scenario 6: cycles not involving the installation are ignored",
+			ai:            genInstallation("monitoring", "x"),
+			expectedError: "[]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateApplicationInstallationDependencies(context.Background(), fakeClient, *testCase.ai)
+			if fmt.Sprint(err) != testCase.expectedError {
+				t.Fatalf("expected error to be %s but got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
 func getApplicationDefinition(name string, defaulted, enforced bool, datacenters []string, labels map[string]string) *appskubermaticv1.ApplicationDefinition {
 	return &appskubermaticv1.ApplicationDefinition{
 		TypeMeta: metav1.TypeMeta{
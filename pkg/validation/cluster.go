@@ -36,6 +36,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/cloud/gcp"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	encryptionresources "k8c.io/kubermatic/v2/pkg/resources/encryption"
 	"k8c.io/kubermatic/v2/pkg/version"
 	clusterversion "k8c.io/kubermatic/v2/pkg/version/cluster"
 
@@ -334,9 +335,43 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("typeMeta"), "type meta cannot be changed"))
 	}
 
+	if newCluster.Spec.DeletionProtection {
+		_, requested := newCluster.Annotations[kubermaticv1.DeletionRequestedAnnotation]
+		_, wasRequested := oldCluster.Annotations[kubermaticv1.DeletionRequestedAnnotation]
+
+		if requested && !wasRequested {
+			path := field.NewPath("metadata", "annotations").Key(kubermaticv1.DeletionRequestedAnnotation)
+			allErrs = append(allErrs, field.Forbidden(path, "deletion cannot be requested while the cluster has deletion protection enabled"))
+		}
+	}
+
 	return allErrs
 }
 
+// ValidateClusterDeletion checks whether the given cluster may be deleted at the given time.
+// Clusters with deletion protection can never be deleted. If the seed configures a cluster
+// deletion grace period, clusters can only be deleted once their scheduled deletion is due.
+func ValidateClusterDeletion(cluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed, now time.Time) error {
+	if cluster.Spec.DeletionProtection {
+		return errors.New("cluster has deletion protection enabled, disable spec.deletionProtection first")
+	}
+
+	if seed == nil || seed.Spec.ClusterDeletionGracePeriod == nil || seed.Spec.ClusterDeletionGracePeriod.Duration <= 0 {
+		return nil
+	}
+
+	scheduled := cluster.Status.ScheduledDeletion
+	if scheduled == nil {
+		return fmt.Errorf("clusters on this seed cannot be deleted directly, annotate the cluster with %q to schedule its deletion", kubermaticv1.DeletionRequestedAnnotation)
+	}
+
+	if now.Before(scheduled.DeleteAt.Time) {
+		return fmt.Errorf("cluster is scheduled for deletion at %s", scheduled.DeleteAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
 func ValidateVersion(spec *kubermaticv1.ClusterSpec, versionManager *version.Manager, currentVersion *semver.Semver, fldPath *field.Path) *field.Error {
 	if spec.Version.Semver() == nil || spec.Version.String() == "" {
 		return field.Required(fldPath, "version is required but was not specified")
@@ -506,12 +541,29 @@ func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *
 				fmt.Sprintf("cannot enable encryption configuration if feature gate '%s' is not set", kubermaticv1.ClusterFeatureEncryptionAtRest)))
 		}
 
-		// TODO: Update with implementations of other encryption providers (KMS)
-
-		if spec.EncryptionConfiguration.Secretbox == nil {
+		if spec.EncryptionConfiguration.Secretbox == nil && spec.EncryptionConfiguration.KMS == nil {
 			allErrs = append(allErrs, field.Required(fieldPath.Child("secretbox"),
-				"exactly one encryption provider (secretbox, kms) needs to be configured"))
-		} else {
+				"at least one encryption provider (secretbox, kms) needs to be configured"))
+		}
+
+		if kms := spec.EncryptionConfiguration.KMS; kms != nil {
+			childPath := fieldPath.Child("kms")
+			if kms.Name == "" {
+				allErrs = append(allErrs, field.Required(childPath.Child("name"), "KMS provider name is required"))
+			} else if strings.Contains(kms.Name, ":") {
+				allErrs = append(allErrs, field.Invalid(childPath.Child("name"), kms.Name, "KMS provider name must not contain ':'"))
+			}
+
+			if kms.Image == "" {
+				allErrs = append(allErrs, field.Required(childPath.Child("image"), "KMS plugin image is required"))
+			}
+
+			if kms.Timeout != nil && kms.Timeout.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(childPath.Child("timeout"), kms.Timeout.String(), "timeout must be positive"))
+			}
+		}
+
+		if spec.EncryptionConfiguration.Secretbox != nil {
 			for i, key := range spec.EncryptionConfiguration.Secretbox.Keys {
 				childPath := fieldPath.Child("secretbox", "keys").Index(i)
 				if key.Name == "" {
@@ -536,8 +588,6 @@ func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *
 				}
 			}
 		}
-
-		// END TODO
 	}
 
 	return allErrs
@@ -570,6 +620,17 @@ func validateEncryptionUpdate(oldCluster *kubermaticv1.Cluster, newCluster *kube
 				}
 			}
 
+			// Data encrypted through a KMS plugin can only be read as long as the plugin is running, so the
+			// provider must stay in place until all data has been re-encrypted with another provider or
+			// encryption has been disabled entirely.
+			if activeKMS, ok := strings.CutPrefix(oldCluster.Status.Encryption.ActiveKey, encryptionresources.KMSPrefix+"/"); ok {
+				if newCluster.Spec.EncryptionConfiguration == nil || newCluster.Spec.EncryptionConfiguration.KMS == nil || newCluster.Spec.EncryptionConfiguration.KMS.Name != activeKMS {
+					allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "encryptionConfiguration", "kms"),
+						fmt.Sprintf("KMS provider %q cannot be removed or renamed while data is encrypted with it, please disable encryption first", activeKMS),
+					))
+				}
+			}
+
 			encryptionConfigExists :=
 				oldCluster.Spec.EncryptionConfiguration != nil &&
 					newCluster.Spec.EncryptionConfiguration != nil
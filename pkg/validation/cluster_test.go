@@ -23,6 +23,7 @@ import (
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/version"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
@@ -1098,6 +1100,63 @@ func TestValidateEncryptionConfiguration(t *testing.T) {
 			},
 			expectErr: field.ErrorList{},
 		},
+		{
+			name: "KMS provider",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+					KMS: &kubermaticv1.KMSEncryptionConfiguration{
+						Name:  "vault",
+						Image: "registry.example.com/vault-kms-plugin:v1.0.0",
+					},
+				},
+			},
+			expectErr: field.ErrorList{},
+		},
+		{
+			name: "KMS provider without image",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+					KMS: &kubermaticv1.KMSEncryptionConfiguration{
+						Name: "vault",
+					},
+				},
+			},
+			expectErr: field.ErrorList{
+				&field.Error{
+					Type:     "FieldValueRequired",
+					Field:    "spec.encryptionConfiguration.kms.image",
+					BadValue: "",
+					Detail:   "KMS plugin image is required",
+				},
+			},
+		},
+		{
+			name: "no provider",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+				},
+			},
+			expectErr: field.ErrorList{
+				&field.Error{
+					Type:     "FieldValueRequired",
+					Field:    "spec.encryptionConfiguration.secretbox",
+					BadValue: "",
+					Detail:   "at least one encryption provider (secretbox, kms) needs to be configured",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -1403,3 +1462,160 @@ func TestValidateCoreDNSReplicas(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateClusterDeletion(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	gracefulSeed := &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			ClusterDeletionGracePeriod: &metav1.Duration{Duration: 24 * time.Hour},
+		},
+	}
+
+	scheduledAt := func(deleteAt time.Time) *kubermaticv1.ClusterScheduledDeletionStatus {
+		return &kubermaticv1.ClusterScheduledDeletionStatus{
+			RequestedAt: metav1.NewTime(deleteAt.Add(-24 * time.Hour)),
+			DeleteAt:    metav1.NewTime(deleteAt),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		seed      *kubermaticv1.Seed
+		scheduled *kubermaticv1.ClusterScheduledDeletionStatus
+		protected bool
+		valid     bool
+	}{
+		{
+			name:  "seed without grace period",
+			seed:  &kubermaticv1.Seed{},
+			valid: true,
+		},
+		{
+			name:  "unknown seed",
+			valid: true,
+		},
+		{
+			name:      "protected cluster",
+			seed:      &kubermaticv1.Seed{},
+			protected: true,
+			valid:     false,
+		},
+		{
+			name:      "protected cluster on unknown seed",
+			protected: true,
+			valid:     false,
+		},
+		{
+			name:  "direct deletion with grace period",
+			seed:  gracefulSeed,
+			valid: false,
+		},
+		{
+			name:      "scheduled deletion not yet due",
+			seed:      gracefulSeed,
+			scheduled: scheduledAt(now.Add(time.Hour)),
+			valid:     false,
+		},
+		{
+			name:      "scheduled deletion due",
+			seed:      gracefulSeed,
+			scheduled: scheduledAt(now.Add(-time.Minute)),
+			valid:     true,
+		},
+		{
+			name:      "scheduled deletion due but protected",
+			seed:      gracefulSeed,
+			scheduled: scheduledAt(now.Add(-time.Minute)),
+			protected: true,
+			valid:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					DeletionProtection: test.protected,
+				},
+				Status: kubermaticv1.ClusterStatus{
+					ScheduledDeletion: test.scheduled,
+				},
+			}
+
+			err := ValidateClusterDeletion(cluster, test.seed, now)
+			if (err == nil) != test.valid {
+				t.Errorf("Expected valid=%v, but got err=%v", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestValidateClusterUpdateDeletionRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		protected  bool
+		oldRequest bool
+		newRequest bool
+		valid      bool
+	}{
+		{
+			name:       "requesting deletion",
+			newRequest: true,
+			valid:      true,
+		},
+		{
+			name:       "requesting deletion of protected cluster",
+			protected:  true,
+			newRequest: true,
+			valid:      false,
+		},
+		{
+			name:       "enabling protection on cluster with pending deletion",
+			protected:  true,
+			oldRequest: true,
+			newRequest: true,
+			valid:      true,
+		},
+		{
+			name:       "cancelling deletion of protected cluster",
+			protected:  true,
+			oldRequest: true,
+			valid:      true,
+		},
+	}
+
+	annotations := func(requested bool) map[string]string {
+		if !requested {
+			return nil
+		}
+
+		return map[string]string{kubermaticv1.DeletionRequestedAnnotation: ""}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldCluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Annotations: annotations(test.oldRequest)},
+			}
+			newCluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Annotations: annotations(test.newRequest)},
+				Spec: kubermaticv1.ClusterSpec{
+					DeletionProtection: test.protected,
+				},
+			}
+
+			path := field.NewPath("metadata", "annotations").Key(kubermaticv1.DeletionRequestedAnnotation).String()
+			found := false
+			for _, err := range ValidateClusterUpdate(context.Background(), newCluster, oldCluster, &kubermaticv1.Datacenter{}, &kubermaticv1.Seed{}, nil, nil, features.FeatureGate{}) {
+				if err.Field == path {
+					found = true
+				}
+			}
+
+			if found == test.valid {
+				t.Errorf("Expected valid=%v, but got found=%v", test.valid, found)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/util/clustertemplate"
 	"k8c.io/kubermatic/v2/pkg/version"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -65,6 +66,8 @@ func ValidateClusterTemplate(ctx context.Context, template *kubermaticv1.Cluster
 		}
 	}
 
+	allErrs = append(allErrs, clustertemplate.ValidateParameters(template, parentFieldPath.Child("parameters"))...)
+
 	// For seed scope ClusterTemplate, cloud provider specification configurations are not allowed.
 	if scope == kubermaticv1.SeedTemplateScope {
 		cloudSpecPath := field.NewPath("spec", "cloud")
@@ -26,6 +26,7 @@ import (
 	"github.com/distribution/reference"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/version"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -45,6 +46,13 @@ func ValidateKubermaticConfigurationSpec(spec *kubermaticv1.KubermaticConfigurat
 		allErrs = append(allErrs, errs...)
 	}
 
+	if threshold := spec.UserCluster.CertificateRenewalThreshold; threshold != nil {
+		if threshold.Duration <= 0 || threshold.Duration > resources.MaximumCertificateRenewalThreshold {
+			msg := fmt.Sprintf("must be greater than 0 and at most %v, so that certificates are not renewed constantly", resources.MaximumCertificateRenewalThreshold)
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "userCluster", "certificateRenewalThreshold"), threshold.Duration.String(), msg))
+		}
+	}
+
 	return allErrs
 }
 
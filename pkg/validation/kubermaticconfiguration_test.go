@@ -18,10 +18,12 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/sdk/v2/semver"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -281,3 +283,56 @@ func TestValidateMirrorImages(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCertificateRenewalThreshold(t *testing.T) {
+	testcases := []struct {
+		name      string
+		threshold *metav1.Duration
+		valid     bool
+	}{
+		{
+			name:      "no threshold configured",
+			threshold: nil,
+			valid:     true,
+		},
+		{
+			name:      "default threshold",
+			threshold: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			valid:     true,
+		},
+		{
+			name:      "zero threshold",
+			threshold: &metav1.Duration{},
+			valid:     false,
+		},
+		{
+			name:      "negative threshold",
+			threshold: &metav1.Duration{Duration: -time.Hour},
+			valid:     false,
+		},
+		{
+			name:      "threshold close to the certificate validity",
+			threshold: &metav1.Duration{Duration: 360 * 24 * time.Hour},
+			valid:     false,
+		},
+	}
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &kubermaticv1.KubermaticConfigurationSpec{}
+			spec.UserCluster.CertificateRenewalThreshold = tt.threshold
+			version := semver.NewSemverOrDie("v1.11.1")
+			spec.Versions.Default = version
+			spec.Versions.Versions = append(spec.Versions.Versions, *version)
+			errs := ValidateKubermaticConfigurationSpec(spec)
+			if tt.valid {
+				if len(errs) > 0 {
+					t.Fatalf("Expected configuration to be valid, but got errors: %v", errs.ToAggregate())
+				}
+			} else {
+				if len(errs) == 0 {
+					t.Fatal("Expected configuration to be invalid, but it was accepted.")
+				}
+			}
+		})
+	}
+}
@@ -40,15 +40,18 @@ type AdmissionHandler struct {
 	log         *zap.SugaredLogger
 	decoder     admission.Decoder
 	client      ctrlruntimeclient.Client
+	userClient  ctrlruntimeclient.Reader
 	clusterName string
 }
 
-// NewAdmissionHandler returns a new validation AdmissionHandler.
-func NewAdmissionHandler(log *zap.SugaredLogger, scheme *runtime.Scheme, client ctrlruntimeclient.Client, clusterName string) *AdmissionHandler {
+// NewAdmissionHandler returns a new validation AdmissionHandler. The client is used to access the seed cluster,
+// the userClient to access the other ApplicationInstallations in the user cluster.
+func NewAdmissionHandler(log *zap.SugaredLogger, scheme *runtime.Scheme, client ctrlruntimeclient.Client, userClient ctrlruntimeclient.Reader, clusterName string) *AdmissionHandler {
 	return &AdmissionHandler{
 		log:         log,
 		decoder:     admission.NewDecoder(scheme),
 		client:      client,
+		userClient:  userClient,
 		clusterName: clusterName,
 	}
 }
@@ -68,6 +71,7 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 			return webhook.Errored(http.StatusBadRequest, err)
 		}
 		allErrs = append(allErrs, validation.ValidateApplicationInstallationSpec(ctx, h.client, *ad)...)
+		allErrs = append(allErrs, validation.ValidateApplicationInstallationDependencies(ctx, h.userClient, *ad)...)
 
 	case admissionv1.Update:
 		if err := h.decoder.Decode(req, ad); err != nil {
@@ -77,6 +81,7 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 			return webhook.Errored(http.StatusBadRequest, err)
 		}
 		allErrs = append(allErrs, validation.ValidateApplicationInstallationUpdate(ctx, h.client, *ad, *oldAD)...)
+		allErrs = append(allErrs, validation.ValidateApplicationInstallationDependencies(ctx, h.userClient, *ad)...)
 
 	case admissionv1.Delete:
 		if err := h.decoder.DecodeRaw(req.OldObject, ad); err != nil {
@@ -135,9 +135,10 @@ func TestValidateApplicationInstallation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			handler := AdmissionHandler{
-				log:     zap.NewNop().Sugar(),
-				decoder: admission.NewDecoder(testScheme),
-				client:  fakeClient,
+				log:        zap.NewNop().Sugar(),
+				decoder:    admission.NewDecoder(testScheme),
+				client:     fakeClient,
+				userClient: fakeClient,
 			}
 
 			if res := handler.Handle(context.Background(), tt.req); res.Allowed != tt.wantAllowed {
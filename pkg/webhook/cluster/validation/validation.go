@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
@@ -99,6 +100,10 @@ func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 		errs = append(errs, err)
 	}
 
+	if err := validateQuotas(ctx, v.client, cluster); err != nil {
+		errs = append(errs, field.Forbidden(field.NewPath("metadata", "labels"), err.Error()))
+	}
+
 	return nil, errs.ToAggregate()
 }
 
@@ -135,7 +140,17 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 }
 
 func (v *validator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+	cluster, ok := obj.(*kubermaticv1.Cluster)
+	if !ok {
+		return nil, errors.New("object is not a Cluster")
+	}
+
+	seed, err := v.seedGetter()
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, validation.ValidateClusterDeletion(cluster, seed, time.Now())
 }
 
 func (v *validator) buildValidationDependencies(ctx context.Context, c *kubermaticv1.Cluster) (*kubermaticv1.Datacenter, *kubermaticv1.Seed, provider.CloudProvider, *field.Error) {
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/sdk/v2/semver"
@@ -108,6 +109,7 @@ func TestHandle(t *testing.T) {
 		cluster     kubermaticv1.Cluster
 		oldCluster  *kubermaticv1.Cluster
 		datacenter  *kubermaticv1.Datacenter
+		gracePeriod *metav1.Duration
 		wantAllowed bool
 	}{
 		{
@@ -136,6 +138,52 @@ func TestHandle(t *testing.T) {
 			}.Build(),
 			wantAllowed: true,
 		},
+		{
+			name: "Delete protected cluster fails",
+			op:   admissionv1.Delete,
+			cluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy:     "Tunneling",
+				DeletionProtection: true,
+			}.Build(),
+			wantAllowed: false,
+		},
+		{
+			name: "Delete cluster directly fails on seed with deletion grace period",
+			op:   admissionv1.Delete,
+			cluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy: "Tunneling",
+			}.Build(),
+			gracePeriod: &metav1.Duration{Duration: 24 * time.Hour},
+			wantAllowed: false,
+		},
+		{
+			name: "Delete cluster with due scheduled deletion succeeds on seed with deletion grace period",
+			op:   admissionv1.Delete,
+			cluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy: "Tunneling",
+				ScheduledDeletion: &kubermaticv1.ClusterScheduledDeletionStatus{
+					RequestedAt: metav1.NewTime(time.Now().Add(-25 * time.Hour)),
+					DeleteAt:    metav1.NewTime(time.Now().Add(-time.Hour)),
+				},
+			}.Build(),
+			gracePeriod: &metav1.Duration{Duration: 24 * time.Hour},
+			wantAllowed: true,
+		},
 		{
 			name: "Create cluster with Tunneling expose strategy succeeds when the FeatureGate is enabled",
 			op:   admissionv1.Create,
@@ -1624,6 +1672,8 @@ func TestHandle(t *testing.T) {
 				}
 			}
 
+			testSeed.Spec.ClusterDeletionGracePeriod = tt.gracePeriod
+
 			seedClient := fake.
 				NewClientBuilder().
 				WithScheme(testScheme).
@@ -1676,6 +1726,8 @@ type rawClusterGen struct {
 	UsePodSecurityPolicyAdmissionPlugin bool
 	AdmissionPlugins                    []string
 	Version                             *semver.Semver
+	DeletionProtection                  bool
+	ScheduledDeletion                   *kubermaticv1.ClusterScheduledDeletionStatus
 }
 
 func (r rawClusterGen) BuildPtr() *kubermaticv1.Cluster {
@@ -1721,6 +1773,10 @@ func (r rawClusterGen) Build() kubermaticv1.Cluster {
 			CNIPlugin:                           r.CNIPlugin,
 			UsePodSecurityPolicyAdmissionPlugin: r.UsePodSecurityPolicyAdmissionPlugin,
 			AdmissionPlugins:                    r.AdmissionPlugins,
+			DeletionProtection:                  r.DeletionProtection,
+		},
+		Status: kubermaticv1.ClusterStatus{
+			ScheduledDeletion: r.ScheduledDeletion,
 		},
 	}
 
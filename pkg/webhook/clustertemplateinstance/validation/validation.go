@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/util/clustertemplate"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// validator for validating Kubermatic ClusterTemplateInstance CRs.
+type validator struct {
+	client ctrlruntimeclient.Client
+}
+
+// NewValidator returns a new cluster template instance validator.
+func NewValidator(client ctrlruntimeclient.Client) *validator {
+	return &validator{
+		client: client,
+	}
+}
+
+var _ admission.CustomValidator = &validator{}
+
+func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(ctx, obj)
+}
+
+func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldInstance, ok := oldObj.(*kubermaticv1.ClusterTemplateInstance)
+	if !ok {
+		return nil, errors.New("existing object is not a ClusterTemplateInstance")
+	}
+
+	newInstance, ok := newObj.(*kubermaticv1.ClusterTemplateInstance)
+	if !ok {
+		return nil, errors.New("updated object is not a ClusterTemplateInstance")
+	}
+
+	// The cluster-template-controller decreases the replicas while creating clusters;
+	// this must not fail just because the template has been changed in the meantime.
+	if oldInstance.Spec.ClusterTemplateID == newInstance.Spec.ClusterTemplateID && maps.Equal(oldInstance.Spec.Values, newInstance.Spec.Values) {
+		return nil, nil
+	}
+
+	return nil, v.validate(ctx, newObj)
+}
+
+func (v *validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *validator) validate(ctx context.Context, obj runtime.Object) error {
+	instance, ok := obj.(*kubermaticv1.ClusterTemplateInstance)
+	if !ok {
+		return errors.New("object is not a ClusterTemplateInstance")
+	}
+
+	template := &kubermaticv1.ClusterTemplate{}
+	if err := v.client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: instance.Spec.ClusterTemplateID}, template); err != nil {
+		return fmt.Errorf("failed to get ClusterTemplate %q: %w", instance.Spec.ClusterTemplateID, err)
+	}
+
+	_, errs := clustertemplate.ResolveValues(template.Parameters, instance.Spec.Values, field.NewPath("spec", "values"))
+
+	return errs.ToAggregate()
+}
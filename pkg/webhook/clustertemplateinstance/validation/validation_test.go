@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/test/generator"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/utils/ptr"
+)
+
+func genTemplate() *kubermaticv1.ClusterTemplate {
+	template := generator.GenClusterTemplate("template", "templateID", "project", kubermaticv1.ProjectClusterTemplateScope, "bob@acme.com")
+	template.Parameters = []kubermaticv1.ClusterTemplateParameter{
+		{
+			Name:    "workers",
+			Type:    kubermaticv1.ClusterTemplateParameterTypeInteger,
+			Paths:   []string{"/initialMachineDeployment/spec/replicas"},
+			Default: ptr.To("3"),
+			Maximum: ptr.To[int64](10),
+		},
+		{
+			Name:  "datacenter",
+			Type:  kubermaticv1.ClusterTemplateParameterTypeString,
+			Paths: []string{"/spec/cloud/dc"},
+		},
+	}
+
+	return template
+}
+
+func genInstance(templateID string, values map[string]string) *kubermaticv1.ClusterTemplateInstance {
+	instance := generator.GenClusterTemplateInstance("project", templateID, "bob@acme.com", 1)
+	instance.Spec.Values = values
+
+	return instance
+}
+
+func TestValidator(t *testing.T) {
+	testCases := []struct {
+		name        string
+		op          admissionv1.Operation
+		instance    *kubermaticv1.ClusterTemplateInstance
+		oldInstance *kubermaticv1.ClusterTemplateInstance
+		expectedErr bool
+	}{
+		{
+			name:     "valid values",
+			op:       admissionv1.Create,
+			instance: genInstance("templateID", map[string]string{"datacenter": "dc", "workers": "5"}),
+		},
+		{
+			name:        "missing required value",
+			op:          admissionv1.Create,
+			instance:    genInstance("templateID", map[string]string{"workers": "5"}),
+			expectedErr: true,
+		},
+		{
+			name:        "value out of range",
+			op:          admissionv1.Create,
+			instance:    genInstance("templateID", map[string]string{"datacenter": "dc", "workers": "50"}),
+			expectedErr: true,
+		},
+		{
+			name:        "unknown template",
+			op:          admissionv1.Create,
+			instance:    genInstance("otherID", nil),
+			expectedErr: true,
+		},
+		{
+			name:        "changed values are validated",
+			op:          admissionv1.Update,
+			oldInstance: genInstance("templateID", map[string]string{"datacenter": "dc"}),
+			instance:    genInstance("templateID", map[string]string{"datacenter": "dc", "workers": "many"}),
+			expectedErr: true,
+		},
+		{
+			name:        "unchanged values are not validated again",
+			op:          admissionv1.Update,
+			oldInstance: genInstance("templateID", map[string]string{"region": "eu"}),
+			instance: func() *kubermaticv1.ClusterTemplateInstance {
+				instance := genInstance("templateID", map[string]string{"region": "eu"})
+				instance.Spec.Replicas = 0
+				return instance
+			}(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithObjects(genTemplate()).Build()
+			validator := NewValidator(client)
+
+			ctx := context.Background()
+			var err error
+
+			switch tc.op {
+			case admissionv1.Create:
+				_, err = validator.ValidateCreate(ctx, tc.instance)
+			case admissionv1.Update:
+				_, err = validator.ValidateUpdate(ctx, tc.oldInstance, tc.instance)
+			}
+
+			if tc.expectedErr != (err != nil) {
+				t.Fatalf("Expected error: %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
@@ -19,49 +19,41 @@ package validation
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"go.uber.org/zap"
 
-	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/selection"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 // validator for validating Kubermatic Machine CRD.
 type validator struct {
-	log             *zap.SugaredLogger
-	seedClient      ctrlruntimeclient.Client
-	userClient      ctrlruntimeclient.Client
-	caBundle        *certificates.CABundle
-	subjectSelector labels.Selector
+	log        *zap.SugaredLogger
+	seedClient ctrlruntimeclient.Client
+	userClient ctrlruntimeclient.Client
+	caBundle   *certificates.CABundle
+	projectID  string
+	datacenter string
 }
 
 // NewValidator returns a new Machine validator.
 func NewValidator(seedClient, userClient ctrlruntimeclient.Client, log *zap.SugaredLogger, caBundle *certificates.CABundle,
-	projectID string) (*validator, error) {
-	subjectNameReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectNameLabelKey, selection.Equals, []string{projectID})
-	if err != nil {
-		return nil, fmt.Errorf("error creating resource quota subject name requirement: %w", err)
-	}
-	subjectKindReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectKindLabelKey, selection.Equals, []string{kubermaticv1.ProjectSubjectKind})
-	if err != nil {
-		return nil, fmt.Errorf("error creating resource quota subject kind requirement: %w", err)
+	projectID, datacenter string) (*validator, error) {
+	if projectID == "" {
+		return nil, errors.New("project ID must not be empty")
 	}
-	subjectSelector := labels.NewSelector().Add(*subjectNameReq, *subjectKindReq)
 
 	return &validator{
-		log:             log,
-		seedClient:      seedClient,
-		userClient:      userClient,
-		caBundle:        caBundle,
-		subjectSelector: subjectSelector,
+		log:        log,
+		seedClient: seedClient,
+		userClient: userClient,
+		caBundle:   caBundle,
+		projectID:  projectID,
+		datacenter: datacenter,
 	}, nil
 }
 
@@ -76,14 +68,12 @@ func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 	log := v.log.With("machine", machine.Name)
 	log.Debug("validating create")
 
-	quota, err := getResourceQuota(ctx, v.seedClient, v.subjectSelector)
+	quotas, err := getResourceQuotas(ctx, v.seedClient, v.projectID, v.datacenter)
 	if err != nil {
 		return nil, err
 	}
-	if quota != nil {
-		return nil, validateQuota(ctx, log, v.userClient, machine, v.caBundle, quota)
-	}
-	return nil, nil
+
+	return validateQuotas(ctx, log, v.userClient, machine, v.caBundle, quotas)
 }
 
 // ValidateUpdate validates Machine updates. As mutating Machine spec is disallowed by the Machine Mutating webhook,
@@ -27,16 +27,16 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
-	"k8s.io/apimachinery/pkg/labels"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-func validateQuota(_ context.Context, _ *zap.SugaredLogger, _ ctrlruntimeclient.Client, _ *clusterv1alpha1.Machine,
-	_ *certificates.CABundle, _ *kubermaticv1.ResourceQuota) error {
-	return nil
+func validateQuotas(_ context.Context, _ *zap.SugaredLogger, _ ctrlruntimeclient.Client, _ *clusterv1alpha1.Machine,
+	_ *certificates.CABundle, _ []kubermaticv1.ResourceQuota) (admission.Warnings, error) {
+	return nil, nil
 }
 
 // Resource Quotas are an EE feature
-func getResourceQuota(_ context.Context, _ ctrlruntimeclient.Client, _ labels.Selector) (*kubermaticv1.ResourceQuota, error) {
+func getResourceQuotas(_ context.Context, _ ctrlruntimeclient.Client, _, _ string) ([]kubermaticv1.ResourceQuota, error) {
 	return nil, nil
 }
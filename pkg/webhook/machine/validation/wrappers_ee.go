@@ -20,35 +20,24 @@ package validation
 
 import (
 	"context"
-	"fmt"
 
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/ee/resource-quota/subject"
 	eemachinevalidation "k8c.io/kubermatic/v2/pkg/ee/validation/machine"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
-	"k8s.io/apimachinery/pkg/labels"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-func validateQuota(ctx context.Context, log *zap.SugaredLogger, userClient ctrlruntimeclient.Client,
-	machine *clusterv1alpha1.Machine, caBundle *certificates.CABundle, resourceQuota *kubermaticv1.ResourceQuota) error {
-	return eemachinevalidation.ValidateQuota(ctx, log, userClient, machine, caBundle, resourceQuota)
+func validateQuotas(ctx context.Context, log *zap.SugaredLogger, userClient ctrlruntimeclient.Client,
+	machine *clusterv1alpha1.Machine, caBundle *certificates.CABundle, resourceQuotas []kubermaticv1.ResourceQuota) (admission.Warnings, error) {
+	return eemachinevalidation.ValidateQuotas(ctx, log, userClient, machine, caBundle, resourceQuotas)
 }
 
-func getResourceQuota(ctx context.Context, seedClient ctrlruntimeclient.Client, subjectSelector labels.Selector) (*kubermaticv1.ResourceQuota, error) {
-	quotaList := &kubermaticv1.ResourceQuotaList{}
-	if err := seedClient.List(ctx, quotaList, &ctrlruntimeclient.ListOptions{
-		LabelSelector: subjectSelector,
-	}); err != nil {
-		return nil, fmt.Errorf("failed to list resource quotas: %w", err)
-	}
-
-	if len(quotaList.Items) == 0 {
-		return nil, nil
-	}
-
-	return &quotaList.Items[0], nil
+func getResourceQuotas(ctx context.Context, seedClient ctrlruntimeclient.Client, projectID, datacenter string) ([]kubermaticv1.ResourceQuota, error) {
+	return subject.ResourceQuotasForCluster(ctx, seedClient, projectID, datacenter)
 }
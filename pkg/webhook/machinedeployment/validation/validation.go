@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// validator for validating MachineDeployments. MachineDeployments are never rejected, as resource quotas
+// are enforced for their Machines, but warnings are returned if they would reach the soft thresholds of quotas.
+type validator struct {
+	log        *zap.SugaredLogger
+	seedClient ctrlruntimeclient.Client
+	userClient ctrlruntimeclient.Client
+	caBundle   *certificates.CABundle
+	projectID  string
+	datacenter string
+}
+
+// NewValidator returns a new MachineDeployment validator.
+func NewValidator(seedClient, userClient ctrlruntimeclient.Client, log *zap.SugaredLogger, caBundle *certificates.CABundle,
+	projectID, datacenter string) *validator {
+	return &validator{
+		log:        log,
+		seedClient: seedClient,
+		userClient: userClient,
+		caBundle:   caBundle,
+		projectID:  projectID,
+		datacenter: datacenter,
+	}
+}
+
+var _ admission.CustomValidator = &validator{}
+
+func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	md, ok := obj.(*clusterv1alpha1.MachineDeployment)
+	if !ok {
+		return nil, errors.New("object is not a MachineDeployment")
+	}
+
+	return v.quotaWarnings(ctx, md, replicas(md))
+}
+
+// ValidateUpdate returns warnings if a MachineDeployment is scaled up.
+func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldMD, ok := oldObj.(*clusterv1alpha1.MachineDeployment)
+	if !ok {
+		return nil, errors.New("old object is not a MachineDeployment")
+	}
+
+	newMD, ok := newObj.(*clusterv1alpha1.MachineDeployment)
+	if !ok {
+		return nil, errors.New("new object is not a MachineDeployment")
+	}
+
+	return v.quotaWarnings(ctx, newMD, replicas(newMD)-replicas(oldMD))
+}
+
+func (v *validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *validator) quotaWarnings(ctx context.Context, md *clusterv1alpha1.MachineDeployment, additionalReplicas int64) (admission.Warnings, error) {
+	if additionalReplicas <= 0 {
+		return nil, nil
+	}
+
+	log := v.log.With("machinedeployment", md.Name)
+	log.Debug("checking resource quota soft thresholds")
+
+	// Never reject MachineDeployments because of the warnings, the quota is enforced for the Machines.
+	quotas, err := getResourceQuotas(ctx, v.seedClient, v.projectID, v.datacenter)
+	if err != nil {
+		log.Debugw("failed to get resource quotas", zap.Error(err))
+		return nil, nil
+	}
+
+	machine := &clusterv1alpha1.Machine{
+		ObjectMeta: md.Spec.Template.ObjectMeta,
+		Spec:       md.Spec.Template.Spec,
+	}
+	machine.Namespace = md.Namespace
+
+	warnings, err := quotaWarnings(ctx, v.userClient, machine, v.caBundle, additionalReplicas, quotas)
+	if err != nil {
+		log.Debugw("failed to check resource quota soft thresholds", zap.Error(err))
+		return nil, nil
+	}
+
+	return warnings, nil
+}
+
+func replicas(md *clusterv1alpha1.MachineDeployment) int64 {
+	if md.Spec.Replicas == nil {
+		return 1
+	}
+
+	return int64(*md.Spec.Replicas)
+}
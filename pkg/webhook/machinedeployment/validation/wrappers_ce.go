@@ -0,0 +1,40 @@
+//go:build !ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func quotaWarnings(_ context.Context, _ ctrlruntimeclient.Client, _ *clusterv1alpha1.Machine, _ *certificates.CABundle,
+	_ int64, _ []kubermaticv1.ResourceQuota) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// Resource Quotas are an EE feature
+func getResourceQuotas(_ context.Context, _ ctrlruntimeclient.Client, _, _ string) ([]kubermaticv1.ResourceQuota, error) {
+	return nil, nil
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/prometheus/common/model"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// expressionError is an error in an expression, the line is relative to the start of the expression.
+type expressionError struct {
+	line int
+	msg  string
+}
+
+func expressionErrorf(line int, format string, args ...interface{}) *expressionError {
+	return &expressionError{line: line, msg: fmt.Sprintf(format, args...)}
+}
+
+// expressionSummary describes the structure of a scanned expression.
+type expressionSummary struct {
+	selectors int
+	ranges    int
+}
+
+var closingBrackets = map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+// keywordOperators are the binary operators that are written as words.
+var keywordOperators = sets.New("and", "or", "unless", "atan2")
+
+// groupingKeywords are the modifiers that must be followed by a list of labels in parentheses.
+var groupingKeywords = sets.New("by", "without", "on", "ignoring")
+
+const operatorChars = "+-*/%^=!<>"
+
+type openBracket struct {
+	bracket rune
+	line    int
+	pos     int
+	// named is true if the bracket directly follows a name, e.g. the metric name of a selector.
+	named bool
+}
+
+// tokenKind is the kind of the previous token, which determines what may follow it.
+type tokenKind int
+
+const (
+	tokenNone tokenKind = iota
+	// tokenName is a metric name, label name, function name, keyword, number or duration.
+	tokenName
+	// tokenOperand is a string literal or a closing bracket.
+	tokenOperand
+	// tokenOperator is a binary or unary operator.
+	tokenOperator
+	// tokenSeparator is an opening bracket, a comma or a pipe.
+	tokenSeparator
+)
+
+// scanExpression performs a lexical check of a PromQL or LogQL expression: string literals
+// must be terminated, brackets must be balanced, selectors without a metric name must not be
+// empty, grouping modifiers must be followed by a label list, operators must be followed by an
+// operand and ranges must be durations. Only the syntax is checked: unknown functions, wrong
+// argument types or invalid label matchers are not detected and are only reported by the
+// ruler once the rule group is synced, as neither the PromQL nor the LogQL parser is a
+// dependency of KKP.
+func scanExpression(expr string) (*expressionSummary, *expressionError) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, expressionErrorf(0, "expression must not be empty")
+	}
+
+	var (
+		summary  = &expressionSummary{}
+		stack    []openBracket
+		runes    = []rune(expr)
+		line     = 0
+		prev     = tokenNone
+		operator string
+	)
+
+	inSelector := func() bool {
+		return len(stack) > 0 && stack[len(stack)-1].bracket == '{'
+	}
+
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '\n':
+			line++
+
+		case unicode.IsSpace(r):
+			continue
+
+		case r == '#':
+			// comments last until the end of the line
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+
+		case r == '"' || r == '\'' || r == '`':
+			start := line
+			closed := false
+
+			for i++; i < len(runes) && !closed; i++ {
+				switch runes[i] {
+				case '\\':
+					if r != '`' {
+						i++
+					}
+				case '\n':
+					if r != '`' {
+						return nil, expressionErrorf(start, "unterminated string literal")
+					}
+					line++
+				case r:
+					closed = true
+				}
+			}
+			i--
+
+			if !closed {
+				return nil, expressionErrorf(start, "unterminated string literal")
+			}
+			prev = tokenOperand
+
+		case r == '(' || r == '[' || r == '{':
+			stack = append(stack, openBracket{bracket: r, line: line, pos: i, named: prev == tokenName})
+			prev = tokenSeparator
+
+		case r == ')' || r == ']' || r == '}':
+			if len(stack) == 0 || stack[len(stack)-1].bracket != closingBrackets[r] {
+				return nil, expressionErrorf(line, "unexpected %q", r)
+			}
+			if prev == tokenOperator {
+				return nil, expressionErrorf(line, "unexpected %q after operator %q", r, operator)
+			}
+
+			open := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			content := strings.TrimSpace(string(runes[open.pos+1 : i]))
+
+			switch r {
+			case '}':
+				// an empty selector is only valid after a metric name, e.g. up{}
+				if content == "" && !open.named {
+					return nil, expressionErrorf(open.line, "selector must contain at least one matcher")
+				}
+				summary.selectors++
+
+			case ']':
+				if err := validateRange(content); err != nil {
+					return nil, expressionErrorf(open.line, "invalid range [%s]: %v", content, err)
+				}
+				summary.ranges++
+			}
+			prev = tokenOperand
+
+		case r == ',' || r == '|':
+			prev = tokenSeparator
+
+		case strings.ContainsRune(operatorChars, r):
+			start := i
+			for i+1 < len(runes) && strings.ContainsRune(operatorChars+"~", runes[i+1]) {
+				i++
+			}
+			operator = string(runes[start : i+1])
+			prev = tokenOperator
+
+		case isNameRune(r):
+			start := i
+			for i+1 < len(runes) && isNameRune(runes[i+1]) {
+				i++
+			}
+			word := strings.ToLower(string(runes[start : i+1]))
+
+			switch {
+			case inSelector():
+				prev = tokenName
+
+			case keywordOperators.Has(word):
+				operator = word
+				prev = tokenOperator
+
+			// names in a label list, e.g. "sum by (by)", are no modifiers
+			case groupingKeywords.Has(word) && prev != tokenSeparator:
+				if next := nextNonSpace(runes, i+1); next != '(' {
+					return nil, expressionErrorf(line, "%q must be followed by a list of labels in parentheses", word)
+				}
+				prev = tokenSeparator
+
+			default:
+				prev = tokenName
+			}
+		}
+	}
+
+	if len(stack) > 0 {
+		open := stack[len(stack)-1]
+		return nil, expressionErrorf(open.line, "unclosed %q", open.bracket)
+	}
+
+	if prev == tokenOperator {
+		return nil, expressionErrorf(line, "expression must not end with operator %q", operator)
+	}
+
+	return summary, nil
+}
+
+func isNameRune(r rune) bool {
+	return r == '_' || r == ':' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// nextNonSpace returns the first rune at or after the given position that is no whitespace, or 0.
+func nextNonSpace(runes []rune, pos int) rune {
+	for ; pos < len(runes); pos++ {
+		if !unicode.IsSpace(runes[pos]) {
+			return runes[pos]
+		}
+	}
+
+	return 0
+}
+
+// validateRange validates the content of a range vector selector ("5m") or of a subquery ("5m:1m").
+func validateRange(content string) error {
+	parts := strings.Split(content, ":")
+	if len(parts) > 2 {
+		return fmt.Errorf("too many colons")
+	}
+
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+
+		// the resolution of subqueries is optional
+		if i == 1 && part == "" {
+			continue
+		}
+
+		if _, err := model.ParseDuration(part); err != nil {
+			if _, floatErr := strconv.ParseFloat(part, 64); floatErr != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func lintPromQL(expr string) *expressionError {
+	_, err := scanExpression(expr)
+	return err
+}
+
+func lintLogQL(expr string) *expressionError {
+	summary, err := scanExpression(expr)
+	if err != nil {
+		return err
+	}
+
+	if summary.selectors == 0 {
+		return expressionErrorf(0, "expression must contain a stream selector, e.g. {app=\"foo\"}")
+	}
+
+	// Every metric query over logs requires a range (e.g. "count_over_time({app="foo"}[5m])"),
+	// without one it is a log query, whose result can not be evaluated by the ruler.
+	if summary.ranges == 0 {
+		return expressionErrorf(0, "expression must be a metric query, but is a log query")
+	}
+
+	return nil
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/prometheus/common/model"
+	"gopkg.in/yaml.v3"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	// ruleGroupFields and ruleFields are the fields of the Prometheus rule group format,
+	// which is used by both the Cortex and the Loki ruler.
+	ruleGroupFields = sets.New("name", "interval", "query_offset", "limit", "labels", "source_tenants", "rules")
+	ruleFields      = sets.New("record", "alert", "expr", "for", "keep_firing_for", "labels", "annotations")
+)
+
+// dataValidator validates the rule group in the data of a RuleGroup. The data is
+// walked as YAML nodes instead of being unmarshalled, so that every error can point
+// to the line in the data it was found in.
+type dataValidator struct {
+	ruleGroupType kubermaticv1.RuleGroupType
+	fldPath       *field.Path
+	errs          field.ErrorList
+}
+
+func validateRuleGroup(ruleGroup *kubermaticv1.RuleGroup) field.ErrorList {
+	v := &dataValidator{
+		ruleGroupType: ruleGroup.Spec.RuleGroupType,
+		fldPath:       field.NewPath("spec", "data"),
+	}
+
+	root, err := parseData(ruleGroup.Spec.Data)
+	if err != nil {
+		return field.ErrorList{field.Invalid(v.fldPath, field.OmitValueType{}, err.Error())}
+	}
+
+	v.validateGroup(root, ruleGroup.Name)
+
+	return v.errs
+}
+
+// parseData returns the root node of the single YAML document in data. Empty documents,
+// e.g. after a trailing "---", are skipped.
+func parseData(data []byte) (*yaml.Node, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+
+	var root *yaml.Node
+	for {
+		doc := &yaml.Node{}
+		if err := decoder.Decode(doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, err
+		}
+
+		if isEmptyDocument(doc) {
+			continue
+		}
+
+		// the rulegroup controller only ever sends the first document to the ruler
+		if root != nil {
+			return nil, fmt.Errorf("line %d: data must contain a single rule group", doc.Content[0].Line)
+		}
+
+		root = doc.Content[0]
+	}
+
+	if root == nil {
+		return nil, errors.New("rule group must not be empty")
+	}
+
+	return root, nil
+}
+
+func isEmptyDocument(doc *yaml.Node) bool {
+	if len(doc.Content) == 0 {
+		return true
+	}
+
+	node := doc.Content[0]
+
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
+
+func (v *dataValidator) errorf(node *yaml.Node, format string, args ...interface{}) {
+	v.errorAt(node.Line, format, args...)
+}
+
+func (v *dataValidator) errorAt(line int, format string, args ...interface{}) {
+	detail := fmt.Sprintf("line %d: %s", line, fmt.Sprintf(format, args...))
+	v.errs = append(v.errs, field.Invalid(v.fldPath, field.OmitValueType{}, detail))
+}
+
+// fields returns the key and value nodes of a mapping node by their key. Unknown and
+// duplicate keys are reported.
+func (v *dataValidator) fields(node *yaml.Node, allowed sets.Set[string], prefix string) (keys map[string]*yaml.Node, values map[string]*yaml.Node) {
+	keys = map[string]*yaml.Node{}
+	values = map[string]*yaml.Node{}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		switch {
+		case !allowed.Has(key.Value):
+			v.errorf(key, "%sfield %q is not allowed", prefix, key.Value)
+		case keys[key.Value] != nil:
+			v.errorf(key, "%sfield %q is defined more than once", prefix, key.Value)
+		default:
+			keys[key.Value] = key
+			values[key.Value] = value
+		}
+	}
+
+	return keys, values
+}
+
+func (v *dataValidator) validateGroup(node *yaml.Node, ruleGroupName string) {
+	if node.Kind != yaml.MappingNode {
+		v.errorf(node, "rule group must be a mapping")
+		return
+	}
+
+	_, fields := v.fields(node, ruleGroupFields, "")
+
+	if name, ok := v.scalar(fields["name"], "name"); !ok {
+		v.errorf(node, "field \"name\" is required")
+	} else if name != ruleGroupName {
+		// the group is addressed by the name of the RuleGroup in the ruler API
+		v.errorf(fields["name"], "group name %q does not match the RuleGroup name %q", name, ruleGroupName)
+	}
+
+	v.validateDuration(fields["interval"], "interval")
+	v.validateDuration(fields["query_offset"], "query_offset")
+
+	if limit, ok := v.scalar(fields["limit"], "limit"); ok {
+		if value, err := strconv.Atoi(limit); err != nil || value < 0 {
+			v.errorf(fields["limit"], "limit must be a non-negative integer")
+		}
+	}
+
+	v.validateLabels(fields["labels"], "labels", false)
+
+	if tenants := fields["source_tenants"]; tenants != nil {
+		if tenants.Kind != yaml.SequenceNode {
+			v.errorf(tenants, "source_tenants must be a list")
+		} else {
+			for i, tenant := range tenants.Content {
+				v.scalar(tenant, fmt.Sprintf("source_tenants[%d]", i))
+			}
+		}
+	}
+
+	rules := fields["rules"]
+	if rules == nil {
+		v.errorf(node, "field \"rules\" is required")
+		return
+	}
+
+	if rules.Kind != yaml.SequenceNode {
+		v.errorf(rules, "rules must be a list")
+		return
+	}
+
+	for i, rule := range rules.Content {
+		v.validateRule(rule, fmt.Sprintf("rules[%d]", i))
+	}
+}
+
+func (v *dataValidator) validateRule(node *yaml.Node, path string) {
+	if node.Kind != yaml.MappingNode {
+		v.errorf(node, "%s: rule must be a mapping", path)
+		return
+	}
+
+	keys, fields := v.fields(node, ruleFields, path+": ")
+
+	record, isRecord := v.scalar(fields["record"], path+".record")
+	alert, isAlert := v.scalar(fields["alert"], path+".alert")
+
+	switch {
+	case isRecord && isAlert:
+		v.errorf(node, "%s: only one of \"record\" and \"alert\" must be set", path)
+	case !isRecord && !isAlert:
+		v.errorf(node, "%s: one of \"record\" or \"alert\" must be set", path)
+	case isRecord:
+		if !model.IsValidLegacyMetricName(record) {
+			v.errorf(fields["record"], "%s.record: %q is not a valid metric name", path, record)
+		}
+
+		for _, name := range []string{"for", "keep_firing_for", "annotations"} {
+			if keys[name] != nil {
+				v.errorf(keys[name], "%s.%s: field is not allowed in recording rules", path, name)
+			}
+		}
+	case isAlert:
+		if alert == "" {
+			v.errorf(fields["alert"], "%s.alert: alert name must not be empty", path)
+		}
+	}
+
+	if expr, ok := v.scalar(fields["expr"], path+".expr"); !ok {
+		v.errorf(node, "%s: field \"expr\" is required", path)
+	} else {
+		v.validateExpression(fields["expr"], expr, path+".expr")
+	}
+
+	v.validateDuration(fields["for"], path+".for")
+	v.validateDuration(fields["keep_firing_for"], path+".keep_firing_for")
+	v.validateLabels(fields["labels"], path+".labels", isRecord)
+	v.validateLabels(fields["annotations"], path+".annotations", false)
+}
+
+func (v *dataValidator) validateExpression(node *yaml.Node, expr string, path string) {
+	var err *expressionError
+
+	switch v.ruleGroupType {
+	case kubermaticv1.RuleGroupTypeLogs:
+		err = lintLogQL(expr)
+	default:
+		err = lintPromQL(expr)
+	}
+
+	if err == nil {
+		return
+	}
+
+	// The lines of literal block scalars map 1:1 to the data, starting with the line
+	// after the indicator. For all other styles, only the start of the value is known.
+	line := node.Line
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		line += 1 + err.line
+	}
+
+	v.errorAt(line, "%s: invalid syntax: %s", path, err.msg)
+}
+
+// scalar returns the value of a scalar node; false is returned if the node is not
+// set or if it is not a scalar, which is reported.
+func (v *dataValidator) scalar(node *yaml.Node, path string) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+
+	if node.Kind != yaml.ScalarNode || node.Tag == "!!null" {
+		v.errorf(node, "%s must be a string", path)
+		return "", false
+	}
+
+	return node.Value, true
+}
+
+func (v *dataValidator) validateDuration(node *yaml.Node, path string) {
+	if value, ok := v.scalar(node, path); ok {
+		if _, err := model.ParseDuration(value); err != nil {
+			v.errorf(node, "%s: %v", path, err)
+		}
+	}
+}
+
+func (v *dataValidator) validateLabels(node *yaml.Node, path string, isRecordingRule bool) {
+	if node == nil {
+		return
+	}
+
+	if node.Kind != yaml.MappingNode {
+		v.errorf(node, "%s must be a mapping", path)
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		if !model.LabelName(key.Value).IsValidLegacy() {
+			v.errorf(key, "%s: %q is not a valid label name", path, key.Value)
+		}
+
+		if isRecordingRule && key.Value == model.MetricNameLabel {
+			v.errorf(key, "%s: label %q is not allowed in recording rules", path, key.Value)
+		}
+
+		v.scalar(value, fmt.Sprintf("%s.%s", path, key.Value))
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// validator for validating Kubermatic RuleGroup CRD. The data is checked to be a single rule group
+// in the Prometheus rule group format. Expressions are only checked for syntax errors, whether an
+// expression can actually be evaluated is only known once the ruler has accepted the rule group.
+type validator struct{}
+
+// NewValidator returns a new RuleGroup validator.
+func NewValidator() *validator {
+	return &validator{}
+}
+
+var _ admission.CustomValidator = &validator{}
+
+func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ruleGroup, ok := obj.(*kubermaticv1.RuleGroup)
+	if !ok {
+		return nil, errors.New("object is not a RuleGroup")
+	}
+
+	return nil, validate(ruleGroup)
+}
+
+func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	ruleGroup, ok := newObj.(*kubermaticv1.RuleGroup)
+	if !ok {
+		return nil, errors.New("new object is not a RuleGroup")
+	}
+
+	// RuleGroups that are being deleted only need to be removed from Cortex/Loki,
+	// do not block the removal of the finalizer because of invalid data.
+	if ruleGroup.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	return nil, validate(ruleGroup)
+}
+
+func (v *validator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validate(ruleGroup *kubermaticv1.RuleGroup) error {
+	allErrs := validateSpec(&ruleGroup.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateRuleGroup(ruleGroup)...)
+
+	return allErrs.ToAggregate()
+}
+
+func validateSpec(spec *kubermaticv1.RuleGroupSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Cluster.Name != "" && spec.ProjectID != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("projectID"), "cluster and projectID are mutually exclusive"))
+	}
+
+	if spec.ClusterSelector != nil {
+		if spec.ProjectID == "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("clusterSelector"), "clusterSelector can only be used together with projectID"))
+		}
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ClusterSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("clusterSelector"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/generator"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func genRuleGroup(ruleGroupType kubermaticv1.RuleGroupType, data string) *kubermaticv1.RuleGroup {
+	ruleGroup := generator.GenRuleGroup("test-rule-group", "test-cluster", ruleGroupType, false)
+	if data != "" {
+		ruleGroup.Spec.Data = []byte(data)
+	}
+
+	return ruleGroup
+}
+
+func genProjectRuleGroup(projectID string, clusterSelector *metav1.LabelSelector) *kubermaticv1.RuleGroup {
+	ruleGroup := genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, "")
+	ruleGroup.Namespace = "mla"
+	ruleGroup.Spec.Cluster.Name = ""
+	ruleGroup.Spec.ProjectID = projectID
+	ruleGroup.Spec.ClusterSelector = clusterSelector
+
+	return ruleGroup
+}
+
+func TestValidator(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ruleGroup     *kubermaticv1.RuleGroup
+		expectedError string
+	}{
+		{
+			name:      "valid metrics rule group",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, ""),
+		},
+		{
+			name: "valid metrics rule group with recording rule and multi-line expression",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `
+name: test-rule-group
+interval: 1m
+rules:
+- record: job:http_requests:rate5m
+  expr: |
+    sum by (job) (
+      rate(http_requests_total{code=~"5.."}[5m])
+    )
+`),
+		},
+		{
+			name: "valid logs rule group",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeLogs, `
+name: test-rule-group
+rules:
+- alert: HighErrorRate
+  expr: sum by (app) (count_over_time({app="foo"} |= "error" [5m])) > 10
+  for: 10m
+`),
+		},
+		{
+			name:      "valid project-scoped rule group",
+			ruleGroup: genProjectRuleGroup("my-project", &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}),
+		},
+		{
+			name: "cluster and project",
+			ruleGroup: func() *kubermaticv1.RuleGroup {
+				ruleGroup := genProjectRuleGroup("my-project", nil)
+				ruleGroup.Spec.Cluster.Name = "test-cluster"
+				return ruleGroup
+			}(),
+			expectedError: "spec.projectID: Forbidden: cluster and projectID are mutually exclusive",
+		},
+		{
+			name:          "cluster selector without project",
+			ruleGroup:     genProjectRuleGroup("", &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}),
+			expectedError: "spec.clusterSelector: Forbidden: clusterSelector can only be used together with projectID",
+		},
+		{
+			name: "invalid cluster selector",
+			ruleGroup: genProjectRuleGroup("my-project", &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "env", Operator: metav1.LabelSelectorOpIn},
+			}}),
+			expectedError: "spec.clusterSelector.matchExpressions[0].values: Required value",
+		},
+		{
+			name:          "empty data",
+			ruleGroup:     genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, " "),
+			expectedError: "rule group must not be empty",
+		},
+		{
+			name: "invalid YAML",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: up == 0
+    for: 5m
+`),
+			expectedError: "line 5: mapping values are not allowed",
+		},
+		{
+			name: "multiple documents",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules: []
+---
+name: other
+rules: []
+`),
+			expectedError: "line 4: data must contain a single rule group",
+		},
+		{
+			name: "trailing document separator",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules: []
+---
+`),
+		},
+		{
+			name: "empty documents around the rule group",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `---
+~
+---
+name: test-rule-group
+rules: []
+---
+`),
+		},
+		{
+			name:          "only empty documents",
+			ruleGroup:     genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, "---\n---\n"),
+			expectedError: "rule group must not be empty",
+		},
+		{
+			name: "group name does not match",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `rules: []
+name: other-rule-group
+`),
+			expectedError: `line 2: group name "other-rule-group" does not match the RuleGroup name "test-rule-group"`,
+		},
+		{
+			name: "unknown field",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: up == 0
+  severity: page
+`),
+			expectedError: `line 5: rules[0]: field "severity" is not allowed`,
+		},
+		{
+			name: "alert and record",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- expr: up == 0
+- alert: InstanceDown
+  record: instance:down
+  expr: up == 0
+`),
+			expectedError: `line 3: rules[0]: one of "record" or "alert" must be set, spec.data: Invalid value: line 4: rules[1]: only one of "record" and "alert" must be set`,
+		},
+		{
+			name: "recording rule with annotations",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- record: instance:up
+  expr: up
+  annotations:
+    summary: up
+`),
+			expectedError: "line 5: rules[0].annotations: field is not allowed in recording rules",
+		},
+		{
+			name: "invalid duration",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: up == 0
+  for: 5 minutes
+`),
+			expectedError: `line 5: rules[0].for: unknown unit " minutes" in duration "5 minutes"`,
+		},
+		{
+			name: "invalid label name",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: up == 0
+  labels:
+    team-name: infra
+`),
+			expectedError: `line 6: rules[0].labels: "team-name" is not a valid label name`,
+		},
+		{
+			name: "unbalanced parenthesis in multi-line expression",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- record: job:http_requests:rate5m
+  expr: |
+    sum by (job) (
+      rate(http_requests_total[5m]
+    )
+`),
+			expectedError: `line 5: rules[0].expr: invalid syntax: unclosed '('`,
+		},
+		{
+			name: "invalid range",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: HighRequestRate
+  expr: rate(http_requests_total[5 m]) > 10
+`),
+			expectedError: "line 4: rules[0].expr: invalid syntax: invalid range [5 m]",
+		},
+		{
+			name: "unterminated string",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: 'up{job="node} == 0'
+`),
+			expectedError: "line 4: rules[0].expr: invalid syntax: unterminated string literal",
+		},
+		{
+			name: "logs rule without stream selector",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeLogs, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: up == 0
+`),
+			expectedError: "line 4: rules[0].expr: invalid syntax: expression must contain a stream selector",
+		},
+		{
+			name: "logs rule with log query",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeLogs, `name: test-rule-group
+rules:
+- alert: Errors
+  expr: '{app="foo"} |= "error"'
+`),
+			expectedError: "line 4: rules[0].expr: invalid syntax: expression must be a metric query, but is a log query",
+		},
+		{
+			name: "metrics rule with empty selectors after metric names",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: HighRequestRate
+  expr: rate(http_requests_total{}[5m]) > 10 and up{} == 1
+`),
+		},
+		{
+			name: "metrics rule with empty selector without metric name",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: '{} == 0'
+`),
+			expectedError: "line 4: rules[0].expr: invalid syntax: selector must contain at least one matcher",
+		},
+		{
+			name: "metrics rule with grouping modifier without label list",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- record: job:up:sum
+  expr: sum by job (up)
+`),
+			expectedError: `line 4: rules[0].expr: invalid syntax: "by" must be followed by a list of labels in parentheses`,
+		},
+		{
+			name: "metrics rule with label named like a modifier",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- record: by:up:sum
+  expr: sum by (by, on) (up{on="true"}) without (ignoring)
+`),
+		},
+		{
+			name: "metrics rule ending with an operator",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: up >
+`),
+			expectedError: `line 4: rules[0].expr: invalid syntax: expression must not end with operator ">"`,
+		},
+		{
+			name: "metrics rule with operator before closing parenthesis",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: InstanceDown
+  expr: sum(up and) > 0
+`),
+			expectedError: `line 4: rules[0].expr: invalid syntax: unexpected ')' after operator "and"`,
+		},
+		{
+			name: "metrics rule with negative numbers and exponents",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeMetrics, `name: test-rule-group
+rules:
+- alert: Skew
+  expr: (time() - node_time_seconds offset -5m) * -1 > 1e-3
+`),
+		},
+		{
+			name: "logs rule with empty stream selector",
+			ruleGroup: genRuleGroup(kubermaticv1.RuleGroupTypeLogs, `name: test-rule-group
+rules:
+- alert: Errors
+  expr: count_over_time({}[5m]) > 0
+`),
+			expectedError: "line 4: rules[0].expr: invalid syntax: selector must contain at least one matcher",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewValidator().ValidateCreate(context.Background(), tc.ruleGroup)
+
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, but got: %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Expected error %q, but got none", tc.expectedError)
+			}
+
+			if !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("Expected error to contain %q, but got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/util/backupencryption"
 	"k8c.io/kubermatic/v2/pkg/validation"
 
 	corev1 "k8s.io/api/core/v1"
@@ -270,12 +271,41 @@ func validateEtcdBackupConfiguration(ctx context.Context, seedClient ctrlruntime
 					return fmt.Errorf("invalid etcd backup configuration: invalid destination %q credentials %s: %w", name, dest.Credentials.Name, err)
 				}
 			}
+
+			if dest.Encryption != nil {
+				if err := validateBackupEncryption(ctx, seedClient, dest); err != nil {
+					return fmt.Errorf("invalid etcd backup configuration: invalid destination %q encryption: %w", name, err)
+				}
+			}
 		}
 	}
 
 	return nil
 }
 
+func validateBackupEncryption(ctx context.Context, seedClient ctrlruntimeclient.Client, dest *kubermaticv1.BackupDestination) error {
+	// the key Secret is looked up in the namespace of the credentials Secret
+	if dest.Credentials == nil {
+		return errors.New("encryption requires credentials to be configured")
+	}
+
+	keySecret := corev1.Secret{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Name: dest.Encryption.KeySecret.Name, Namespace: dest.Credentials.Namespace}, &keySecret); err != nil {
+		return fmt.Errorf("invalid key secret %s: %w", dest.Encryption.KeySecret.Name, err)
+	}
+
+	key, ok := keySecret.Data[dest.Encryption.KeySecret.Key]
+	if !ok {
+		return fmt.Errorf("key secret %s does not contain key %q", dest.Encryption.KeySecret.Name, dest.Encryption.KeySecret.Key)
+	}
+
+	if _, err := backupencryption.ParseKey(key); err != nil {
+		return fmt.Errorf("invalid key in secret %s: %w", dest.Encryption.KeySecret.Name, err)
+	}
+
+	return nil
+}
+
 func validateKubeVirtSupportedOS(datacenterSpec *kubermaticv1.DatacenterSpecKubevirt) error {
 	if datacenterSpec != nil && datacenterSpec.Images.HTTP != nil {
 		for os := range datacenterSpec.Images.HTTP.OperatingSystems {
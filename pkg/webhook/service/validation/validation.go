@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// validator for validating Services in the user cluster against the resource quotas.
+type validator struct {
+	log        *zap.SugaredLogger
+	seedClient ctrlruntimeclient.Client
+	projectID  string
+	datacenter string
+}
+
+// NewValidator returns a new Service validator.
+func NewValidator(seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger, projectID, datacenter string) (*validator, error) {
+	if projectID == "" {
+		return nil, errors.New("project ID must not be empty")
+	}
+
+	return &validator{
+		log:        log,
+		seedClient: seedClient,
+		projectID:  projectID,
+		datacenter: datacenter,
+	}, nil
+}
+
+var _ admission.CustomValidator = &validator{}
+
+func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	service, ok := obj.(*corev1.Service)
+	if !ok {
+		return nil, errors.New("object is not a Service")
+	}
+
+	return nil, v.validate(ctx, service, nil)
+}
+
+func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldService, ok := oldObj.(*corev1.Service)
+	if !ok {
+		return nil, errors.New("old object is not a Service")
+	}
+
+	service, ok := newObj.(*corev1.Service)
+	if !ok {
+		return nil, errors.New("new object is not a Service")
+	}
+
+	return nil, v.validate(ctx, service, oldService)
+}
+
+func (v *validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *validator) validate(ctx context.Context, service, oldService *corev1.Service) error {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	v.log.Debugw("validating load balancer", "service", ctrlruntimeclient.ObjectKeyFromObject(service))
+
+	quotas, err := getResourceQuotas(ctx, v.seedClient, v.projectID, v.datacenter)
+	if err != nil {
+		return err
+	}
+
+	return validateQuotas(service, oldService, quotas)
+}
@@ -0,0 +1,38 @@
+//go:build ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/ee/resource-quota/subject"
+	eeservicevalidation "k8c.io/kubermatic/v2/pkg/ee/validation/service"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func validateQuotas(service, oldService *corev1.Service, resourceQuotas []kubermaticv1.ResourceQuota) error {
+	return eeservicevalidation.ValidateQuotas(service, oldService, resourceQuotas)
+}
+
+func getResourceQuotas(ctx context.Context, seedClient ctrlruntimeclient.Client, projectID, datacenter string) ([]kubermaticv1.ResourceQuota, error) {
+	return subject.ResourceQuotasForCluster(ctx, seedClient, projectID, datacenter)
+}
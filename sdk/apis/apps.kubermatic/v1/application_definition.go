@@ -81,6 +81,57 @@ type HelmSource struct {
 	// Credentials are optional and hold the ref to the secret with Helm credentials.
 	// Either username / password or registryConfigFile can be defined.
 	Credentials *HelmCredentials `json:"credentials,omitempty"`
+
+	// Verification is optional and configures how the provenance of the chart is verified before it is installed.
+	// If verification fails, the chart is not installed.
+	Verification *HelmChartVerification `json:"verification,omitempty"`
+}
+
+// HelmChartVerification configures the verification of a Helm chart. Exactly one method must be configured.
+type HelmChartVerification struct {
+	// Cosign verifies the cosign signature of the chart. Only supported for oci:// URLs.
+	Cosign *CosignVerification `json:"cosign,omitempty"`
+
+	// Provenance verifies the Helm provenance file (.prov) of the chart. Only supported for http:// and https:// URLs.
+	Provenance *ProvenanceVerification `json:"provenance,omitempty"`
+}
+
+// CosignVerification verifies the cosign signature of a chart stored in an OCI registry.
+// Either publicKey or keyless must be defined.
+type CosignVerification struct {
+	// PublicKey holds the ref and key in the secret for the PEM-encoded public key the chart must be signed with.
+	// The Secret must exist in the namespace where KKP is installed (default is "kubermatic").
+	// The Secret must be annotated with `apps.kubermatic.k8c.io/secret-type:` set to "helm".
+	PublicKey *corev1.SecretKeySelector `json:"publicKey,omitempty"`
+
+	// Keyless verifies signatures created with cosign keyless signing, i.e. with a short-lived certificate
+	// issued by the public Sigstore Fulcio instance and recorded in the public Rekor transparency log.
+	Keyless *CosignKeylessIdentity `json:"keyless,omitempty"`
+
+	// IgnoreTransparencyLog disables the verification that the signature has been recorded in the Rekor
+	// transparency log. This is required for charts signed without uploading the signature to Rekor (e.g.
+	// in air-gapped environments). Only supported together with publicKey.
+	IgnoreTransparencyLog bool `json:"ignoreTransparencyLog,omitempty"`
+}
+
+// CosignKeylessIdentity is the identity of the signer of a chart signed with cosign keyless signing.
+type CosignKeylessIdentity struct {
+	// Issuer is the OIDC issuer of the signer's identity (e.g. https://token.actions.githubusercontent.com).
+	// +kubebuilder:validation:MinLength=1
+	Issuer string `json:"issuer"`
+
+	// Subject is the identity of the signer, e.g. an email address or the URL of a CI workflow.
+	// +kubebuilder:validation:MinLength=1
+	Subject string `json:"subject"`
+}
+
+// ProvenanceVerification verifies a chart using its Helm provenance file.
+type ProvenanceVerification struct {
+	// Keyring holds the ref and key in the secret for the public OpenPGP keyring (as exported by `gpg --export`)
+	// containing the keys the chart may be signed with.
+	// The Secret must exist in the namespace where KKP is installed (default is "kubermatic").
+	// The Secret must be annotated with `apps.kubermatic.k8c.io/secret-type:` set to "helm".
+	Keyring corev1.SecretKeySelector `json:"keyring"`
 }
 
 const (
@@ -168,9 +219,17 @@ type ApplicationSource struct {
 
 const (
 	HelmTemplateMethod TemplateMethod = "helm"
+
+	// KustomizeTemplateMethod renders the kustomization found in the path of a Git source
+	// and server-side applies the resulting objects.
+	KustomizeTemplateMethod TemplateMethod = "kustomize"
+
+	// ManifestsTemplateMethod server-side applies all plain YAML or JSON manifests found
+	// in the path of a Git source.
+	ManifestsTemplateMethod TemplateMethod = "manifests"
 )
 
-// +kubebuilder:validation:Enum=helm
+// +kubebuilder:validation:Enum=helm;kustomize;manifests
 type TemplateMethod string
 
 type ApplicationTemplate struct {
@@ -209,7 +268,8 @@ type ApplicationDefinitionSpec struct {
 	// Description of the application. what is its purpose
 	Description string `json:"description"`
 
-	// Method used to install the application
+	// Method used to install the application. The kustomize and manifests methods require
+	// all versions to use a Git source.
 	Method TemplateMethod `json:"method"`
 
 	// DefaultValues specify default values for the UI which are passed to helm templating when creating an application. Comments are not preserved.
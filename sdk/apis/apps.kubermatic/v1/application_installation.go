@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/yaml"
 )
 
@@ -76,6 +77,13 @@ type ApplicationInstallationSpec struct {
 	// ValuesBlock specifies values overrides that are passed to helm templating. Comments are preserved.
 	ValuesBlock string `json:"valuesBlock,omitempty"`
 
+	// ValuesFrom references Secrets and ConfigMaps containing values overrides that are passed to helm templating.
+	// The referenced values are merged in the given order, with later entries taking precedence. Values and
+	// ValuesBlock are merged last and take precedence over all referenced values. Changes to the referenced
+	// objects trigger a reconciliation of the application.
+	// +optional
+	ValuesFrom []ValuesReference `json:"valuesFrom,omitempty"`
+
 	// ReconciliationInterval is the interval at which to force the reconciliation of the application. By default, Applications are only reconciled
 	// on changes on spec, annotations, or the parent application definition. Meaning that if the user manually deletes the workload
 	// deployed by the application, nothing will happen until the application CR change.
@@ -87,6 +95,104 @@ type ApplicationInstallationSpec struct {
 
 	// DeployOptions holds the settings specific to the templating method used to deploy the application.
 	DeployOptions *DeployOptions `json:"deployOptions,omitempty"`
+
+	// DependsOn lists other ApplicationInstallations that this application depends on. The application
+	// is only installed once all its dependencies fulfill their readiness requirement and it is
+	// uninstalled before any of its dependencies. Upgrades of an installed application are not held
+	// back by its dependencies. Dependency cycles are not allowed.
+	// +optional
+	DependsOn []ApplicationInstallationDependency `json:"dependsOn,omitempty"`
+}
+
+// ApplicationInstallationDependency references another ApplicationInstallation in the same user cluster.
+type ApplicationInstallationDependency struct {
+	// Name of the ApplicationInstallation.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the ApplicationInstallation. If empty, the namespace of the dependent
+	// ApplicationInstallation is used.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:default:=true
+
+	// RequireReady defines whether the dependency must have been deployed successfully, i.e. its
+	// Ready condition must be true, before this application is installed. If false, the dependency
+	// only has to exist. Defaults to true.
+	// +optional
+	RequireReady *bool `json:"requireReady,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+
+// ValuesReferenceKind is the kind of object values are read from.
+type ValuesReferenceKind string
+
+const (
+	SecretValuesReferenceKind    ValuesReferenceKind = "Secret"
+	ConfigMapValuesReferenceKind ValuesReferenceKind = "ConfigMap"
+)
+
+// +kubebuilder:validation:Enum=UserCluster;ClusterNamespace
+
+// ValuesReferenceLocation is the cluster that contains the object values are read from.
+type ValuesReferenceLocation string
+
+const (
+	// UserClusterValuesReferenceLocation references an object in the namespace of the ApplicationInstallation in the user cluster.
+	UserClusterValuesReferenceLocation ValuesReferenceLocation = "UserCluster"
+
+	// ClusterNamespaceValuesReferenceLocation references an object in the cluster namespace on the seed cluster. This allows
+	// KKP admins to provide values that the users of the cluster cannot change. The values are not kept secret from them:
+	// Helm stores the merged values of a release in a Secret in the user cluster, which users with access to that Secret
+	// can read. The object must be labelled with ApplicationValuesLabel.
+	ClusterNamespaceValuesReferenceLocation ValuesReferenceLocation = "ClusterNamespace"
+)
+
+// DefaultValuesKey is the key values are read from if a ValuesReference does not specify one.
+const DefaultValuesKey = "values.yaml"
+
+// ValuesReference references a key of a Secret or ConfigMap containing values in YAML format.
+type ValuesReference struct {
+	// Kind of the referenced object.
+	Kind ValuesReferenceKind `json:"kind"`
+
+	// Name of the referenced object.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key in the referenced object containing the values. Defaults to "values.yaml".
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// +kubebuilder:default:=UserCluster
+
+	// Location of the referenced object. Defaults to UserCluster.
+	// +optional
+	Location ValuesReferenceLocation `json:"location,omitempty"`
+
+	// Optional marks the reference as optional. A missing object or key is ignored instead of failing the installation.
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// GetKey returns the key containing the values, falling back to DefaultValuesKey.
+func (r *ValuesReference) GetKey() string {
+	if r.Key == "" {
+		return DefaultValuesKey
+	}
+
+	return r.Key
+}
+
+// GetLocation returns the location of the referenced object, falling back to UserClusterValuesReferenceLocation.
+func (r *ValuesReference) GetLocation() ValuesReferenceLocation {
+	if r.Location == "" {
+		return UserClusterValuesReferenceLocation
+	}
+
+	return r.Location
 }
 
 // DeployOptions holds the settings specific to the templating method used to deploy the application.
@@ -112,6 +218,18 @@ type HelmDeployOptions struct {
 	// enable DNS lookups when rendering templates.
 	// if you enable this flag, you have to verify that helm template function 'getHostByName' is not being used in a chart to disclose any information you do not want to be passed to DNS servers.(c.f. CVE-2023-25165)
 	EnableDNS bool `json:"enableDNS,omitempty"`
+
+	// CorrectDrift enables the self-healing of the release. Objects of the release that have been modified or deleted
+	// in the user cluster are reapplied, even if neither the chart nor the values have changed. Drifted objects are
+	// always reported in the Drifted condition, regardless of this setting. The release is checked for drift on
+	// every reconciliation and periodically according to DriftCheckInterval.
+	CorrectDrift bool `json:"correctDrift,omitempty"`
+
+	// DriftCheckInterval is the interval at which the deployed release is checked for drifted objects in between
+	// reconciliations. Only the stored release manifest is compared to the objects in the user cluster, the chart is
+	// not downloaded again and the application is not reinstalled. Defaults to 15 minutes if CorrectDrift is enabled,
+	// otherwise the periodic check is disabled. Setting a value equal to 0 always disables the periodic check.
+	DriftCheckInterval *metav1.Duration `json:"driftCheckInterval,omitempty"`
 }
 
 // AppNamespaceSpec describe the desired state of the namespace where application will be created.
@@ -158,6 +276,12 @@ type ApplicationRef struct {
 	// NOTE: We are not using Masterminds/semver here, as it keeps data in unexported fields witch causes issues for
 	// DeepEqual used in our reconciliation packages. At the same time, we are not using pkg/semver because
 	// of the reasons stated in https://github.com/kubermatic/kubermatic/pull/10891.
+
+	// VersionConstraint is an optional SemVer constraint, e.g. "~1.14". If set, Version is automatically updated
+	// to the newest version of the ApplicationDefinition that satisfies the constraint. Installed applications
+	// are only upgraded while the update window of the cluster is open.
+	// +optional
+	VersionConstraint string `json:"versionConstraint,omitempty"`
 }
 
 // ApplicationInstallationStatus denotes status information about an ApplicationInstallation.
@@ -174,8 +298,39 @@ type ApplicationInstallationStatus struct {
 	// HelmRelease holds the information about the helm release installed by this application. This field is only filled if template method is 'helm'.
 	HelmRelease *HelmRelease `json:"helmRelease,omitempty"`
 
+	// Inventory is the list of objects that have been applied into the user cluster by this application.
+	// Objects that are removed from the application's manifests are pruned based on this list. This field
+	// is only filled if template method is 'kustomize' or 'manifests'.
+	Inventory []ApplicationInventoryItem `json:"inventory,omitempty"`
+
 	// Failures counts the number of failed installation or updagrade. it is reset on successful reconciliation.
 	Failures int `json:"failures,omitempty"`
+
+	// AutomaticUpgrade contains information about the last upgrade that was performed automatically
+	// because of the version constraint of the application.
+	AutomaticUpgrade *ApplicationAutomaticUpgrade `json:"automaticUpgrade,omitempty"`
+}
+
+// ApplicationAutomaticUpgrade describes an upgrade that was performed automatically because of the
+// version constraint of the application.
+type ApplicationAutomaticUpgrade struct {
+	// PreviousVersion is the version of the application before the upgrade.
+	PreviousVersion string `json:"previousVersion"`
+	// TargetVersion is the version the application has been upgraded to.
+	TargetVersion string `json:"targetVersion"`
+	// Time is the point in time at which the upgrade has been started.
+	Time metav1.Time `json:"time"`
+}
+
+// ApplicationInventoryItem references a single object that was applied into the user cluster
+// as part of an application.
+type ApplicationInventoryItem struct {
+	Group   string `json:"group,omitempty"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+	// Namespace is empty for cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
 }
 
 type HelmRelease struct {
@@ -263,7 +418,7 @@ type ApplicationInstallationCondition struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=ManifestsRetrieved;Ready
+// +kubebuilder:validation:Enum=ManifestsRetrieved;Ready;Drifted
 
 // swagger:enum ApplicationInstallationConditionType
 // All condition types must be registered within the `AllApplicationInstallationConditionTypes` variable.
@@ -275,32 +430,43 @@ const (
 
 	// Ready describes all components have been successfully rolled out and are ready.
 	Ready ApplicationInstallationConditionType = "Ready"
+
+	// Drifted indicates that objects of the application have been modified or deleted in the user cluster. This
+	// condition is only set for applications using the helm template method.
+	Drifted ApplicationInstallationConditionType = "Drifted"
 )
 
 var AllApplicationInstallationConditionTypes = []ApplicationInstallationConditionType{
 	ManifestsRetrieved,
 	Ready,
+	Drifted,
 }
 
 // SetCondition of the applicationInstallation. It take care of update LastHeartbeatTime and LastTransitionTime if needed.
 func (appInstallation *ApplicationInstallation) SetCondition(conditionType ApplicationInstallationConditionType, status corev1.ConditionStatus, reason, message string) {
+	appInstallation.Status.SetCondition(conditionType, status, reason, message, appInstallation.Generation)
+}
+
+// SetCondition sets the condition observed for the given generation. It take care of update LastHeartbeatTime and
+// LastTransitionTime if needed.
+func (status *ApplicationInstallationStatus) SetCondition(conditionType ApplicationInstallationConditionType, conditionStatus corev1.ConditionStatus, reason, message string, observedGeneration int64) {
 	now := metav1.Now()
 
-	condition, exists := appInstallation.Status.Conditions[conditionType]
-	if exists && condition.Status != status {
+	condition, exists := status.Conditions[conditionType]
+	if exists && condition.Status != conditionStatus {
 		condition.LastTransitionTime = now
 	}
 
-	condition.Status = status
+	condition.Status = conditionStatus
 	condition.LastHeartbeatTime = now
 	condition.Reason = reason
 	condition.Message = message
-	condition.ObservedGeneration = appInstallation.Generation
+	condition.ObservedGeneration = observedGeneration
 
-	if appInstallation.Status.Conditions == nil {
-		appInstallation.Status.Conditions = map[ApplicationInstallationConditionType]ApplicationInstallationCondition{}
+	if status.Conditions == nil {
+		status.Conditions = map[ApplicationInstallationConditionType]ApplicationInstallationCondition{}
 	}
-	appInstallation.Status.Conditions[conditionType] = condition
+	status.Conditions[conditionType] = condition
 }
 
 // SetReadyCondition sets the ReadyCondition and appInstallation.Status.Failures counter according to the installError.
@@ -316,6 +482,16 @@ func (appInstallation *ApplicationInstallation) SetReadyCondition(installErr err
 	}
 }
 
+// DependencyKey returns the namespaced name of the ApplicationInstallation referenced by the given dependency.
+func (appInstallation *ApplicationInstallation) DependencyKey(dependency ApplicationInstallationDependency) types.NamespacedName {
+	namespace := dependency.Namespace
+	if namespace == "" {
+		namespace = appInstallation.Namespace
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: dependency.Name}
+}
+
 // GetParsedValues parses the values either from the Values or ValuesBlock field.
 // Will return an error if both fields are set.
 func (ai *ApplicationInstallationSpec) GetParsedValues() (map[string]interface{}, error) {
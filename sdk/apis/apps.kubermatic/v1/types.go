@@ -31,6 +31,15 @@ const (
 	// application definition / application installation is managed by KKP (i.e. it is KKP-internal).
 	ApplicationManagedByKKPValue = "kkp"
 
+	// ApplicationInstallationLabel is set on all objects applied into the user cluster by applications using the
+	// kustomize or manifests template method. Objects are only pruned if they still carry this label.
+	ApplicationInstallationLabel = "apps.kubermatic.k8c.io/application-installation"
+
+	// ApplicationValuesLabel must be set to "true" on Secrets and ConfigMaps in the cluster namespace on the seed cluster
+	// to allow ApplicationInstallations to read values from them. This prevents users from reading arbitrary objects
+	// of the control plane.
+	ApplicationValuesLabel = "apps.kubermatic.k8c.io/application-values"
+
 	// ApplicationTypeLabel indicated the type of the application definition / application installation.
 	ApplicationTypeLabel = "apps.kubermatic.k8c.io/type"
 
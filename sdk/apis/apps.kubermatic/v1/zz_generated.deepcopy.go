@@ -22,6 +22,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -54,6 +55,22 @@ func (in *AppNamespaceSpec) DeepCopy() *AppNamespaceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationAutomaticUpgrade) DeepCopyInto(out *ApplicationAutomaticUpgrade) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationAutomaticUpgrade.
+func (in *ApplicationAutomaticUpgrade) DeepCopy() *ApplicationAutomaticUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationAutomaticUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDefinition) DeepCopyInto(out *ApplicationDefinition) {
 	*out = *in
@@ -194,6 +211,26 @@ func (in *ApplicationInstallationCondition) DeepCopy() *ApplicationInstallationC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationInstallationDependency) DeepCopyInto(out *ApplicationInstallationDependency) {
+	*out = *in
+	if in.RequireReady != nil {
+		in, out := &in.RequireReady, &out.RequireReady
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationInstallationDependency.
+func (in *ApplicationInstallationDependency) DeepCopy() *ApplicationInstallationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationInstallationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationInstallationList) DeepCopyInto(out *ApplicationInstallationList) {
 	*out = *in
@@ -236,12 +273,24 @@ func (in *ApplicationInstallationSpec) DeepCopyInto(out *ApplicationInstallation
 	}
 	out.ApplicationRef = in.ApplicationRef
 	in.Values.DeepCopyInto(&out.Values)
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]ValuesReference, len(*in))
+		copy(*out, *in)
+	}
 	out.ReconciliationInterval = in.ReconciliationInterval
 	if in.DeployOptions != nil {
 		in, out := &in.DeployOptions, &out.DeployOptions
 		*out = new(DeployOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationInstallationDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationInstallationSpec.
@@ -274,6 +323,16 @@ func (in *ApplicationInstallationStatus) DeepCopyInto(out *ApplicationInstallati
 		*out = new(HelmRelease)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = make([]ApplicationInventoryItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutomaticUpgrade != nil {
+		in, out := &in.AutomaticUpgrade, &out.AutomaticUpgrade
+		*out = new(ApplicationAutomaticUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationInstallationStatus.
@@ -286,6 +345,21 @@ func (in *ApplicationInstallationStatus) DeepCopy() *ApplicationInstallationStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationInventoryItem) DeepCopyInto(out *ApplicationInventoryItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationInventoryItem.
+func (in *ApplicationInventoryItem) DeepCopy() *ApplicationInventoryItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationInventoryItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationRef) DeepCopyInto(out *ApplicationRef) {
 	*out = *in
@@ -363,6 +437,46 @@ func (in *ApplicationVersion) DeepCopy() *ApplicationVersion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignKeylessIdentity) DeepCopyInto(out *CosignKeylessIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignKeylessIdentity.
+func (in *CosignKeylessIdentity) DeepCopy() *CosignKeylessIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignKeylessIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignVerification) DeepCopyInto(out *CosignVerification) {
+	*out = *in
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Keyless != nil {
+		in, out := &in.Keyless, &out.Keyless
+		*out = new(CosignKeylessIdentity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignVerification.
+func (in *CosignVerification) DeepCopy() *CosignVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultingSelector) DeepCopyInto(out *DefaultingSelector) {
 	*out = *in
@@ -409,7 +523,7 @@ func (in *DeployOptions) DeepCopyInto(out *DeployOptions) {
 	if in.Helm != nil {
 		in, out := &in.Helm, &out.Helm
 		*out = new(HelmDeployOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -494,6 +608,31 @@ func (in *GitSource) DeepCopy() *GitSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartVerification) DeepCopyInto(out *HelmChartVerification) {
+	*out = *in
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(CosignVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(ProvenanceVerification)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartVerification.
+func (in *HelmChartVerification) DeepCopy() *HelmChartVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmCredentials) DeepCopyInto(out *HelmCredentials) {
 	*out = *in
@@ -528,6 +667,11 @@ func (in *HelmCredentials) DeepCopy() *HelmCredentials {
 func (in *HelmDeployOptions) DeepCopyInto(out *HelmDeployOptions) {
 	*out = *in
 	out.Timeout = in.Timeout
+	if in.DriftCheckInterval != nil {
+		in, out := &in.DriftCheckInterval, &out.DriftCheckInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmDeployOptions.
@@ -596,6 +740,11 @@ func (in *HelmSource) DeepCopyInto(out *HelmSource) {
 		*out = new(HelmCredentials)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(HelmChartVerification)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmSource.
@@ -607,3 +756,34 @@ func (in *HelmSource) DeepCopy() *HelmSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvenanceVerification) DeepCopyInto(out *ProvenanceVerification) {
+	*out = *in
+	in.Keyring.DeepCopyInto(&out.Keyring)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvenanceVerification.
+func (in *ProvenanceVerification) DeepCopy() *ProvenanceVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvenanceVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesReference) DeepCopyInto(out *ValuesReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesReference.
+func (in *ValuesReference) DeepCopy() *ValuesReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesReference)
+	in.DeepCopyInto(out)
+	return out
+}
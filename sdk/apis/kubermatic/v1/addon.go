@@ -113,6 +113,22 @@ type AddonStatus struct {
 	Phase AddonPhase `json:"phase,omitempty"`
 
 	Conditions map[AddonConditionType]AddonCondition `json:"conditions,omitempty"`
+
+	// Inventory is the list of objects that have been applied into the user cluster
+	// for this addon during the last successful reconciliation. Objects that are
+	// removed from the addon manifests are pruned based on this list.
+	Inventory []AddonInventoryItem `json:"inventory,omitempty"`
+}
+
+// AddonInventoryItem references a single object that was applied into the user
+// cluster as part of an addon.
+type AddonInventoryItem struct {
+	Group   string `json:"group,omitempty"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+	// Namespace is empty for cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
 }
 
 // +kubebuilder:validation:Enum=AddonResourcesCreatedSuccessfully;AddonReconciledSuccessfully
@@ -130,4 +146,11 @@ type AddonCondition struct {
 	// KubermaticVersion is the version of KKP that last _successfully_ reconciled this
 	// addon.
 	KubermaticVersion string `json:"kubermaticVersion,omitempty"`
+	// (brief) reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition. If the
+	// addon failed to apply, this contains the errors for the individual objects.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
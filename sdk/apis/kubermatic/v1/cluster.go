@@ -51,6 +51,20 @@ const (
 
 	// PresetInvalidatedAnnotation is key of the annotation used to indicate why the preset was invalidated.
 	PresetInvalidatedAnnotation = "presetInvalidated"
+
+	// RotateCAAnnotation is the key of the annotation used to request a rotation of the cluster's root CA.
+	// The annotation is removed once the rotation has been started; its value is ignored.
+	RotateCAAnnotation = "kubermatic.k8c.io/rotate-ca"
+
+	// DeletionRequestedAnnotation is the key of the annotation used to request the deletion of a cluster
+	// on seeds with a cluster deletion grace period. Removing the annotation before the grace period has
+	// expired cancels the deletion; its value is ignored.
+	DeletionRequestedAnnotation = "kubermatic.k8c.io/deletion-requested"
+
+	// DeletionReportAnnotation is the key of the annotation used to request a report of all objects and
+	// cloud resources that would be removed by deleting the cluster. The report is written into the
+	// "cluster-deletion-report" ConfigMap in the cluster namespace and the annotation is removed afterwards.
+	DeletionReportAnnotation = "kubermatic.k8c.io/deletion-report"
 )
 
 const (
@@ -183,8 +197,9 @@ type ClusterSpec struct {
 	// Please consult the KKP documentation for specific feature gates.
 	Features map[string]bool `json:"features,omitempty"`
 
-	// Optional: UpdateWindow configures automatic update systems to respect a maintenance window for
-	// applying OS updates to nodes. This is only respected on Flatcar nodes currently.
+	// Optional: UpdateWindow configures a maintenance window for disruptive updates. It is respected
+	// by KKP when rolling out control plane version updates and automatic updates (for the control
+	// plane and MachineDeployments), and for applying OS updates to Flatcar nodes.
 	UpdateWindow *UpdateWindow `json:"updateWindow,omitempty"`
 
 	// Enables the admission plugin `PodSecurityPolicy`. This plugin is deprecated by Kubernetes.
@@ -255,6 +270,10 @@ type ClusterSpec struct {
 	// purpose only and can be set by a user or a controller to communicate the reason for pausing the cluster.
 	PauseReason string `json:"pauseReason,omitempty"`
 
+	// DeletionProtection prevents the cluster from being deleted, both directly and by requesting its
+	// deletion. It has to be disabled before the cluster can be deleted.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
 	// Enables more verbose logging in KKP's user-cluster-controller-manager.
 	DebugLog bool `json:"debugLog,omitempty"`
 
@@ -379,17 +398,18 @@ const (
 	ClusterFeatureEncryptionAtRest = "encryptionAtRest"
 )
 
-// +kubebuilder:validation:Enum="";SeedResourcesUpToDate;ClusterControllerReconciledSuccessfully;AddonControllerReconciledSuccessfully;AddonInstallerControllerReconciledSuccessfully;BackupControllerReconciledSuccessfully;CloudControllerReconciledSuccessfully;UpdateControllerReconciledSuccessfully;MonitoringControllerReconciledSuccessfully;MachineDeploymentReconciledSuccessfully;MLAControllerReconciledSuccessfully;ClusterInitialized;EtcdClusterInitialized;CSIKubeletMigrationCompleted;ClusterUpdateSuccessful;ClusterUpdateInProgress;CSIKubeletMigrationSuccess;CSIKubeletMigrationInProgress;EncryptionControllerReconciledSuccessfully;IPAMControllerReconciledSuccessfully;
+// +kubebuilder:validation:Enum="";SeedResourcesUpToDate;ClusterControllerReconciledSuccessfully;AddonControllerReconciledSuccessfully;AddonInstallerControllerReconciledSuccessfully;BackupControllerReconciledSuccessfully;CloudControllerReconciledSuccessfully;UpdateControllerReconciledSuccessfully;MonitoringControllerReconciledSuccessfully;MachineDeploymentReconciledSuccessfully;MLAControllerReconciledSuccessfully;ClusterInitialized;EtcdClusterInitialized;CSIKubeletMigrationCompleted;ClusterUpdateSuccessful;ClusterUpdateInProgress;CSIKubeletMigrationSuccess;CSIKubeletMigrationInProgress;EncryptionControllerReconciledSuccessfully;IPAMControllerReconciledSuccessfully;EtcdClusterResized;
 
 // ClusterConditionType is used to indicate the type of a cluster condition. For all condition
 // types, the `true` value must indicate success. All condition types must be registered within
 // the `AllClusterConditionTypes` variable.
 type ClusterConditionType string
 
-// UpdateWindow allows defining windows for maintenance tasks related to OS updates.
-// This is only applied to cluster nodes using Flatcar Linux.
-// The reference time for this is the node system time and might differ from
-// the user's timezone, which needs to be considered when configuring a window.
+// UpdateWindow allows defining windows for maintenance tasks like control plane updates
+// and OS updates on cluster nodes using Flatcar Linux.
+// The reference time for OS updates is the node system time, while control plane updates
+// are evaluated in UTC. Both might differ from the user's timezone, which needs to be
+// considered when configuring a window.
 type UpdateWindow struct {
 
 	// Sets the start time of the update window. This can be a time of day in 24h format, e.g. `22:30`,
@@ -413,6 +433,13 @@ type EncryptionConfiguration struct {
 	// Configuration for the `secretbox` static key encryption scheme as supported by Kubernetes.
 	// More info: https://kubernetes.io/docs/tasks/administer-cluster/encrypt-data/#providers
 	Secretbox *SecretboxEncryptionConfiguration `json:"secretbox,omitempty"`
+	// Configuration for the `kms` (v2) envelope encryption scheme as supported by Kubernetes. Keys
+	// are held by an external key management system, which is accessed through a KMS plugin that
+	// runs as a sidecar of kube-apiserver. If `secretbox` is configured as well, KMS is used for
+	// encrypting data and the `secretbox` keys are only used for decrypting it, which allows
+	// migrating from static keys to KMS.
+	// More info: https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/
+	KMS *KMSEncryptionConfiguration `json:"kms,omitempty"`
 }
 
 // SecretboxEncryptionConfiguration defines static key encryption based on the 'secretbox' solution for Kubernetes.
@@ -438,6 +465,32 @@ type SecretboxKey struct {
 	SecretRef *corev1.SecretKeySelector `json:"secretRef,omitempty"`
 }
 
+// KMSEncryptionConfiguration defines envelope encryption through a KMS v2 plugin for Kubernetes.
+type KMSEncryptionConfiguration struct {
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]+$`
+
+	// Name of the KMS provider. The name is used to refer to the provider in the encryption
+	// configuration and changing it re-encrypts all resources using the new provider.
+	Name string `json:"name"`
+	// Image is the container image of the KMS v2 plugin. The plugin is expected to serve the
+	// KMS v2 gRPC API on the Unix socket whose path is passed in the `KMS_SOCKET` environment
+	// variable, e.g. by passing `--listen-addr=unix://$(KMS_SOCKET)` in `args`.
+	Image string `json:"image"`
+	// Command overrides the entrypoint of the plugin image.
+	Command []string `json:"command,omitempty"`
+	// Args are passed to the plugin container.
+	Args []string `json:"args,omitempty"`
+	// Env contains additional environment variables for the plugin container. Credentials for the
+	// external key management system can be read from Secrets in the cluster namespace. Note that
+	// if the `apiserverNetworkPolicy` feature is enabled, egress traffic to the key management
+	// system needs to be allowed by an additional NetworkPolicy in the cluster namespace.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Resources configures the compute resources of the plugin container.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Timeout for gRPC calls from kube-apiserver to the plugin. Defaults to 3s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
 type BackupConfig struct {
 	BackupStorageLocation *corev1.LocalObjectReference `json:"backupStorageLocation,omitempty"`
 }
@@ -558,8 +611,20 @@ const (
 	ClusterConditionEtcdClusterInitialized ClusterConditionType = "EtcdClusterInitialized"
 	ClusterConditionEncryptionInitialized  ClusterConditionType = "EncryptionInitialized"
 
+	// ClusterConditionEtcdClusterResized indicates whether the etcd cluster consists of the
+	// configured number of members. It is only maintained if the etcd-launcher is enabled.
+	ClusterConditionEtcdClusterResized ClusterConditionType = "EtcdClusterResized"
+
 	ClusterConditionUpdateProgress ClusterConditionType = "UpdateProgress"
 
+	// ClusterConditionCARotated is false while a rotation of the cluster's root CA is in progress
+	// and becomes true once the rotation has completed and the old CA is no longer trusted.
+	ClusterConditionCARotated ClusterConditionType = "CARotated"
+
+	// ClusterConditionAutomaticUpdatesApplied is false while automatic updates of the control plane
+	// or of MachineDeployments are deferred until the cluster's update window opens.
+	ClusterConditionAutomaticUpdatesApplied ClusterConditionType = "AutomaticUpdatesApplied"
+
 	// ClusterConditionNone is a special value indicating that no cluster condition should be set.
 	ClusterConditionNone ClusterConditionType = ""
 	// This condition is met when a CSI migration is ongoing and the CSI
@@ -576,6 +641,12 @@ const (
 	ReasonClusterUpdateInProgress             = "ClusterUpdateInProgress"
 	ReasonClusterCSIKubeletMigrationCompleted = "CSIKubeletMigrationSuccess"
 	ReasonClusterCCMMigrationInProgress       = "CSIKubeletMigrationInProgress"
+	ReasonEtcdClusterResized                  = "EtcdClusterResized"
+	ReasonEtcdClusterResizing                 = "EtcdClusterResizing"
+	ReasonCARotationInProgress                = "CARotationInProgress"
+	ReasonCARotationCompleted                 = "CARotationCompleted"
+	ReasonWaitingForUpdateWindow              = "WaitingForUpdateWindow"
+	ReasonAutomaticUpdatesApplied             = "AutomaticUpdatesApplied"
 )
 
 var AllClusterConditionTypes = []ClusterConditionType{
@@ -679,6 +750,15 @@ type ClusterStatus struct {
 	// +optional
 	Encryption *ClusterEncryptionStatus `json:"encryption,omitempty"`
 
+	// CARotation describes the progress of the most recent rotation of the cluster's root CA.
+	// +optional
+	CARotation *ClusterCARotationStatus `json:"caRotation,omitempty"`
+
+	// ScheduledDeletion is set while the deletion of the cluster has been requested, but the seed's
+	// cluster deletion grace period has not yet expired.
+	// +optional
+	ScheduledDeletion *ClusterScheduledDeletionStatus `json:"scheduledDeletion,omitempty"`
+
 	// ResourceUsage shows the current usage of resources for the cluster.
 	ResourceUsage *ResourceDetails `json:"resourceUsage,omitempty"`
 }
@@ -754,6 +834,57 @@ const (
 	ClusterEncryptionPhaseEncryptionNeeded ClusterEncryptionPhase = "EncryptionNeeded"
 )
 
+// ClusterCARotationStatus holds status information about a rotation of the cluster's root CA.
+type ClusterCARotationStatus struct {
+	// The current phase of the rotation. Can be one of `TrustNewCA`, `SignWithNewCA`, `RemoveOldCA` or `Completed`.
+	// While in `TrustNewCA`, the new CA is added to all trust bundles while the old CA keeps signing certificates.
+	// In `SignWithNewCA`, the new CA becomes the signing CA and all leaf certificates are re-issued. Finally,
+	// `RemoveOldCA` drops the old CA from all trust bundles.
+	Phase ClusterCARotationPhase `json:"phase"`
+
+	// StartedAt is the time the rotation was started.
+	StartedAt metav1.Time `json:"startedAt"`
+
+	// CompletedAt is the time the rotation has completed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=TrustNewCA;SignWithNewCA;RemoveOldCA;Completed
+type ClusterCARotationPhase string
+
+// ClusterScheduledDeletionStatus holds status information about a requested cluster deletion.
+type ClusterScheduledDeletionStatus struct {
+	// RequestedAt is the time the deletion was requested.
+	RequestedAt metav1.Time `json:"requestedAt"`
+
+	// DeleteAt is the time after which the cluster will be deleted.
+	DeleteAt metav1.Time `json:"deleteAt"`
+
+	// FinalBackupConfig is the name of the EtcdBackupConfig in the cluster namespace that takes
+	// the final etcd snapshot. It is empty if the seed has no default etcd backup destination.
+	// +optional
+	FinalBackupConfig string `json:"finalBackupConfig,omitempty"`
+
+	// WasPaused is true if the cluster was already paused when its deletion was requested, so
+	// that cancelling the deletion keeps it paused.
+	// +optional
+	WasPaused bool `json:"wasPaused,omitempty"`
+
+	// FinalBackupFailed is true if the final etcd snapshot has failed or its EtcdBackupConfig has
+	// been removed. The cluster is not deleted until the deletion is cancelled and requested again,
+	// which takes a new snapshot.
+	// +optional
+	FinalBackupFailed bool `json:"finalBackupFailed,omitempty"`
+}
+
+const (
+	ClusterCARotationPhaseTrustNewCA    ClusterCARotationPhase = "TrustNewCA"
+	ClusterCARotationPhaseSignWithNewCA ClusterCARotationPhase = "SignWithNewCA"
+	ClusterCARotationPhaseRemoveOldCA   ClusterCARotationPhase = "RemoveOldCA"
+	ClusterCARotationPhaseCompleted     ClusterCARotationPhase = "Completed"
+)
+
 // OIDCSettings contains OIDC configuration parameters for enabling authentication mechanism for the cluster.
 type OIDCSettings struct {
 	IssuerURL      string `json:"issuerURL,omitempty"`
@@ -1149,8 +1280,16 @@ type HetznerCloudSpec struct {
 	// Network is the pre-existing Hetzner network in which the machines are running.
 	// While machines can be in multiple networks, a single one must be chosen for the
 	// HCloud CCM to work.
-	// If this is empty, the network configured on the datacenter will be used.
+	// If this is empty, the network configured on the datacenter will be used. If
+	// neither is configured, KKP creates a network for the cluster.
 	Network string `json:"network,omitempty"`
+	// NetworkID is the ID of the Hetzner network the cluster uses. It is set by KKP.
+	NetworkID int64 `json:"networkID,omitempty"`
+	// Firewall is the name of a pre-existing Hetzner firewall that is attached to all
+	// machines of the cluster. If this is empty, KKP creates a firewall for the cluster.
+	Firewall string `json:"firewall,omitempty"`
+	// FirewallID is the ID of the Hetzner firewall the cluster uses. It is set by KKP.
+	FirewallID int64 `json:"firewallID,omitempty"`
 }
 
 // AzureCloudSpec defines cloud resource references for Microsoft Azure.
@@ -1658,6 +1797,24 @@ type ExtendedClusterHealth struct {
 	KubernetesDashboard          *HealthStatus `json:"kubernetesDashboard,omitempty"`
 	KubeLB                       *HealthStatus `json:"kubelb,omitempty"`
 	Kyverno                      *HealthStatus `json:"kyverno,omitempty"`
+	// Certificates is down if any control plane certificate is about to expire and cannot
+	// be renewed automatically.
+	Certificates *HealthStatus `json:"certificates,omitempty"`
+	// ExpiringCertificates lists all control plane certificates that expire within the
+	// configured certificate renewal threshold.
+	ExpiringCertificates []ExpiringCertificate `json:"expiringCertificates,omitempty"`
+}
+
+// ExpiringCertificate describes a control plane certificate that is about to expire.
+type ExpiringCertificate struct {
+	// Purpose identifies the certificate, usually by the name of the Secret (and the key
+	// within the Secret, if it contains more than one certificate).
+	Purpose string `json:"purpose"`
+	// NotAfter is the time at which the certificate expires.
+	NotAfter metav1.Time `json:"notAfter"`
+	// Renewable is false for certificates that KKP cannot renew on its own, like CA
+	// certificates or certificates signed by a CA that is about to expire as well.
+	Renewable bool `json:"renewable"`
 }
 
 // ControlPlaneHealthy returns if all Kubernetes control plane components are healthy.
@@ -1793,3 +1950,8 @@ func (c *Cluster) IsEncryptionEnabled() bool {
 func (c *Cluster) IsEncryptionActive() bool {
 	return c.Status.HasConditionValue(ClusterConditionEncryptionInitialized, corev1.ConditionTrue)
 }
+
+// IsCARotationInProgress returns whether a rotation of the cluster's root CA is currently in progress.
+func (c *Cluster) IsCARotationInProgress() bool {
+	return c.Status.CARotation != nil && c.Status.CARotation.Phase != ClusterCARotationPhaseCompleted
+}
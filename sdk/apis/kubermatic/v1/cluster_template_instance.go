@@ -32,6 +32,7 @@ const ClusterTemplateInstanceOwnerAnnotationKey = "owner"
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:object:generate=true
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:JSONPath=".spec.projectID",name="ProjectID",type="string"
 // +kubebuilder:printcolumn:JSONPath=".spec.clusterTemplateID",name="ClusterTemplateID",type="string"
 // +kubebuilder:printcolumn:JSONPath=".spec.replicas",name="Replicas",type="integer"
@@ -44,6 +45,9 @@ type ClusterTemplateInstance struct {
 
 	// Spec specifies the data for cluster instances.
 	Spec ClusterTemplateInstanceSpec `json:"spec,omitempty"`
+
+	// Status reports how the clusters created from this instance differ from their template.
+	Status ClusterTemplateInstanceStatus `json:"status,omitempty"`
 }
 
 // ClusterTemplateInstanceSpec specifies the data for cluster instances.
@@ -51,7 +55,67 @@ type ClusterTemplateInstanceSpec struct {
 	ProjectID           string `json:"projectID"`
 	ClusterTemplateID   string `json:"clusterTemplateID"`
 	ClusterTemplateName string `json:"clusterTemplateName"`
-	Replicas            int64  `json:"replicas"`
+	// Replicas is the number of clusters to create from the template.
+	Replicas int64 `json:"replicas"`
+
+	// Values sets the parameters declared by the ClusterTemplate. All values are given
+	// as strings and are converted to the parameter's type.
+	Values map[string]string `json:"values,omitempty"`
+
+	// RolloutPolicy configures how changes to the ClusterTemplate are propagated to the
+	// clusters created from this instance. If not set, the instance is deleted once all
+	// clusters have been created and later changes to the template have no effect.
+	RolloutPolicy *ClusterTemplateRolloutPolicy `json:"rolloutPolicy,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=None;Manual;Rolling
+
+// ClusterTemplateRolloutStrategy defines how template changes are rolled out.
+type ClusterTemplateRolloutStrategy string
+
+const (
+	// ClusterTemplateRolloutStrategyNone only reports the drift between the template and its clusters.
+	ClusterTemplateRolloutStrategyNone ClusterTemplateRolloutStrategy = "None"
+	// ClusterTemplateRolloutStrategyManual rolls out a template generation once it has been approved.
+	ClusterTemplateRolloutStrategyManual ClusterTemplateRolloutStrategy = "Manual"
+	// ClusterTemplateRolloutStrategyRolling rolls out every template change automatically.
+	ClusterTemplateRolloutStrategyRolling ClusterTemplateRolloutStrategy = "Rolling"
+)
+
+// ClusterTemplateRolloutPolicy configures the propagation of ClusterTemplate changes. Only the
+// version, component settings overrides, feature gates and admission plugins are propagated.
+// The version is only propagated to clusters with an older version, clusters are never downgraded.
+type ClusterTemplateRolloutPolicy struct {
+	// Strategy defines how template changes are rolled out.
+	Strategy ClusterTemplateRolloutStrategy `json:"strategy"`
+	// MaxUnavailable is the maximum number of clusters that are updated at the same time. A
+	// cluster counts as unavailable until its control plane has reached the desired version
+	// and is healthy. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+	// ApprovedTemplateGeneration is the generation of the ClusterTemplate that may be rolled
+	// out when using the Manual strategy.
+	ApprovedTemplateGeneration int64 `json:"approvedTemplateGeneration,omitempty"`
+}
+
+// ClusterTemplateInstanceStatus reports the drift between the ClusterTemplate and the clusters
+// created from the instance.
+type ClusterTemplateInstanceStatus struct {
+	// TemplateGeneration is the generation of the ClusterTemplate the clusters were compared to.
+	TemplateGeneration int64 `json:"templateGeneration,omitempty"`
+	// Clusters reports the drift for every cluster created from this instance, keyed by cluster name.
+	Clusters map[string]ClusterTemplateInstanceClusterStatus `json:"clusters,omitempty"`
+	// CreatedClusters is the number of clusters that have already been created from this instance.
+	CreatedClusters int64 `json:"createdClusters,omitempty"`
+}
+
+// ClusterTemplateInstanceClusterStatus is the drift of a single cluster.
+type ClusterTemplateInstanceClusterStatus struct {
+	// Drift lists the fields of the cluster spec that differ from the template.
+	Drift []string `json:"drift,omitempty"`
+	// Updating is true while the cluster has been updated to the template, but its
+	// control plane has not yet reached the desired version or is not healthy.
+	Updating bool `json:"updating,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -64,6 +64,10 @@ type ClusterTemplate struct {
 	// UserSSHKeys is the list of SSH public keys that should be assigned to all nodes in the cluster.
 	UserSSHKeys []ClusterTemplateSSHKey `json:"userSSHKeys,omitempty"`
 
+	// Parameters declare the values that can be set by a ClusterTemplateInstance
+	// to customize the clusters created from this template.
+	Parameters []ClusterTemplateParameter `json:"parameters,omitempty"`
+
 	// Spec describes the desired state of a user cluster.
 	Spec ClusterSpec `json:"spec,omitempty"`
 }
@@ -88,3 +92,43 @@ type ClusterTemplateSSHKey struct {
 	// Name is the human readable SSH key name.
 	Name string `json:"name"`
 }
+
+// +kubebuilder:validation:Enum=string;integer;boolean
+
+// ClusterTemplateParameterType is the type of a cluster template parameter's value.
+type ClusterTemplateParameterType string
+
+const (
+	ClusterTemplateParameterTypeString  ClusterTemplateParameterType = "string"
+	ClusterTemplateParameterTypeInteger ClusterTemplateParameterType = "integer"
+	ClusterTemplateParameterTypeBoolean ClusterTemplateParameterType = "boolean"
+)
+
+// ClusterTemplateParameter is a typed variable of a ClusterTemplate that is substituted
+// into the clusters created from the template.
+type ClusterTemplateParameter struct {
+	// Name is the name used to set the parameter's value in a ClusterTemplateInstance.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+	// Description is a human readable explanation of the parameter.
+	Description string `json:"description,omitempty"`
+	// Type is the type of the parameter's value.
+	Type ClusterTemplateParameterType `json:"type"`
+	// Paths are JSON pointers (RFC 6901) to the fields that are set to the parameter's value.
+	// Pointers starting with "/spec" refer to the cluster spec (e.g. "/spec/version"), pointers
+	// starting with "/initialMachineDeployment" refer to the initial MachineDeployment of the
+	// cluster (e.g. "/initialMachineDeployment/spec/replicas").
+	// +kubebuilder:validation:MinItems=1
+	Paths []string `json:"paths"`
+	// Default is used if a ClusterTemplateInstance does not set a value for this parameter.
+	// Parameters without a default must be set by every ClusterTemplateInstance.
+	Default *string `json:"default,omitempty"`
+	// Enum optionally restricts the value to a fixed set of allowed values.
+	Enum []string `json:"enum,omitempty"`
+	// Pattern is an optional regular expression that string values must match.
+	Pattern string `json:"pattern,omitempty"`
+	// Minimum is the optional lower bound for integer values.
+	Minimum *int64 `json:"minimum,omitempty"`
+	// Maximum is the optional upper bound for integer values.
+	Maximum *int64 `json:"maximum,omitempty"`
+}
@@ -42,13 +42,15 @@ const (
 // OperationType is the type defining the operations triggering the compatibility check (CREATE or UPDATE).
 type OperationType string
 
-// +kubebuilder:validation:Enum=Addon;Cluster;ClusterBackup;Project;None
+// +kubebuilder:validation:Enum=Addon;Certificate;Cluster;ClusterBackup;Project;None
 // MetricsCollector is the name of an available metrics collector.
 type MetricsCollector string
 
 const (
 	// AddonCollector is addon metrics collector.
 	AddonCollector MetricsCollector = "Addon"
+	// CertificateCollector is the control plane certificate metrics collector.
+	CertificateCollector MetricsCollector = "Certificate"
 	// ClusterBackupCollector is cluster backup metrics collector.
 	ClusterBackupCollector MetricsCollector = "ClusterBackup"
 	// ClusterCollector is cluster metrics collector.
@@ -212,6 +214,10 @@ type KubermaticSeedControllerConfiguration struct {
 	BackupStoreContainer string `json:"backupStoreContainer,omitempty"`
 	// BackupDeleteContainer is the container used for deleting etcd snapshots from a backup location.
 	BackupDeleteContainer string `json:"backupDeleteContainer,omitempty"`
+	// BackupObjectName is the name of the snapshot object that the BackupStoreContainer uploads,
+	// used to download the snapshot for verification. $(CLUSTER) and $(BACKUP_TO_CREATE) are
+	// replaced with the cluster name and the backup name. Defaults to "$(CLUSTER)-$(BACKUP_TO_CREATE)".
+	BackupObjectName string `json:"backupObjectName,omitempty"`
 	// Deprecated: BackupCleanupContainer is the container used for removing expired backups from the storage location.
 	// This field is a no-op and is no longer used. The old backup controller it was used for has been
 	// removed. Do not set this field.
@@ -229,7 +235,7 @@ type KubermaticSeedControllerConfiguration struct {
 	// Replicas sets the number of pod replicas for the seed-controller-manager.
 	Replicas *int32 `json:"replicas,omitempty"`
 	// DisabledCollectors contains a list of metrics collectors that should be disabled.
-	// Acceptable values are "Addon", "Cluster", "ClusterBackup", "Project", and "None".
+	// Acceptable values are "Addon", "Certificate", "Cluster", "ClusterBackup", "Project", and "None".
 	DisabledCollectors []MetricsCollector `json:"disabledCollectors,omitempty"`
 	// BackupInterval defines the time duration between consecutive etcd backups.
 	// Must be a valid time.Duration string format. Only takes effect when backup scheduling is enabled.
@@ -292,6 +298,11 @@ type KubermaticUserClusterConfiguration struct {
 	OperatingSystemManager OperatingSystemManager `json:"operatingSystemManager,omitempty"`
 	// KubeLB configures the kubeLB component.
 	KubeLB KubeLBConfiguration `json:"kubelb,omitempty"`
+	// CertificateRenewalThreshold is the remaining validity below which control plane
+	// certificates are renewed. The same threshold is used to report certificates that
+	// cannot be renewed automatically in the cluster health. Must be greater than 0 and at
+	// most 8040h (335 days), as certificates are issued for one year. Defaults to 720h (30 days).
+	CertificateRenewalThreshold *metav1.Duration `json:"certificateRenewalThreshold,omitempty"`
 }
 
 // KubermaticUserClusterMonitoringConfiguration can be used to fine-tune to in-cluster Prometheus.
@@ -303,6 +303,12 @@ type SeedSpec struct {
 	// EtcdBackupRestore holds the configuration of the automatic etcd backup restores for the Seed;
 	// if this is set, the new backup/restore controllers are enabled for this Seed.
 	EtcdBackupRestore *EtcdBackupRestore `json:"etcdBackupRestore,omitempty"`
+	// ClusterDeletionGracePeriod enables scheduled cluster deletion for the Seed. If set, clusters
+	// cannot be deleted directly anymore, but only by annotating them with
+	// "kubermatic.k8c.io/deletion-requested". A final etcd snapshot is taken and the cluster is
+	// paused until the grace period has expired, at which point it is deleted. Removing the
+	// annotation before that cancels the deletion.
+	ClusterDeletionGracePeriod *metav1.Duration `json:"clusterDeletionGracePeriod,omitempty"`
 	// OIDCProviderConfiguration allows to configure OIDC provider at the Seed level.
 	OIDCProviderConfiguration *OIDCProviderConfiguration `json:"oidcProviderConfiguration,omitempty"`
 	// KubeLB holds the configuration for the kubeLB at the Seed level. This component is responsible for managing load balancers.
@@ -312,7 +318,7 @@ type SeedSpec struct {
 	//lint:ignore SA5008 omitcegenyaml is used by the example-yaml-generator
 	KubeLB *KubeLBSeedSettings `json:"kubelb,omitempty,omitcegenyaml"`
 	// DisabledCollectors contains a list of metrics collectors that should be disabled.
-	// Acceptable values are "Addon", "Cluster", "ClusterBackup", "Project", and "None".
+	// Acceptable values are "Addon", "Certificate", "Cluster", "ClusterBackup", "Project", and "None".
 	DisabledCollectors []MetricsCollector `json:"disabledCollectors,omitempty"`
 	// ManagementProxySettings can be used if the KubeAPI of the user clusters
 	// will not be directly available from kkp and a proxy in between should be used
@@ -357,6 +363,19 @@ type BackupDestination struct {
 	BucketName string `json:"bucketName"`
 	// Credentials hold the ref to the secret with backup credentials
 	Credentials *corev1.SecretReference `json:"credentials,omitempty"`
+	// Encryption configures the client-side encryption of etcd snapshots before they
+	// are uploaded to this destination. If not set, snapshots are stored unencrypted.
+	Encryption *BackupEncryption `json:"encryption,omitempty"`
+}
+
+// BackupEncryption configures the client-side encryption of etcd snapshots.
+type BackupEncryption struct {
+	// KeySecret references the key in a Secret that holds the base64-encoded 256 bit
+	// AES key used to encrypt the snapshots (e.g. generated using `openssl rand -base64 32`).
+	// The Secret must be in the same namespace as the credentials Secret. Rotating the key
+	// makes snapshots that were encrypted with the previous key unrestorable, so the old
+	// key must be kept until all of these snapshots are deleted.
+	KeySecret corev1.SecretKeySelector `json:"keySecret"`
 }
 
 type NodeportProxyConfig struct {
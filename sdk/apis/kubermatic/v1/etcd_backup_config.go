@@ -39,6 +39,10 @@ const (
 
 	// BackupStatusPhase value indicating that the corresponding job has completed with an error.
 	BackupStatusPhaseFailed = "Failed"
+
+	// BackupStatusPhase value indicating that the backup has been restored successfully by the
+	// verification job.
+	BackupStatusPhaseVerified = "Verified"
 )
 
 // +kubebuilder:object:generate=true
@@ -75,6 +79,13 @@ type EtcdBackupConfigSpec struct {
 	// Destination indicates where the backup will be stored. The destination name must correspond to a destination in
 	// the cluster's Seed.Spec.EtcdBackupRestore.
 	Destination string `json:"destination"`
+	// Verify enables the verification of each backup after it has been created. To verify a
+	// backup, it is downloaded from the destination and restored into a throwaway etcd data
+	// directory. The results are recorded in the backup's status.
+	Verify bool `json:"verify,omitempty"`
+	// RetainBackups keeps all completed backups at the destination when the EtcdBackupConfig
+	// is deleted. By default, all backups are deleted together with their EtcdBackupConfig.
+	RetainBackups bool `json:"retainBackups,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -119,6 +130,28 @@ type BackupStatus struct {
 	DeleteFinishedTime metav1.Time       `json:"deleteFinishedTime,omitempty"`
 	DeletePhase        BackupStatusPhase `json:"deletePhase,omitempty"`
 	DeleteMessage      string            `json:"deleteMessage,omitempty"`
+	VerifyJobName      string            `json:"verifyJobName,omitempty"`
+	// +optional
+	VerifyStartTime metav1.Time `json:"verifyStartTime,omitempty"`
+	// +optional
+	VerifyFinishedTime metav1.Time       `json:"verifyFinishedTime,omitempty"`
+	VerifyPhase        BackupStatusPhase `json:"verifyPhase,omitempty"`
+	VerifyMessage      string            `json:"verifyMessage,omitempty"`
+	// Verification contains details about the restored snapshot, as determined by the verification job.
+	// +optional
+	Verification *BackupVerification `json:"verification,omitempty"`
+}
+
+// BackupVerification describes the contents of an etcd snapshot that was successfully restored.
+type BackupVerification struct {
+	// Revision is the latest etcd revision contained in the snapshot.
+	Revision int64 `json:"revision"`
+	// KeyCount is the number of keys contained in the snapshot.
+	KeyCount int64 `json:"keyCount"`
+	// Hash is the hex-encoded CRC32 hash of the snapshot's database, as calculated by etcdutl.
+	Hash string `json:"hash"`
+	// Size is the size of the uncompressed snapshot database in bytes.
+	Size int64 `json:"size"`
 }
 
 type EtcdBackupConfigCondition struct {
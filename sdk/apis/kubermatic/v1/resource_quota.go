@@ -17,6 +17,9 @@ limitations under the License.
 package v1
 
 import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -28,7 +31,9 @@ const (
 	ResourceQuotaSubjectNameLabelKey = "subject-name"
 	ResourceQuotaSubjectKindLabelKey = "subject-kind"
 
-	ProjectSubjectKind = "project"
+	ProjectSubjectKind    = "project"
+	GroupSubjectKind      = "group"
+	DatacenterSubjectKind = "datacenter"
 )
 
 // +kubebuilder:resource:scope=Cluster
@@ -39,7 +44,7 @@ const (
 // +kubebuilder:printcolumn:JSONPath=".spec.subject.name",name="Subject Name",type="string"
 // +kubebuilder:printcolumn:JSONPath=".spec.subject.kind",name="Subject Kind",type="string"
 
-// ResourceQuota specifies the amount of cluster resources a project can use.
+// ResourceQuota specifies the amount of cluster resources a project, a group or a datacenter can use.
 type ResourceQuota struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -56,6 +61,55 @@ type ResourceQuotaSpec struct {
 	Subject Subject `json:"subject"`
 	// Quota specifies the current maximum allowed usage of resources.
 	Quota ResourceDetails `json:"quota"`
+	// SoftThresholds specifies the usage of resources, in percent of the quota, at which warnings
+	// are issued. Exceeding a soft threshold does not block any requests.
+	// +optional
+	SoftThresholds *ResourceThresholds `json:"softThresholds,omitempty"`
+}
+
+// ResourceThresholds holds a threshold in percent for each resource of a quota.
+type ResourceThresholds struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// CPU is the threshold for the CPU quota.
+	CPU *int32 `json:"cpu,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Memory is the threshold for the memory quota.
+	Memory *int32 `json:"memory,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Storage is the threshold for the storage quota.
+	Storage *int32 `json:"storage,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Clusters is the threshold for the clusters quota.
+	Clusters *int32 `json:"clusters,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Machines is the threshold for the machines quota.
+	Machines *int32 `json:"machines,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// LoadBalancers is the threshold for the load balancers quota.
+	LoadBalancers *int32 `json:"loadBalancers,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// PublicIPs is the threshold for the public IPs quota.
+	PublicIPs *int32 `json:"publicIPs,omitempty"`
 }
 
 // ResourceQuotaStatus describes the current state of a resource quota.
@@ -64,21 +118,54 @@ type ResourceQuotaStatus struct {
 	GlobalUsage ResourceDetails `json:"globalUsage,omitempty"`
 	// LocalUsage is holds the current usage of resources for the local seed.
 	LocalUsage ResourceDetails `json:"localUsage,omitempty"`
+	// Conditions contains conditions of the resource quota.
+	Conditions map[ResourceQuotaConditionType]ResourceQuotaCondition `json:"conditions,omitempty"`
 }
 
+type ResourceQuotaCondition struct {
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time we got an update on a given condition.
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime"`
+	// Last time the condition transit from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// (brief) reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=SoftThresholdExceeded
+
+// ResourceQuotaConditionType is used to indicate the type of a ResourceQuota condition.
+type ResourceQuotaConditionType string
+
+const (
+	// ResourceQuotaConditionSoftThresholdExceeded indicates that the global usage of at least one
+	// resource has reached its soft threshold.
+	ResourceQuotaConditionSoftThresholdExceeded ResourceQuotaConditionType = "SoftThresholdExceeded"
+)
+
 // Subject describes the entity to which the quota applies to.
 type Subject struct {
 	// Name of the quota subject.
 	Name string `json:"name"`
 
-	// +kubebuilder:validation:Enum=project
+	// +kubebuilder:validation:Enum=project;group;datacenter
 	// +kubebuilder:default=project
 
-	// Kind of the quota subject. For now the only possible kind is project.
+	// Kind of the quota subject. The quota applies to the clusters
+	//
+	// * of the project with the ID name (project),
+	// * of all projects the group with the given name is bound to via GroupProjectBindings (group),
+	// * in the datacenter with the given name (datacenter).
 	Kind string `json:"kind"`
 }
 
-// ResourceDetails holds the CPU, Memory and Storage quantities.
+// ResourceDetails holds the CPU, Memory and Storage quantities and the number of objects.
 type ResourceDetails struct {
 	// CPU holds the quantity of CPU. For the format, please check k8s.io/apimachinery/pkg/api/resource.Quantity.
 	CPU *resource.Quantity `json:"cpu,omitempty"`
@@ -86,10 +173,73 @@ type ResourceDetails struct {
 	Memory *resource.Quantity `json:"memory,omitempty"`
 	// Storage represents the disk size. For the format, please check k8s.io/apimachinery/pkg/api/resource.Quantity.
 	Storage *resource.Quantity `json:"storage,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+
+	// Clusters is the number of clusters.
+	Clusters *int64 `json:"clusters,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+
+	// Machines is the number of machines.
+	Machines *int64 `json:"machines,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+
+	// LoadBalancers is the number of Services of type LoadBalancer in the user clusters.
+	LoadBalancers *int64 `json:"loadBalancers,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+
+	// PublicIPs is the number of public IP addresses assigned to machines and load balancers.
+	PublicIPs *int64 `json:"publicIPs,omitempty"`
 }
 
 func (r ResourceDetails) IsEmpty() bool {
-	return (r.CPU == nil || r.CPU.IsZero()) && (r.Memory == nil || r.Memory.IsZero()) && (r.Storage == nil || r.Storage.IsZero())
+	return (r.CPU == nil || r.CPU.IsZero()) && (r.Memory == nil || r.Memory.IsZero()) && (r.Storage == nil || r.Storage.IsZero()) &&
+		isZeroCount(r.Clusters) && isZeroCount(r.Machines) && isZeroCount(r.LoadBalancers) && isZeroCount(r.PublicIPs)
+}
+
+// Add adds the resources and counts of other to r. Counts that are nil in both are left nil.
+func (r *ResourceDetails) Add(other ResourceDetails) {
+	r.CPU = addQuantity(r.CPU, other.CPU)
+	r.Memory = addQuantity(r.Memory, other.Memory)
+	r.Storage = addQuantity(r.Storage, other.Storage)
+	r.Clusters = addCount(r.Clusters, other.Clusters)
+	r.Machines = addCount(r.Machines, other.Machines)
+	r.LoadBalancers = addCount(r.LoadBalancers, other.LoadBalancers)
+	r.PublicIPs = addCount(r.PublicIPs, other.PublicIPs)
+}
+
+func isZeroCount(c *int64) bool {
+	return c == nil || *c == 0
+}
+
+func addQuantity(a, b *resource.Quantity) *resource.Quantity {
+	switch {
+	case b == nil:
+		return a
+	case a == nil:
+		sum := b.DeepCopy()
+		return &sum
+	default:
+		sum := a.DeepCopy()
+		sum.Add(*b)
+		return &sum
+	}
+}
+
+func addCount(a, b *int64) *int64 {
+	switch {
+	case b == nil:
+		return a
+	case a == nil:
+		sum := *b
+		return &sum
+	default:
+		sum := *a + *b
+		return &sum
+	}
 }
 
 // +kubebuilder:object:generate=true
@@ -111,3 +261,85 @@ func NewResourceDetails(cpu, memory, storage resource.Quantity) *ResourceDetails
 		Storage: &storage,
 	}
 }
+
+// Values returns the values of all resources that are set, keyed by their JSON name.
+// Quantities are converted to float64, which can be approximate for large values.
+func (r ResourceDetails) Values() map[string]float64 {
+	values := map[string]float64{}
+	setQuantity := func(name string, q *resource.Quantity) {
+		if q != nil {
+			values[name] = q.AsApproximateFloat64()
+		}
+	}
+	setCount := func(name string, c *int64) {
+		if c != nil {
+			values[name] = float64(*c)
+		}
+	}
+
+	setQuantity("cpu", r.CPU)
+	setQuantity("memory", r.Memory)
+	setQuantity("storage", r.Storage)
+	setCount("clusters", r.Clusters)
+	setCount("machines", r.Machines)
+	setCount("loadBalancers", r.LoadBalancers)
+	setCount("publicIPs", r.PublicIPs)
+
+	return values
+}
+
+// Values returns the thresholds of all resources that are set, keyed by their JSON name.
+func (t *ResourceThresholds) Values() map[string]int32 {
+	values := map[string]int32{}
+	if t == nil {
+		return values
+	}
+
+	for name, threshold := range map[string]*int32{
+		"cpu":           t.CPU,
+		"memory":        t.Memory,
+		"storage":       t.Storage,
+		"clusters":      t.Clusters,
+		"machines":      t.Machines,
+		"loadBalancers": t.LoadBalancers,
+		"publicIPs":     t.PublicIPs,
+	} {
+		if threshold != nil {
+			values[name] = *threshold
+		}
+	}
+
+	return values
+}
+
+// ExceededSoftThresholds returns the sorted names of the resources for which the given usage reaches
+// the soft threshold of the quota. Resources without quota or without threshold are never exceeded,
+// a quota of zero is exceeded as soon as the resource is used.
+func (s *ResourceQuotaSpec) ExceededSoftThresholds(usage ResourceDetails) []string {
+	quotas := s.Quota.Values()
+	usages := usage.Values()
+
+	var exceeded []string
+	for name, threshold := range s.SoftThresholds.Values() {
+		quota, ok := quotas[name]
+		if !ok {
+			continue
+		}
+
+		used := usages[name]
+		if quota == 0 {
+			if used > 0 {
+				exceeded = append(exceeded, name)
+			}
+			continue
+		}
+
+		if used >= quota*float64(threshold)/100 {
+			exceeded = append(exceeded, name)
+		}
+	}
+
+	slices.Sort(exceeded)
+
+	return exceeded
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func TestExceededSoftThresholds(t *testing.T) {
+	spec := ResourceQuotaSpec{
+		Quota: ResourceDetails{
+			CPU:      ptr.To(resource.MustParse("10")),
+			Memory:   ptr.To(resource.MustParse("10Gi")),
+			Machines: ptr.To[int64](10),
+			// no load balancers are allowed at all
+			LoadBalancers: ptr.To[int64](0),
+		},
+		SoftThresholds: &ResourceThresholds{
+			CPU:           ptr.To[int32](80),
+			Memory:        ptr.To[int32](80),
+			Machines:      ptr.To[int32](50),
+			LoadBalancers: ptr.To[int32](80),
+			// no quota for storage, so the threshold is never exceeded
+			Storage: ptr.To[int32](1),
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		usage    ResourceDetails
+		expected []string
+	}{
+		{
+			name:  "below all thresholds",
+			usage: ResourceDetails{CPU: ptr.To(resource.MustParse("7")), Memory: ptr.To(resource.MustParse("1Gi")), Machines: ptr.To[int64](4)},
+		},
+		{
+			name:     "reaching thresholds",
+			usage:    ResourceDetails{CPU: ptr.To(resource.MustParse("8")), Memory: ptr.To(resource.MustParse("9Gi")), Machines: ptr.To[int64](4), Storage: ptr.To(resource.MustParse("1Ti"))},
+			expected: []string{"cpu", "memory"},
+		},
+		{
+			name:     "exceeding count threshold",
+			usage:    ResourceDetails{Machines: ptr.To[int64](6)},
+			expected: []string{"machines"},
+		},
+		{
+			name:     "using a resource with zero quota",
+			usage:    ResourceDetails{LoadBalancers: ptr.To[int64](1)},
+			expected: []string{"loadBalancers"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exceeded := spec.ExceededSoftThresholds(tc.usage)
+			if diff := cmp.Diff(tc.expected, exceeded); diff != "" {
+				t.Errorf("Unexpected exceeded thresholds (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if exceeded := (&ResourceQuotaSpec{Quota: spec.Quota}).ExceededSoftThresholds(spec.Quota); len(exceeded) != 0 {
+		t.Errorf("Expected no exceeded thresholds without soft thresholds, got %v.", exceeded)
+	}
+}
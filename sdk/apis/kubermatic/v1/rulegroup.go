@@ -27,17 +27,26 @@ const (
 
 	// RuleGroupKindName represents "Kind" defined in Kubernetes.
 	RuleGroupKindName = "RuleGroup"
+
+	// RuleGroupSourceLabelKey is the label on RuleGroups that have been materialised for a cluster from a
+	// project-scoped RuleGroup. It contains the name of the project-scoped RuleGroup.
+	RuleGroupSourceLabelKey = "kubermatic.k8c.io/rule-group-source"
 )
 
 // +kubebuilder:object:generate=true
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.ruleGroupType",name="Type",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.cluster.name",name="Cluster",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.projectID",name="Project",type="string"
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 type RuleGroup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec RuleGroupSpec `json:"spec,omitempty"`
+	Spec   RuleGroupSpec   `json:"spec,omitempty"`
+	Status RuleGroupStatus `json:"status,omitempty"`
 }
 
 type RuleGroupSpec struct {
@@ -46,9 +55,22 @@ type RuleGroupSpec struct {
 	// RuleGroupType is the type of this ruleGroup applies to. It can be `Metrics` or `Logs`.
 	RuleGroupType RuleGroupType `json:"ruleGroupType"`
 	// Cluster is the reference to the cluster the ruleGroup should be created in. All fields
-	// except for the name are ignored.
-	Cluster corev1.ObjectReference `json:"cluster"`
+	// except for the name are ignored. Mutually exclusive with ProjectID.
+	// +optional
+	Cluster corev1.ObjectReference `json:"cluster,omitempty"`
+	// ProjectID makes this a project-scoped ruleGroup, which is materialised for every cluster
+	// in the project. Project-scoped ruleGroups must be created in the MLA namespace on the seed.
+	// Mutually exclusive with Cluster.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+	// ClusterSelector restricts a project-scoped ruleGroup to the clusters in the project whose
+	// labels match the selector. If not set, the ruleGroup is materialised for all clusters
+	// in the project.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
 	// Data contains the RuleGroup data. Ref: https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/#rule_group
+	// On admission, the expressions of the rules are only checked for syntax errors; other errors are reported by the
+	// ruler once the RuleGroup is synced.
 	Data []byte `json:"data"`
 }
 
@@ -63,6 +85,53 @@ const (
 	RuleGroupTypeLogs RuleGroupType = "Logs"
 )
 
+// RuleGroupStatus describes the current state of a rule group.
+type RuleGroupStatus struct {
+	// Conditions contains conditions of the rule group.
+	// +optional
+	Conditions map[RuleGroupConditionType]RuleGroupCondition `json:"conditions,omitempty"`
+	// Clusters contains the sync result of a project-scoped rule group for each cluster it
+	// has been materialised for, keyed by the cluster name.
+	// +optional
+	Clusters map[string]RuleGroupClusterStatus `json:"clusters,omitempty"`
+}
+
+type RuleGroupCondition struct {
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time we got an update on a given condition.
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime"`
+	// Last time the condition transit from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// (brief) reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Synced
+
+// RuleGroupConditionType is used to indicate the type of a RuleGroup condition.
+type RuleGroupConditionType string
+
+const (
+	// RuleGroupConditionSynced indicates whether the rule group has been synced to the
+	// Cortex or Loki ruler of its cluster.
+	RuleGroupConditionSynced RuleGroupConditionType = "Synced"
+)
+
+// RuleGroupClusterStatus is the sync result of a project-scoped rule group for a single cluster.
+type RuleGroupClusterStatus struct {
+	// Synced indicates whether the rule group has been synced to the ruler of the cluster.
+	Synced bool `json:"synced"`
+	// Message contains the reason if the rule group has not been synced.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // +kubebuilder:object:generate=true
 // +kubebuilder:object:root=true
 
@@ -247,6 +247,21 @@ func (in *AddonFormControl) DeepCopy() *AddonFormControl {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonInventoryItem) DeepCopyInto(out *AddonInventoryItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonInventoryItem.
+func (in *AddonInventoryItem) DeepCopy() *AddonInventoryItem {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonInventoryItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonList) DeepCopyInto(out *AddonList) {
 	*out = *in
@@ -315,6 +330,11 @@ func (in *AddonStatus) DeepCopyInto(out *AddonStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = make([]AddonInventoryItem, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonStatus.
@@ -1065,6 +1085,11 @@ func (in *BackupDestination) DeepCopyInto(out *BackupDestination) {
 		*out = new(corev1.SecretReference)
 		**out = **in
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BackupEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDestination.
@@ -1077,6 +1102,22 @@ func (in *BackupDestination) DeepCopy() *BackupDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEncryption) DeepCopyInto(out *BackupEncryption) {
+	*out = *in
+	in.KeySecret.DeepCopyInto(&out.KeySecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEncryption.
+func (in *BackupEncryption) DeepCopy() *BackupEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	*out = *in
@@ -1085,6 +1126,13 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	in.BackupFinishedTime.DeepCopyInto(&out.BackupFinishedTime)
 	in.DeleteStartTime.DeepCopyInto(&out.DeleteStartTime)
 	in.DeleteFinishedTime.DeepCopyInto(&out.DeleteFinishedTime)
+	in.VerifyStartTime.DeepCopyInto(&out.VerifyStartTime)
+	in.VerifyFinishedTime.DeepCopyInto(&out.VerifyFinishedTime)
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(BackupVerification)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
@@ -1097,6 +1145,21 @@ func (in *BackupStatus) DeepCopy() *BackupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerification) DeepCopyInto(out *BackupVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerification.
+func (in *BackupVerification) DeepCopy() *BackupVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Baremetal) DeepCopyInto(out *Baremetal) {
 	*out = *in
@@ -1458,6 +1521,26 @@ func (in *ClusterBackupStorageLocationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCARotationStatus) DeepCopyInto(out *ClusterCARotationStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCARotationStatus.
+func (in *ClusterCARotationStatus) DeepCopy() *ClusterCARotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCARotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterCondition) DeepCopyInto(out *ClusterCondition) {
 	*out = *in
@@ -1574,6 +1657,23 @@ func (in *ClusterNetworkingConfig) DeepCopy() *ClusterNetworkingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterScheduledDeletionStatus) DeepCopyInto(out *ClusterScheduledDeletionStatus) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	in.DeleteAt.DeepCopyInto(&out.DeleteAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterScheduledDeletionStatus.
+func (in *ClusterScheduledDeletionStatus) DeepCopy() *ClusterScheduledDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterScheduledDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
@@ -1752,6 +1852,16 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = new(ClusterEncryptionStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CARotation != nil {
+		in, out := &in.CARotation, &out.CARotation
+		*out = new(ClusterCARotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScheduledDeletion != nil {
+		in, out := &in.ScheduledDeletion, &out.ScheduledDeletion
+		*out = new(ClusterScheduledDeletionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResourceUsage != nil {
 		in, out := &in.ResourceUsage, &out.ResourceUsage
 		*out = new(ResourceDetails)
@@ -1793,6 +1903,13 @@ func (in *ClusterTemplate) DeepCopyInto(out *ClusterTemplate) {
 		*out = make([]ClusterTemplateSSHKey, len(*in))
 		copy(*out, *in)
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ClusterTemplateParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
@@ -1819,7 +1936,8 @@ func (in *ClusterTemplateInstance) DeepCopyInto(out *ClusterTemplateInstance) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstance.
@@ -1840,6 +1958,26 @@ func (in *ClusterTemplateInstance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateInstanceClusterStatus) DeepCopyInto(out *ClusterTemplateInstanceClusterStatus) {
+	*out = *in
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstanceClusterStatus.
+func (in *ClusterTemplateInstanceClusterStatus) DeepCopy() *ClusterTemplateInstanceClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateInstanceClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterTemplateInstanceList) DeepCopyInto(out *ClusterTemplateInstanceList) {
 	*out = *in
@@ -1875,6 +2013,18 @@ func (in *ClusterTemplateInstanceList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterTemplateInstanceSpec) DeepCopyInto(out *ClusterTemplateInstanceSpec) {
 	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RolloutPolicy != nil {
+		in, out := &in.RolloutPolicy, &out.RolloutPolicy
+		*out = new(ClusterTemplateRolloutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstanceSpec.
@@ -1887,6 +2037,28 @@ func (in *ClusterTemplateInstanceSpec) DeepCopy() *ClusterTemplateInstanceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateInstanceStatus) DeepCopyInto(out *ClusterTemplateInstanceStatus) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make(map[string]ClusterTemplateInstanceClusterStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstanceStatus.
+func (in *ClusterTemplateInstanceStatus) DeepCopy() *ClusterTemplateInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
 	*out = *in
@@ -1919,6 +2091,66 @@ func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateParameter) DeepCopyInto(out *ClusterTemplateParameter) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enum != nil {
+		in, out := &in.Enum, &out.Enum
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Minimum != nil {
+		in, out := &in.Minimum, &out.Minimum
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Maximum != nil {
+		in, out := &in.Maximum, &out.Maximum
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateParameter.
+func (in *ClusterTemplateParameter) DeepCopy() *ClusterTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateRolloutPolicy) DeepCopyInto(out *ClusterTemplateRolloutPolicy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRolloutPolicy.
+func (in *ClusterTemplateRolloutPolicy) DeepCopy() *ClusterTemplateRolloutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateRolloutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterTemplateSSHKey) DeepCopyInto(out *ClusterTemplateSSHKey) {
 	*out = *in
@@ -3118,6 +3350,11 @@ func (in *EncryptionConfiguration) DeepCopyInto(out *EncryptionConfiguration) {
 		*out = new(SecretboxEncryptionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSEncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfiguration.
@@ -3510,6 +3747,22 @@ func (in *EventRateLimitConfigItem) DeepCopy() *EventRateLimitConfigItem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpiringCertificate) DeepCopyInto(out *ExpiringCertificate) {
+	*out = *in
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpiringCertificate.
+func (in *ExpiringCertificate) DeepCopy() *ExpiringCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpiringCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ExposeStrategiesSet) DeepCopyInto(out *ExposeStrategiesSet) {
 	{
@@ -3584,6 +3837,18 @@ func (in *ExtendedClusterHealth) DeepCopyInto(out *ExtendedClusterHealth) {
 		*out = new(HealthStatus)
 		**out = **in
 	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(HealthStatus)
+		**out = **in
+	}
+	if in.ExpiringCertificates != nil {
+		in, out := &in.ExpiringCertificates, &out.ExpiringCertificates
+		*out = make([]ExpiringCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtendedClusterHealth.
@@ -4418,6 +4683,48 @@ func (in *Incompatibility) DeepCopy() *Incompatibility {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionConfiguration) DeepCopyInto(out *KMSEncryptionConfiguration) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionConfiguration.
+func (in *KMSEncryptionConfiguration) DeepCopy() *KMSEncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kind) DeepCopyInto(out *Kind) {
 	*out = *in
@@ -5078,6 +5385,11 @@ func (in *KubermaticUserClusterConfiguration) DeepCopyInto(out *KubermaticUserCl
 	out.MachineController = in.MachineController
 	out.OperatingSystemManager = in.OperatingSystemManager
 	out.KubeLB = in.KubeLB
+	if in.CertificateRenewalThreshold != nil {
+		in, out := &in.CertificateRenewalThreshold, &out.CertificateRenewalThreshold
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubermaticUserClusterConfiguration.
@@ -6930,6 +7242,26 @@ func (in *ResourceDetails) DeepCopyInto(out *ResourceDetails) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PublicIPs != nil {
+		in, out := &in.PublicIPs, &out.PublicIPs
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDetails.
@@ -6969,6 +7301,23 @@ func (in *ResourceQuota) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaCondition) DeepCopyInto(out *ResourceQuotaCondition) {
+	*out = *in
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaCondition.
+func (in *ResourceQuotaCondition) DeepCopy() *ResourceQuotaCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuotaList) DeepCopyInto(out *ResourceQuotaList) {
 	*out = *in
@@ -7006,6 +7355,11 @@ func (in *ResourceQuotaSpec) DeepCopyInto(out *ResourceQuotaSpec) {
 	*out = *in
 	out.Subject = in.Subject
 	in.Quota.DeepCopyInto(&out.Quota)
+	if in.SoftThresholds != nil {
+		in, out := &in.SoftThresholds, &out.SoftThresholds
+		*out = new(ResourceThresholds)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaSpec.
@@ -7023,6 +7377,13 @@ func (in *ResourceQuotaStatus) DeepCopyInto(out *ResourceQuotaStatus) {
 	*out = *in
 	in.GlobalUsage.DeepCopyInto(&out.GlobalUsage)
 	in.LocalUsage.DeepCopyInto(&out.LocalUsage)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(map[ResourceQuotaConditionType]ResourceQuotaCondition, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaStatus.
@@ -7035,12 +7396,63 @@ func (in *ResourceQuotaStatus) DeepCopy() *ResourceQuotaStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceThresholds) DeepCopyInto(out *ResourceThresholds) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PublicIPs != nil {
+		in, out := &in.PublicIPs, &out.PublicIPs
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceThresholds.
+func (in *ResourceThresholds) DeepCopy() *ResourceThresholds {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceThresholds)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleGroup) DeepCopyInto(out *RuleGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroup.
@@ -7061,6 +7473,38 @@ func (in *RuleGroup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupClusterStatus) DeepCopyInto(out *RuleGroupClusterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupClusterStatus.
+func (in *RuleGroupClusterStatus) DeepCopy() *RuleGroupClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupCondition) DeepCopyInto(out *RuleGroupCondition) {
+	*out = *in
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupCondition.
+func (in *RuleGroupCondition) DeepCopy() *RuleGroupCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleGroupList) DeepCopyInto(out *RuleGroupList) {
 	*out = *in
@@ -7097,6 +7541,11 @@ func (in *RuleGroupList) DeepCopyObject() runtime.Object {
 func (in *RuleGroupSpec) DeepCopyInto(out *RuleGroupSpec) {
 	*out = *in
 	out.Cluster = in.Cluster
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Data != nil {
 		in, out := &in.Data, &out.Data
 		*out = make([]byte, len(*in))
@@ -7114,6 +7563,35 @@ func (in *RuleGroupSpec) DeepCopy() *RuleGroupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupStatus) DeepCopyInto(out *RuleGroupStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(map[RuleGroupConditionType]RuleGroupCondition, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make(map[string]RuleGroupClusterStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupStatus.
+func (in *RuleGroupStatus) DeepCopy() *RuleGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHKeySpec) DeepCopyInto(out *SSHKeySpec) {
 	*out = *in
@@ -7300,6 +7778,11 @@ func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 		*out = new(EtcdBackupRestore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterDeletionGracePeriod != nil {
+		in, out := &in.ClusterDeletionGracePeriod, &out.ClusterDeletionGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.OIDCProviderConfiguration != nil {
 		in, out := &in.OIDCProviderConfiguration, &out.OIDCProviderConfiguration
 		*out = new(OIDCProviderConfiguration)